@@ -14,20 +14,40 @@ const (
 	DB_MYSQL    = "mysql"
 )
 
+// What to do when a completed task consumes more material than the stock
+const (
+	NEGATIVE_STOCK_REJECT = "reject"
+	NEGATIVE_STOCK_FLAG   = "flag"
+)
+
 type Configuration struct {
-	AppPort                *string   `mapstructure:"app_port"`
-	DemoMode               *bool     `mapstructure:"demo_mode"`
-	UploadPathArea         *string   `mapstructure:"upload_path_area"`
-	UploadPathCrop         *string   `mapstructure:"upload_path_crop"`
-	TaniaPersistenceEngine *string   `mapstructure:"tania_persistence_engine"`
-	SqlitePath             *string   `mapstructure:"sqlite_path"`
-	MysqlHost              *string   `mapstructure:"mysql_host"`
-	MysqlPort              *string   `mapstructure:"mysql_port"`
-	MysqlDbname            *string   `mapstructure:"mysql_dbname"`
-	MysqlUsername          *string   `mapstructure:"mysql_username"`
-	MysqlPassword          *string   `mapstructure:"mysql_password"`
-	RedirectURI            []*string `mapstructure:"redirect_uri"`
-	ClientID               *string   `mapstructure:"client_id"`
+	AppPort                   *string   `mapstructure:"app_port"`
+	DemoMode                  *bool     `mapstructure:"demo_mode"`
+	UploadPathArea            *string   `mapstructure:"upload_path_area"`
+	UploadPathCrop            *string   `mapstructure:"upload_path_crop"`
+	UploadPathTask            *string   `mapstructure:"upload_path_task"`
+	TaniaPersistenceEngine    *string   `mapstructure:"tania_persistence_engine"`
+	SqlitePath                *string   `mapstructure:"sqlite_path"`
+	MysqlHost                 *string   `mapstructure:"mysql_host"`
+	MysqlPort                 *string   `mapstructure:"mysql_port"`
+	MysqlDbname               *string   `mapstructure:"mysql_dbname"`
+	MysqlUsername             *string   `mapstructure:"mysql_username"`
+	MysqlPassword             *string   `mapstructure:"mysql_password"`
+	RedirectURI               []*string `mapstructure:"redirect_uri"`
+	ClientID                  *string   `mapstructure:"client_id"`
+	CropRotationRules         []*string `mapstructure:"crop_rotation_rules"`
+	TaskDueCheckInterval      *string   `mapstructure:"task_due_check_interval"`
+	TaskNegativeStock         *string   `mapstructure:"task_negative_stock"`
+	TaskReminderCheckInterval *string   `mapstructure:"task_reminder_check_interval"`
+	TaskReminderBefore        *string   `mapstructure:"task_reminder_before"`
+	SmtpHost                  *string   `mapstructure:"smtp_host"`
+	SmtpPort                  *string   `mapstructure:"smtp_port"`
+	SmtpUsername              *string   `mapstructure:"smtp_username"`
+	SmtpPassword              *string   `mapstructure:"smtp_password"`
+	SmtpFrom                  *string   `mapstructure:"smtp_from"`
+	NotificationFilePath      *string   `mapstructure:"notification_file_path"`
+	NotificationWebhook       *bool     `mapstructure:"notification_webhook"`
+	NotificationWebhookLocal  *bool     `mapstructure:"notification_webhook_local"`
 }
 
 /*
@@ -67,11 +87,36 @@ func InitViperConfig() {
 	// Local Upload Path
 	pflag.String("upload_path_area", "tania-uploads/area", "Upload path for the Area photo")
 	pflag.String("upload_path_crop", "tania-uploads/crop", "Upload path for the Crop photo")
+	pflag.String("upload_path_task", "tania-uploads/task", "Upload path for the Task attachments")
 
 	// Built-In implicit grant OAuth 2
 	pflag.StringSlice("redirect_uri", []string{"http://localhost:8080/oauth2_implicit_callback"}, "URI for redirection after authorization server grants access token")
 	pflag.String("client_id", "f0ece679-3f53-463e-b624-73e83049d6ac", "OAuth2 Implicit Grant Client ID for frontend")
 
+	// Crop rotation rules, written as PLANT_FAMILY:YEARS
+	pflag.StringSlice("crop_rotation_rules", []string{"SOLANACEAE:3", "BRASSICACEAE:3", "CUCURBITACEAE:2", "AMARYLLIDACEAE:2"}, "Minimum years before planting the same plant family in the same area again")
+
+	// Scheduler, written as a duration such as 30s or 5m. Zero disables the check
+	pflag.String("task_due_check_interval", "1m", "Interval between the checks marking the overdue tasks as due")
+
+	// Material consumed by the tasks
+	pflag.String("task_negative_stock", "reject", "What to do when a completed task consumes more material than the stock. Available options: reject, flag")
+
+	// Task reminders, written as durations such as 30s or 24h. A zero interval disables them
+	pflag.String("task_reminder_check_interval", "1m", "Interval between the checks sending the task reminders")
+	pflag.String("task_reminder_before", "24h", "Default time before the due date to remind the users of their tasks")
+
+	// Notification channels. The email channel is only available when the SMTP host is set,
+	// the file channel when the file path is set, and the webhook channel when it is switched on
+	pflag.String("smtp_host", "", "SMTP host sending the email notifications")
+	pflag.String("smtp_port", "587", "SMTP port")
+	pflag.String("smtp_username", "", "SMTP username. Leave it empty when the SMTP server doesn't need authentication")
+	pflag.String("smtp_password", "", "SMTP password")
+	pflag.String("smtp_from", "tania@localhost", "Sender address of the email notifications")
+	pflag.String("notification_file_path", "", "Path of the file where the notifications are written, to try them without a mail server")
+	pflag.Bool("notification_webhook", false, "Switch for the webhook notifications, posted to the urls given by the users")
+	pflag.Bool("notification_webhook_local", false, "Allow the webhooks to be posted to loopback, link-local and private addresses")
+
 	pflag.Parse()
 	err := v.BindPFlags(pflag.CommandLine)
 	if err != nil {
@@ -249,6 +249,107 @@ CREATE TABLE IF NOT EXISTS `CROP_ACTIVITY` (
     FOREIGN KEY(`CROP_UID`) REFERENCES `CROP_READ`(`UID`)
 );
 
+CREATE TABLE IF NOT EXISTS `PLANTING_HISTORY_READ` (
+    `ID` INT PRIMARY KEY AUTO_INCREMENT,
+    `AREA_UID` BINARY(16),
+    `CROP_UID` BINARY(16),
+    `BATCH_ID` VARCHAR(255),
+    `INVENTORY_UID` BINARY(16),
+    `VARIETY_NAME` VARCHAR(255),
+    `PLANT_FAMILY` VARCHAR(255),
+    `PLANTED_DATE` DATETIME
+);
+
+CREATE UNIQUE INDEX `PLANTING_HISTORY_READ_AREA_CROP_UNIQUE_INDEX` ON `PLANTING_HISTORY_READ` (`AREA_UID`, `CROP_UID`);
+
+CREATE TABLE IF NOT EXISTS `HARVEST_LOT_READ` (
+    `UID` BINARY(16) PRIMARY KEY,
+    `LOT_NUMBER` VARCHAR(255),
+    `CROP_UID` BINARY(16),
+    `BATCH_ID` VARCHAR(255),
+    `FARM_UID` BINARY(16),
+    `SOURCE_AREA_UID` BINARY(16),
+    `SOURCE_AREA_NAME` VARCHAR(255),
+    `GRADE` VARCHAR(255),
+    `PRODUCED_GRAM_QUANTITY` FLOAT,
+    `HARVEST_DATE` DATETIME
+);
+
+CREATE INDEX `HARVEST_LOT_READ_CROP_UID_INDEX` ON `HARVEST_LOT_READ` (`CROP_UID`);
+CREATE INDEX `HARVEST_LOT_READ_FARM_UID_INDEX` ON `HARVEST_LOT_READ` (`FARM_UID`);
+
+CREATE TABLE IF NOT EXISTS `CROP_TEMPLATE_EVENT` (
+    `ID` INT PRIMARY KEY AUTO_INCREMENT,
+    `CROP_TEMPLATE_UID` BINARY(16),
+    `VERSION` INT,
+    `CREATED_DATE` DATETIME,
+    `EVENT` JSON
+);
+
+CREATE INDEX `CROP_TEMPLATE_EVENT_CROP_TEMPLATE_UID_INDEX` ON `CROP_TEMPLATE_EVENT` (`CROP_TEMPLATE_UID`);
+
+CREATE TABLE IF NOT EXISTS `CROP_TEMPLATE_READ` (
+    `UID` BINARY(16) PRIMARY KEY,
+    `NAME` VARCHAR(255),
+    `INVENTORY_UID` BINARY(16),
+    `INVENTORY_NAME` VARCHAR(255),
+    `TASKS` TEXT,
+    `CREATED_DATE` DATETIME
+);
+
+CREATE INDEX `CROP_TEMPLATE_READ_INVENTORY_UID_INDEX` ON `CROP_TEMPLATE_READ` (`INVENTORY_UID`);
+
+CREATE TABLE IF NOT EXISTS `HARVEST_UNIT_EVENT` (
+    `ID` INT PRIMARY KEY AUTO_INCREMENT,
+    `HARVEST_UNIT_UID` BINARY(16),
+    `VERSION` INT,
+    `CREATED_DATE` DATETIME,
+    `EVENT` JSON
+);
+
+CREATE INDEX `HARVEST_UNIT_EVENT_HARVEST_UNIT_UID_INDEX` ON `HARVEST_UNIT_EVENT` (`HARVEST_UNIT_UID`);
+
+CREATE TABLE IF NOT EXISTS `HARVEST_UNIT_READ` (
+    `UID` BINARY(16) PRIMARY KEY,
+    `INVENTORY_UID` BINARY(16),
+    `INVENTORY_NAME` VARCHAR(255),
+    `CODE` VARCHAR(255),
+    `LABEL` VARCHAR(255),
+    `AVERAGE_GRAM_WEIGHT` FLOAT,
+    `CREATED_DATE` DATETIME
+);
+
+CREATE INDEX `HARVEST_UNIT_READ_INVENTORY_UID_INDEX` ON `HARVEST_UNIT_READ` (`INVENTORY_UID`);
+
+CREATE TABLE IF NOT EXISTS `SUCCESSION_PLAN_EVENT` (
+    `ID` INT PRIMARY KEY AUTO_INCREMENT,
+    `SUCCESSION_PLAN_UID` BINARY(16),
+    `VERSION` INT,
+    `CREATED_DATE` DATETIME,
+    `EVENT` JSON
+);
+
+CREATE INDEX `SUCCESSION_PLAN_EVENT_SUCCESSION_PLAN_UID_INDEX` ON `SUCCESSION_PLAN_EVENT` (`SUCCESSION_PLAN_UID`);
+
+CREATE TABLE IF NOT EXISTS `SUCCESSION_PLAN_READ` (
+    `UID` BINARY(16) PRIMARY KEY,
+    `NAME` VARCHAR(255),
+    `INVENTORY_UID` BINARY(16),
+    `INVENTORY_NAME` VARCHAR(255),
+    `AREAS` TEXT,
+    `CROP_TYPE` VARCHAR(255),
+    `CONTAINER_TYPE` VARCHAR(255),
+    `CONTAINER_CELL` INT,
+    `QUANTITY` INT,
+    `INTERVAL_DAYS` INT,
+    `START_DATE` DATETIME,
+    `END_DATE` DATETIME,
+    `PLANNED_BATCHES` TEXT,
+    `CREATED_DATE` DATETIME
+);
+
+CREATE INDEX `SUCCESSION_PLAN_READ_INVENTORY_UID_INDEX` ON `SUCCESSION_PLAN_READ` (`INVENTORY_UID`);
+
 -- TASK --
 
 CREATE TABLE IF NOT EXISTS `TASK_EVENT` (
@@ -313,4 +414,110 @@ CREATE TABLE IF NOT EXISTS `USER_AUTH` (
 );
 
 CREATE UNIQUE INDEX `USER_AUTH_USER_UID_UNIQUE_INDEX` ON `USER_AUTH` (`USER_UID`);
-CREATE UNIQUE INDEX `USER_AUTH_ACCESS_TOKEN_UNIQUE_INDEX` ON `USER_AUTH` (`ACCESS_TOKEN`);
\ No newline at end of file
+CREATE UNIQUE INDEX `USER_AUTH_ACCESS_TOKEN_UNIQUE_INDEX` ON `USER_AUTH` (`ACCESS_TOKEN`);
+
+-- SCHEDULER --
+
+CREATE TABLE IF NOT EXISTS `SCHEDULER_LOCK` (
+    `NAME` VARCHAR(255) PRIMARY KEY,
+    `OWNER` VARCHAR(255),
+    `EXPIRES_DATE` DATETIME
+);
+
+CREATE TABLE IF NOT EXISTS `TASK_CALENDAR_FEED` (
+    `USER_UID` BINARY(16) PRIMARY KEY,
+    `TOKEN` VARCHAR(255),
+    `CREATED_DATE` DATETIME
+);
+
+CREATE UNIQUE INDEX `TASK_CALENDAR_FEED_TOKEN_UNIQUE_INDEX` ON `TASK_CALENDAR_FEED` (`TOKEN`);
+
+CREATE TABLE IF NOT EXISTS `TASK_TIME_ENTRY_READ` (
+    `UID` BINARY(16) PRIMARY KEY,
+    `TASK_UID` BINARY(16),
+    `WORKER_UID` BINARY(16),
+    `HOURLY_RATE` FLOAT,
+    `START_DATE` DATETIME,
+    `END_DATE` DATETIME,
+    `DURATION` INT
+);
+
+CREATE INDEX `TASK_TIME_ENTRY_READ_START_DATE_INDEX` ON `TASK_TIME_ENTRY_READ` (`START_DATE`);
+
+CREATE TABLE IF NOT EXISTS `FINANCE_LEDGER_READ` (
+    `TASK_UID` BINARY(16) PRIMARY KEY,
+    `FARM_UID` BINARY(16),
+    `TITLE` VARCHAR(255),
+    `AMOUNT` VARCHAR(255),
+    `CURRENCY_CODE` VARCHAR(3),
+    `DIRECTION` VARCHAR(255),
+    `COUNTERPARTY` VARCHAR(255),
+    `MATERIAL_UID` BINARY(16),
+    `POSTED_DATE` DATETIME
+);
+
+CREATE INDEX `FINANCE_LEDGER_READ_FARM_UID_INDEX` ON `FINANCE_LEDGER_READ` (`FARM_UID`, `POSTED_DATE`);
+
+CREATE TABLE IF NOT EXISTS `TASK_REMINDER_PREFERENCE` (
+    `USER_UID` BINARY(16) PRIMARY KEY,
+    `CHANNEL` VARCHAR(255),
+    `RECIPIENT` TEXT,
+    `CATEGORIES` TEXT,
+    `PRIORITIES` TEXT,
+    `REMIND_BEFORE` INT,
+    `REMIND_OVERDUE` TINYINT(1),
+    `LAST_UPDATED` DATETIME
+);
+
+CREATE TABLE IF NOT EXISTS `TASK_REMINDER_SENT` (
+    `TASK_UID` BINARY(16),
+    `USER_UID` BINARY(16),
+    `KIND` VARCHAR(255),
+    `DUE_DATE` DATETIME,
+    `SENT_DATE` DATETIME,
+    PRIMARY KEY (`TASK_UID`, `USER_UID`, `KIND`)
+);
+
+CREATE TABLE IF NOT EXISTS `SUCCESSION_PLAN_TASK` (
+    `PLANNED_BATCH_UID` BINARY(16) PRIMARY KEY,
+    `SUCCESSION_PLAN_UID` BINARY(16),
+    `TASK_UID` BINARY(16)
+);
+
+CREATE INDEX `SUCCESSION_PLAN_TASK_SUCCESSION_PLAN_UID_INDEX` ON `SUCCESSION_PLAN_TASK` (`SUCCESSION_PLAN_UID`);
+
+-- COLUMN ADDITIONS --
+-- Columns added to the existing tables. Keep them after the tables and before the backfills.
+
+ALTER TABLE `MATERIAL_READ` ADD COLUMN `PLANT_FAMILY` VARCHAR(255);
+ALTER TABLE `CROP_READ_TRASH` ADD COLUMN `REASONS` TEXT;
+ALTER TABLE `HARVEST_LOT_READ` ADD COLUMN `PRODUCED_QUANTITY` FLOAT;
+ALTER TABLE `HARVEST_LOT_READ` ADD COLUMN `PRODUCED_UNIT` VARCHAR(255);
+ALTER TABLE `TASK_READ` ADD COLUMN `RECURRENCE_RULE` VARCHAR(255);
+ALTER TABLE `TASK_READ` ADD COLUMN `SERIES_UID` BINARY(16);
+ALTER TABLE `TASK_READ` ADD COLUMN `ASSIGNEE_UID` BINARY(16);
+ALTER TABLE `TASK_READ` ADD COLUMN `CHECKLIST` TEXT;
+ALTER TABLE `TASK_READ` ADD COLUMN `BLOCKED_BY` TEXT;
+ALTER TABLE `TASK_READ` ADD COLUMN `COMMENTS` TEXT;
+ALTER TABLE `TASK_READ` ADD COLUMN `ATTACHMENTS` TEXT;
+ALTER TABLE `TASK_READ` ADD COLUMN `MATERIAL_CONSUMPTION` TEXT;
+ALTER TABLE `TASK_READ` ADD COLUMN `DOMAIN_DATA_FINANCE` TEXT;
+
+-- DATA BACKFILLS --
+-- Read models filled from the existing data. They run on every start, so they must be idempotent.
+
+-- The plantings of the crop batches created before the planting history was recorded
+INSERT IGNORE INTO `PLANTING_HISTORY_READ`
+    (`AREA_UID`, `CROP_UID`, `BATCH_ID`, `INVENTORY_UID`, `VARIETY_NAME`, `PLANT_FAMILY`, `PLANTED_DATE`)
+    SELECT C.`INITIAL_AREA_UID`, C.`UID`, C.`BATCH_ID`, C.`INVENTORY_UID`, C.`INVENTORY_NAME`,
+        COALESCE(M.`PLANT_FAMILY`, ''), C.`INITIAL_AREA_CREATED_DATE`
+    FROM `CROP_READ` C
+    LEFT JOIN `MATERIAL_READ` M ON M.`UID` = C.`INVENTORY_UID`;
+
+INSERT IGNORE INTO `PLANTING_HISTORY_READ`
+    (`AREA_UID`, `CROP_UID`, `BATCH_ID`, `INVENTORY_UID`, `VARIETY_NAME`, `PLANT_FAMILY`, `PLANTED_DATE`)
+    SELECT A.`AREA_UID`, C.`UID`, C.`BATCH_ID`, C.`INVENTORY_UID`, C.`INVENTORY_NAME`,
+        COALESCE(M.`PLANT_FAMILY`, ''), A.`CREATED_DATE`
+    FROM `CROP_READ_MOVED_AREA` A
+    JOIN `CROP_READ` C ON C.`UID` = A.`CROP_UID`
+    LEFT JOIN `MATERIAL_READ` M ON M.`UID` = C.`INVENTORY_UID`;
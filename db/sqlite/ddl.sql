@@ -249,6 +249,107 @@ CREATE TABLE IF NOT EXISTS "CROP_ACTIVITY" (
     FOREIGN KEY("CROP_UID") REFERENCES "CROP_READ"("UID")
 );
 
+CREATE TABLE IF NOT EXISTS "PLANTING_HISTORY_READ" (
+    "ID" INTEGER PRIMARY KEY,
+    "AREA_UID" BLOB,
+    "CROP_UID" BLOB,
+    "BATCH_ID" TEXT,
+    "INVENTORY_UID" BLOB,
+    "VARIETY_NAME" TEXT,
+    "PLANT_FAMILY" TEXT,
+    "PLANTED_DATE" TEXT
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS "PLANTING_HISTORY_READ_AREA_CROP_UNIQUE_INDEX" ON "PLANTING_HISTORY_READ" ("AREA_UID", "CROP_UID");
+
+CREATE TABLE IF NOT EXISTS "HARVEST_LOT_READ" (
+    "UID" BLOB PRIMARY KEY,
+    "LOT_NUMBER" TEXT,
+    "CROP_UID" BLOB,
+    "BATCH_ID" TEXT,
+    "FARM_UID" BLOB,
+    "SOURCE_AREA_UID" BLOB,
+    "SOURCE_AREA_NAME" TEXT,
+    "GRADE" TEXT,
+    "PRODUCED_GRAM_QUANTITY" REAL,
+    "HARVEST_DATE" TEXT
+);
+
+CREATE INDEX IF NOT EXISTS "HARVEST_LOT_READ_CROP_UID_INDEX" ON "HARVEST_LOT_READ" ("CROP_UID");
+CREATE INDEX IF NOT EXISTS "HARVEST_LOT_READ_FARM_UID_INDEX" ON "HARVEST_LOT_READ" ("FARM_UID");
+
+CREATE TABLE IF NOT EXISTS "CROP_TEMPLATE_EVENT" (
+    "ID" INTEGER PRIMARY KEY,
+    "CROP_TEMPLATE_UID" BLOB,
+    "VERSION" INTEGER,
+    "CREATED_DATE" TEXT,
+    "EVENT" BLOB
+);
+
+CREATE INDEX IF NOT EXISTS "CROP_TEMPLATE_EVENT_CROP_TEMPLATE_UID_INDEX" ON "CROP_TEMPLATE_EVENT" ("CROP_TEMPLATE_UID");
+
+CREATE TABLE IF NOT EXISTS "CROP_TEMPLATE_READ" (
+    "UID" BLOB PRIMARY KEY,
+    "NAME" TEXT,
+    "INVENTORY_UID" BLOB,
+    "INVENTORY_NAME" TEXT,
+    "TASKS" TEXT,
+    "CREATED_DATE" TEXT
+);
+
+CREATE INDEX IF NOT EXISTS "CROP_TEMPLATE_READ_INVENTORY_UID_INDEX" ON "CROP_TEMPLATE_READ" ("INVENTORY_UID");
+
+CREATE TABLE IF NOT EXISTS "HARVEST_UNIT_EVENT" (
+    "ID" INTEGER PRIMARY KEY,
+    "HARVEST_UNIT_UID" BLOB,
+    "VERSION" INTEGER,
+    "CREATED_DATE" TEXT,
+    "EVENT" BLOB
+);
+
+CREATE INDEX IF NOT EXISTS "HARVEST_UNIT_EVENT_HARVEST_UNIT_UID_INDEX" ON "HARVEST_UNIT_EVENT" ("HARVEST_UNIT_UID");
+
+CREATE TABLE IF NOT EXISTS "HARVEST_UNIT_READ" (
+    "UID" BLOB PRIMARY KEY,
+    "INVENTORY_UID" BLOB,
+    "INVENTORY_NAME" TEXT,
+    "CODE" TEXT,
+    "LABEL" TEXT,
+    "AVERAGE_GRAM_WEIGHT" REAL,
+    "CREATED_DATE" TEXT
+);
+
+CREATE INDEX IF NOT EXISTS "HARVEST_UNIT_READ_INVENTORY_UID_INDEX" ON "HARVEST_UNIT_READ" ("INVENTORY_UID");
+
+CREATE TABLE IF NOT EXISTS "SUCCESSION_PLAN_EVENT" (
+    "ID" INTEGER PRIMARY KEY,
+    "SUCCESSION_PLAN_UID" BLOB,
+    "VERSION" INTEGER,
+    "CREATED_DATE" TEXT,
+    "EVENT" BLOB
+);
+
+CREATE INDEX IF NOT EXISTS "SUCCESSION_PLAN_EVENT_SUCCESSION_PLAN_UID_INDEX" ON "SUCCESSION_PLAN_EVENT" ("SUCCESSION_PLAN_UID");
+
+CREATE TABLE IF NOT EXISTS "SUCCESSION_PLAN_READ" (
+    "UID" BLOB PRIMARY KEY,
+    "NAME" TEXT,
+    "INVENTORY_UID" BLOB,
+    "INVENTORY_NAME" TEXT,
+    "AREAS" TEXT,
+    "CROP_TYPE" TEXT,
+    "CONTAINER_TYPE" TEXT,
+    "CONTAINER_CELL" INTEGER,
+    "QUANTITY" INTEGER,
+    "INTERVAL_DAYS" INTEGER,
+    "START_DATE" TEXT,
+    "END_DATE" TEXT,
+    "PLANNED_BATCHES" TEXT,
+    "CREATED_DATE" TEXT
+);
+
+CREATE INDEX IF NOT EXISTS "SUCCESSION_PLAN_READ_INVENTORY_UID_INDEX" ON "SUCCESSION_PLAN_READ" ("INVENTORY_UID");
+
 -- TASK --
 
 CREATE TABLE IF NOT EXISTS "TASK_EVENT" (
@@ -312,4 +413,110 @@ CREATE TABLE IF NOT EXISTS "USER_AUTH" (
 );
 
 CREATE UNIQUE INDEX IF NOT EXISTS "USER_AUTH_USER_UID_UNIQUE_INDEX" ON "USER_AUTH" ("USER_UID");
-CREATE UNIQUE INDEX IF NOT EXISTS "USER_AUTH_ACCESS_TOKEN_UNIQUE_INDEX" ON "USER_AUTH" ("ACCESS_TOKEN");
\ No newline at end of file
+CREATE UNIQUE INDEX IF NOT EXISTS "USER_AUTH_ACCESS_TOKEN_UNIQUE_INDEX" ON "USER_AUTH" ("ACCESS_TOKEN");
+
+-- SCHEDULER --
+
+CREATE TABLE IF NOT EXISTS "SCHEDULER_LOCK" (
+    "NAME" TEXT PRIMARY KEY,
+    "OWNER" TEXT,
+    "EXPIRES_DATE" TEXT
+);
+
+CREATE TABLE IF NOT EXISTS "TASK_CALENDAR_FEED" (
+    "USER_UID" BLOB PRIMARY KEY,
+    "TOKEN" TEXT,
+    "CREATED_DATE" TEXT
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS "TASK_CALENDAR_FEED_TOKEN_UNIQUE_INDEX" ON "TASK_CALENDAR_FEED" ("TOKEN");
+
+CREATE TABLE IF NOT EXISTS "TASK_TIME_ENTRY_READ" (
+    "UID" BLOB PRIMARY KEY,
+    "TASK_UID" BLOB,
+    "WORKER_UID" BLOB,
+    "HOURLY_RATE" REAL,
+    "START_DATE" TEXT,
+    "END_DATE" TEXT,
+    "DURATION" INTEGER
+);
+
+CREATE INDEX IF NOT EXISTS "TASK_TIME_ENTRY_READ_START_DATE_INDEX" ON "TASK_TIME_ENTRY_READ" ("START_DATE");
+
+CREATE TABLE IF NOT EXISTS "FINANCE_LEDGER_READ" (
+    "TASK_UID" BLOB PRIMARY KEY,
+    "FARM_UID" BLOB,
+    "TITLE" TEXT,
+    "AMOUNT" TEXT,
+    "CURRENCY_CODE" TEXT,
+    "DIRECTION" TEXT,
+    "COUNTERPARTY" TEXT,
+    "MATERIAL_UID" BLOB,
+    "POSTED_DATE" TEXT
+);
+
+CREATE INDEX IF NOT EXISTS "FINANCE_LEDGER_READ_FARM_UID_INDEX" ON "FINANCE_LEDGER_READ" ("FARM_UID", "POSTED_DATE");
+
+CREATE TABLE IF NOT EXISTS "TASK_REMINDER_PREFERENCE" (
+    "USER_UID" BLOB PRIMARY KEY,
+    "CHANNEL" TEXT,
+    "RECIPIENT" TEXT,
+    "CATEGORIES" TEXT,
+    "PRIORITIES" TEXT,
+    "REMIND_BEFORE" INTEGER,
+    "REMIND_OVERDUE" BOOLEAN,
+    "LAST_UPDATED" TEXT
+);
+
+CREATE TABLE IF NOT EXISTS "TASK_REMINDER_SENT" (
+    "TASK_UID" BLOB,
+    "USER_UID" BLOB,
+    "KIND" TEXT,
+    "DUE_DATE" TEXT,
+    "SENT_DATE" TEXT,
+    PRIMARY KEY ("TASK_UID", "USER_UID", "KIND")
+);
+
+CREATE TABLE IF NOT EXISTS "SUCCESSION_PLAN_TASK" (
+    "PLANNED_BATCH_UID" BLOB PRIMARY KEY,
+    "SUCCESSION_PLAN_UID" BLOB,
+    "TASK_UID" BLOB
+);
+
+CREATE INDEX IF NOT EXISTS "SUCCESSION_PLAN_TASK_SUCCESSION_PLAN_UID_INDEX" ON "SUCCESSION_PLAN_TASK" ("SUCCESSION_PLAN_UID");
+
+-- COLUMN ADDITIONS --
+-- Columns added to the existing tables. Keep them after the tables and before the backfills.
+
+ALTER TABLE "MATERIAL_READ" ADD COLUMN "PLANT_FAMILY" TEXT;
+ALTER TABLE "CROP_READ_TRASH" ADD COLUMN "REASONS" TEXT;
+ALTER TABLE "HARVEST_LOT_READ" ADD COLUMN "PRODUCED_QUANTITY" REAL;
+ALTER TABLE "HARVEST_LOT_READ" ADD COLUMN "PRODUCED_UNIT" TEXT;
+ALTER TABLE "TASK_READ" ADD COLUMN "RECURRENCE_RULE" TEXT;
+ALTER TABLE "TASK_READ" ADD COLUMN "SERIES_UID" TEXT;
+ALTER TABLE "TASK_READ" ADD COLUMN "ASSIGNEE_UID" TEXT;
+ALTER TABLE "TASK_READ" ADD COLUMN "CHECKLIST" TEXT;
+ALTER TABLE "TASK_READ" ADD COLUMN "BLOCKED_BY" TEXT;
+ALTER TABLE "TASK_READ" ADD COLUMN "COMMENTS" TEXT;
+ALTER TABLE "TASK_READ" ADD COLUMN "ATTACHMENTS" TEXT;
+ALTER TABLE "TASK_READ" ADD COLUMN "MATERIAL_CONSUMPTION" TEXT;
+ALTER TABLE "TASK_READ" ADD COLUMN "DOMAIN_DATA_FINANCE" TEXT;
+
+-- DATA BACKFILLS --
+-- Read models filled from the existing data. They run on every start, so they must be idempotent.
+
+-- The plantings of the crop batches created before the planting history was recorded
+INSERT OR IGNORE INTO "PLANTING_HISTORY_READ"
+    ("AREA_UID", "CROP_UID", "BATCH_ID", "INVENTORY_UID", "VARIETY_NAME", "PLANT_FAMILY", "PLANTED_DATE")
+    SELECT C."INITIAL_AREA_UID", C."UID", C."BATCH_ID", C."INVENTORY_UID", C."INVENTORY_NAME",
+        COALESCE(M."PLANT_FAMILY", ''), C."INITIAL_AREA_CREATED_DATE"
+    FROM "CROP_READ" C
+    LEFT JOIN "MATERIAL_READ" M ON M."UID" = C."INVENTORY_UID";
+
+INSERT OR IGNORE INTO "PLANTING_HISTORY_READ"
+    ("AREA_UID", "CROP_UID", "BATCH_ID", "INVENTORY_UID", "VARIETY_NAME", "PLANT_FAMILY", "PLANTED_DATE")
+    SELECT A."AREA_UID", C."UID", C."BATCH_ID", C."INVENTORY_UID", C."INVENTORY_NAME",
+        COALESCE(M."PLANT_FAMILY", ''), A."CREATED_DATE"
+    FROM "CROP_READ_MOVED_AREA" A
+    JOIN "CROP_READ" C ON C."UID" = A."CROP_UID"
+    LEFT JOIN "MATERIAL_READ" M ON M."UID" = C."INVENTORY_UID";
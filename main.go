@@ -21,6 +21,8 @@ import (
 	growthserver "github.com/Tanibox/tania-core/src/growth/server"
 	growthstorage "github.com/Tanibox/tania-core/src/growth/storage"
 	locationserver "github.com/Tanibox/tania-core/src/location/server"
+	"github.com/Tanibox/tania-core/src/notification"
+	"github.com/Tanibox/tania-core/src/scheduler"
 	tasksserver "github.com/Tanibox/tania-core/src/tasks/server"
 	taskstorage "github.com/Tanibox/tania-core/src/tasks/storage"
 	userserver "github.com/Tanibox/tania-core/src/user/server"
@@ -88,12 +90,20 @@ func main() {
 	taskServer, err := tasksserver.NewTaskServer(
 		db,
 		bus,
+		initNotifier(),
+		inMem.farmReadStorage,
 		inMem.cropReadStorage,
 		inMem.areaReadStorage,
 		inMem.materialReadStorage,
 		inMem.reservoirReadStorage,
 		inMem.taskEventStorage,
 		inMem.taskReadStorage,
+		inMem.taskCalendarFeedStorage,
+		inMem.taskTimeEntryReadStorage,
+		inMem.financeLedgerStorage,
+		inMem.taskReminderPreferenceStorage,
+		inMem.taskReminderSentStorage,
+		inMem.successionPlanTaskStorage,
 	)
 	if err != nil {
 		e.Logger.Fatal(err)
@@ -105,6 +115,14 @@ func main() {
 		inMem.cropEventStorage,
 		inMem.cropReadStorage,
 		inMem.cropActivityStorage,
+		inMem.plantingHistoryStorage,
+		inMem.harvestLotStorage,
+		inMem.cropTemplateEventStorage,
+		inMem.cropTemplateReadStorage,
+		inMem.harvestUnitEventStorage,
+		inMem.harvestUnitReadStorage,
+		inMem.successionPlanEventStorage,
+		inMem.successionPlanReadStorage,
 		inMem.areaReadStorage,
 		inMem.materialReadStorage,
 		inMem.farmReadStorage,
@@ -132,6 +150,23 @@ func main() {
 	// Initialize user
 	err = initUser(authServer)
 
+	// Initialize Scheduler
+	taskDueCheckInterval, err := time.ParseDuration(*config.Config.TaskDueCheckInterval)
+	if err != nil {
+		e.Logger.Fatal(err)
+	}
+
+	taskReminderCheckInterval, err := time.ParseDuration(*config.Config.TaskReminderCheckInterval)
+	if err != nil {
+		e.Logger.Fatal(err)
+	}
+
+	jobScheduler := initScheduler(db)
+	jobScheduler.Every("task_due_check", taskDueCheckInterval, taskServer.MarkDueTasks)
+	jobScheduler.Every("task_reminder_check", taskReminderCheckInterval, taskServer.SendTaskReminders)
+	jobScheduler.Start()
+	defer jobScheduler.Stop()
+
 	// Initialize Echo Middleware
 	e.Use(middleware.Recover())
 	e.Use(headerNoCache)
@@ -161,6 +196,10 @@ func main() {
 	taskGroup := API.Group("/tasks", APIMiddlewares...)
 	taskServer.Mount(taskGroup)
 
+	// The calendar apps can't send the authorization header, so the feed is protected by its own token
+	calendarGroup := API.Group("/calendar")
+	taskServer.MountCalendar(calendarGroup)
+
 	userGroup := API.Group("/user", APIMiddlewares...)
 	userServer.Mount(userGroup)
 
@@ -197,19 +236,35 @@ func headerNoCache(next echo.HandlerFunc) echo.HandlerFunc {
 }
 
 type InMemory struct {
-	farmEventStorage      *assetsstorage.FarmEventStorage
-	farmReadStorage       *assetsstorage.FarmReadStorage
-	areaEventStorage      *assetsstorage.AreaEventStorage
-	areaReadStorage       *assetsstorage.AreaReadStorage
-	reservoirEventStorage *assetsstorage.ReservoirEventStorage
-	reservoirReadStorage  *assetsstorage.ReservoirReadStorage
-	materialEventStorage  *assetsstorage.MaterialEventStorage
-	materialReadStorage   *assetsstorage.MaterialReadStorage
-	cropEventStorage      *growthstorage.CropEventStorage
-	cropReadStorage       *growthstorage.CropReadStorage
-	cropActivityStorage   *growthstorage.CropActivityStorage
-	taskEventStorage      *taskstorage.TaskEventStorage
-	taskReadStorage       *taskstorage.TaskReadStorage
+	farmEventStorage         *assetsstorage.FarmEventStorage
+	farmReadStorage          *assetsstorage.FarmReadStorage
+	areaEventStorage         *assetsstorage.AreaEventStorage
+	areaReadStorage          *assetsstorage.AreaReadStorage
+	reservoirEventStorage    *assetsstorage.ReservoirEventStorage
+	reservoirReadStorage     *assetsstorage.ReservoirReadStorage
+	materialEventStorage     *assetsstorage.MaterialEventStorage
+	materialReadStorage      *assetsstorage.MaterialReadStorage
+	cropEventStorage         *growthstorage.CropEventStorage
+	cropReadStorage          *growthstorage.CropReadStorage
+	cropActivityStorage      *growthstorage.CropActivityStorage
+	plantingHistoryStorage   *growthstorage.PlantingHistoryStorage
+	harvestLotStorage        *growthstorage.HarvestLotStorage
+	cropTemplateEventStorage *growthstorage.CropTemplateEventStorage
+	cropTemplateReadStorage  *growthstorage.CropTemplateReadStorage
+	harvestUnitEventStorage  *growthstorage.HarvestUnitEventStorage
+	harvestUnitReadStorage   *growthstorage.HarvestUnitReadStorage
+
+	successionPlanEventStorage *growthstorage.SuccessionPlanEventStorage
+	successionPlanReadStorage  *growthstorage.SuccessionPlanReadStorage
+	taskEventStorage           *taskstorage.TaskEventStorage
+	taskReadStorage            *taskstorage.TaskReadStorage
+	taskCalendarFeedStorage    *taskstorage.TaskCalendarFeedStorage
+	taskTimeEntryReadStorage   *taskstorage.TaskTimeEntryReadStorage
+	financeLedgerStorage       *taskstorage.FinanceLedgerStorage
+
+	taskReminderPreferenceStorage *taskstorage.TaskReminderPreferenceStorage
+	taskReminderSentStorage       *taskstorage.TaskReminderSentStorage
+	successionPlanTaskStorage     *taskstorage.SuccessionPlanTaskStorage
 }
 
 func initInMemory() *InMemory {
@@ -226,12 +281,31 @@ func initInMemory() *InMemory {
 		materialEventStorage: assetsstorage.CreateMaterialEventStorage(),
 		materialReadStorage:  assetsstorage.CreateMaterialReadStorage(),
 
-		cropEventStorage:    growthstorage.CreateCropEventStorage(),
-		cropReadStorage:     growthstorage.CreateCropReadStorage(),
-		cropActivityStorage: growthstorage.CreateCropActivityStorage(),
+		cropEventStorage:       growthstorage.CreateCropEventStorage(),
+		cropReadStorage:        growthstorage.CreateCropReadStorage(),
+		cropActivityStorage:    growthstorage.CreateCropActivityStorage(),
+		plantingHistoryStorage: growthstorage.CreatePlantingHistoryStorage(),
+		harvestLotStorage:      growthstorage.CreateHarvestLotStorage(),
+
+		cropTemplateEventStorage: growthstorage.CreateCropTemplateEventStorage(),
+		cropTemplateReadStorage:  growthstorage.CreateCropTemplateReadStorage(),
+
+		harvestUnitEventStorage: growthstorage.CreateHarvestUnitEventStorage(),
+		harvestUnitReadStorage:  growthstorage.CreateHarvestUnitReadStorage(),
+
+		successionPlanEventStorage: growthstorage.CreateSuccessionPlanEventStorage(),
+		successionPlanReadStorage:  growthstorage.CreateSuccessionPlanReadStorage(),
 
 		taskEventStorage: taskstorage.CreateTaskEventStorage(),
 		taskReadStorage:  taskstorage.CreateTaskReadStorage(),
+
+		taskCalendarFeedStorage:  taskstorage.CreateTaskCalendarFeedStorage(),
+		taskTimeEntryReadStorage: taskstorage.CreateTaskTimeEntryReadStorage(),
+		financeLedgerStorage:     taskstorage.CreateFinanceLedgerStorage(),
+
+		taskReminderPreferenceStorage: taskstorage.CreateTaskReminderPreferenceStorage(),
+		taskReminderSentStorage:       taskstorage.CreateTaskReminderSentStorage(),
+		successionPlanTaskStorage:     taskstorage.CreateSuccessionPlanTaskStorage(),
 	}
 }
 
@@ -277,8 +351,10 @@ func initMysql() *sql.DB {
 				// http://dev.mysql.com/doc/refman/5.7/en/error-messages-server.html
 				// We will skip error duplicate key name in database (code: 1061),
 				// because CREATE INDEX doesn't have IF NOT EXISTS clause,
+				// and error duplicate column name (code: 1060),
+				// because ALTER TABLE ADD COLUMN doesn't have IF NOT EXISTS clause either,
 				// otherwise we will stop the loop and print the error
-				if me.Number == 1061 {
+				if me.Number == 1061 || me.Number == 1060 {
 
 				} else {
 					log.Print(err)
@@ -293,6 +369,48 @@ func initMysql() *sql.DB {
 	return db
 }
 
+// initScheduler uses a lock stored in the database, so only one of the instances
+// sharing the same database runs each job
+func initScheduler(db *sql.DB) *scheduler.Scheduler {
+	var lock scheduler.Lock
+
+	switch *config.Config.TaniaPersistenceEngine {
+	case config.DB_SQLITE:
+		lock = scheduler.NewLockSqlite(db)
+	case config.DB_MYSQL:
+		lock = scheduler.NewLockMysql(db)
+	default:
+		lock = scheduler.NewLockInMemory()
+	}
+
+	return scheduler.NewScheduler(lock)
+}
+
+// initNotifier registers the notification channels which are configured
+func initNotifier() *notification.Notifier {
+	notifier := notification.NewNotifier()
+
+	if *config.Config.NotificationWebhook {
+		notifier.Register(notification.ChannelWebhook, notification.NewWebhookChannel(*config.Config.NotificationWebhookLocal))
+	}
+
+	if *config.Config.SmtpHost != "" {
+		notifier.Register(notification.ChannelEmail, notification.NewSMTPChannel(
+			*config.Config.SmtpHost,
+			*config.Config.SmtpPort,
+			*config.Config.SmtpUsername,
+			*config.Config.SmtpPassword,
+			*config.Config.SmtpFrom,
+		))
+	}
+
+	if *config.Config.NotificationFilePath != "" {
+		notifier.Register(notification.ChannelFile, notification.NewFileChannel(*config.Config.NotificationFilePath))
+	}
+
+	return notifier
+}
+
 func initSqlite() *sql.DB {
 	if _, err := os.Stat(*config.Config.SqlitePath); os.IsNotExist(err) {
 		log.Print("Creating database file ", *config.Config.SqlitePath)
@@ -311,11 +429,20 @@ func initSqlite() *sql.DB {
 	if err != nil {
 		panic(err)
 	}
-	sql := string(ddl)
+	sqls := string(ddl)
 
-	_, err = db.Exec(sql)
-	if err != nil {
-		panic(err)
+	// We execute the DDL query one by one, so the duplicate column error
+	// from ALTER TABLE ADD COLUMN of an existing database can be skipped,
+	// because SQLite doesn't have IF NOT EXISTS clause for it
+	for _, v := range strings.Split(sqls, ";") {
+		trimmed := strings.TrimSpace(v)
+
+		if len(trimmed) > 0 {
+			_, err = db.Exec(v)
+			if err != nil && !strings.Contains(err.Error(), "duplicate column name") {
+				panic(err)
+			}
+		}
 	}
 
 	log.Print("DDL file executed")
@@ -109,6 +109,26 @@ func (w *MaterialEventWrapper) UnmarshalJSON(b []byte) error {
 			return err
 		}
 
+		w.EventData = e
+
+	case "MaterialPlantFamilyChanged":
+		e := domain.MaterialPlantFamilyChanged{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.EventData = e
+
+	case "MaterialConsumed":
+		e := domain.MaterialConsumed{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
 		w.EventData = e
 	}
 
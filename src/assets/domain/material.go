@@ -16,6 +16,7 @@ type Material struct {
 	ExpirationDate *time.Time       `json:"expiration_date"`
 	Notes          *string          `json:"notes"`
 	ProducedBy     *string          `json:"produced_by"`
+	PlantFamily    string           `json:"plant_family"`
 	CreatedDate    time.Time        `json:"created_date"`
 
 	// Events
@@ -75,6 +76,12 @@ const (
 	MaterialUnitUnits      = "UNITS"
 )
 
+// Reference types of a material consumption, telling what the material is consumed for
+const (
+	MaterialConsumptionCropBatch = "CROP_BATCH"
+	MaterialConsumptionTask      = "TASK"
+)
+
 type MaterialQuantity struct {
 	Value float32              `json:"value"`
 	Unit  MaterialQuantityUnit `json:"unit"`
@@ -180,6 +187,15 @@ func (state *Material) Transition(event interface{}) {
 	case MaterialProducedByChanged:
 		state.ProducedBy = &e.ProducedBy
 
+	case MaterialPlantFamilyChanged:
+		state.PlantFamily = e.PlantFamily
+
+	case MaterialConsumed:
+		state.Quantity.Value -= e.Quantity.Value
+		if state.Quantity.Value < 0 {
+			state.Quantity.Value = 0
+		}
+
 	}
 }
 
@@ -336,6 +352,53 @@ func (m *Material) ChangeProducedBy(producedBy string) error {
 	return nil
 }
 
+// ChangePlantFamily sets the botanical family of a seed or plant material,
+// which is used to check the crop rotation of the areas it is planted in.
+func (m *Material) ChangePlantFamily(plantFamily string) error {
+	switch m.Type.(type) {
+	case MaterialTypeSeed, MaterialTypePlant:
+	default:
+		return MaterialError{MaterialErrorInvalidPlantFamily}
+	}
+
+	if GetPlantFamily(plantFamily) == (PlantFamily{}) {
+		return MaterialError{MaterialErrorInvalidPlantFamily}
+	}
+
+	m.TrackChange(MaterialPlantFamilyChanged{
+		MaterialUID: m.UID,
+		PlantFamily: plantFamily,
+	})
+
+	return nil
+}
+
+// Consume deducts the quantity from the material stock.
+// The event keeps the requested quantity, even when it is more than the stock,
+// but the stock never goes below zero.
+func (m *Material) Consume(quantity float32, quantityUnit string, referenceUID uuid.UUID, referenceType string) error {
+	if quantity <= 0 {
+		return MaterialError{MaterialErrorInvalidConsumedQuantity}
+	}
+
+	if quantityUnit != m.Quantity.Unit.Code {
+		return MaterialError{MaterialErrorInvalidConsumedQuantityUnit}
+	}
+
+	m.TrackChange(MaterialConsumed{
+		MaterialUID: m.UID,
+		Quantity: MaterialQuantity{
+			Value: quantity,
+			Unit:  m.Quantity.Unit,
+		},
+		ReferenceUID:  referenceUID,
+		ReferenceType: referenceType,
+		ConsumedDate:  time.Now(),
+	})
+
+	return nil
+}
+
 func validateQuantity(quantity float32) error {
 	if quantity <= 0 {
 		return errors.New("Cannot be empty")
@@ -2,6 +2,9 @@ package domain
 
 const (
 	MaterialErrorInvalidMaterialType = iota
+	MaterialErrorInvalidConsumedQuantity
+	MaterialErrorInvalidConsumedQuantityUnit
+	MaterialErrorInvalidPlantFamily
 )
 
 // MaterialError is a custom error from Go built-in error
@@ -13,6 +16,12 @@ func (e MaterialError) Error() string {
 	switch e.Code {
 	case MaterialErrorInvalidMaterialType:
 		return "Invalid material type"
+	case MaterialErrorInvalidConsumedQuantity:
+		return "Invalid consumed quantity"
+	case MaterialErrorInvalidConsumedQuantityUnit:
+		return "Consumed quantity unit is different from the material quantity unit"
+	case MaterialErrorInvalidPlantFamily:
+		return "Invalid plant family. Only seed and plant materials have plant family"
 	default:
 		return "Unrecognized Material Error Code"
 	}
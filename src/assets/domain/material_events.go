@@ -53,3 +53,16 @@ type MaterialProducedByChanged struct {
 	MaterialUID uuid.UUID
 	ProducedBy  string
 }
+
+type MaterialPlantFamilyChanged struct {
+	MaterialUID uuid.UUID
+	PlantFamily string
+}
+
+type MaterialConsumed struct {
+	MaterialUID   uuid.UUID
+	Quantity      MaterialQuantity
+	ReferenceUID  uuid.UUID
+	ReferenceType string
+	ConsumedDate  time.Time
+}
@@ -3,6 +3,7 @@ package domain
 import (
 	"testing"
 
+	uuid "github.com/satori/go.uuid"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -96,3 +97,68 @@ func TestCreateInventorySeed(t *testing.T) {
 	assert.Equal(t, true, ok)
 	assert.Equal(t, MaterialTypeOtherCode, mo.Code())
 }
+
+func TestConsumeMaterial(t *testing.T) {
+	// Given
+	mts, _ := CreateMaterialTypeSeed(PlantTypeVegetable)
+	material, _ := CreateMaterial("Bayam Lu Hsieh", "12", MoneyEUR, mts, 100, MaterialUnitSeeds, nil, nil, nil)
+	cropUID, _ := uuid.NewV4()
+
+	// When
+	err := material.Consume(30, MaterialUnitSeeds, cropUID, MaterialConsumptionCropBatch)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, float32(70), material.Quantity.Value)
+
+	// When
+	err = material.Consume(10, MaterialUnitPackets, cropUID, MaterialConsumptionCropBatch)
+
+	// Then
+	assert.Equal(t, MaterialError{MaterialErrorInvalidConsumedQuantityUnit}, err)
+
+	// When
+	err = material.Consume(0, MaterialUnitSeeds, cropUID, MaterialConsumptionCropBatch)
+
+	// Then
+	assert.Equal(t, MaterialError{MaterialErrorInvalidConsumedQuantity}, err)
+
+	// When
+	err = material.Consume(100, MaterialUnitSeeds, cropUID, MaterialConsumptionCropBatch)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, float32(0), material.Quantity.Value)
+
+	event, ok := material.UncommittedChanges[len(material.UncommittedChanges)-1].(MaterialConsumed)
+	assert.True(t, ok)
+	assert.Equal(t, float32(100), event.Quantity.Value)
+}
+
+func TestChangeMaterialPlantFamily(t *testing.T) {
+	// Given
+	mts, _ := CreateMaterialTypeSeed(PlantTypeVegetable)
+	seed, _ := CreateMaterial("Tomato Super One", "12", MoneyEUR, mts, 100, MaterialUnitSeeds, nil, nil, nil)
+
+	mta := MaterialTypeGrowingMedium{}
+	medium, _ := CreateMaterial("Organic Soil", "12", MoneyEUR, mta, 10, MaterialUnitBags, nil, nil, nil)
+
+	// When
+	err := seed.ChangePlantFamily(PlantFamilySolanaceae)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, PlantFamilySolanaceae, seed.PlantFamily)
+
+	// When
+	err = seed.ChangePlantFamily("ROSACEAE")
+
+	// Then
+	assert.Equal(t, MaterialError{MaterialErrorInvalidPlantFamily}, err)
+
+	// When
+	err = medium.ChangePlantFamily(PlantFamilySolanaceae)
+
+	// Then
+	assert.Equal(t, MaterialError{MaterialErrorInvalidPlantFamily}, err)
+}
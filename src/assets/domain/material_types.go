@@ -65,6 +65,51 @@ func GetPlantType(code string) PlantType {
 	return PlantType{}
 }
 
+type PlantFamily struct {
+	Code  string `json:"code"`
+	Label string `json:"label"`
+}
+
+const (
+	PlantFamilySolanaceae     = "SOLANACEAE"
+	PlantFamilyBrassicaceae   = "BRASSICACEAE"
+	PlantFamilyFabaceae       = "FABACEAE"
+	PlantFamilyCucurbitaceae  = "CUCURBITACEAE"
+	PlantFamilyApiaceae       = "APIACEAE"
+	PlantFamilyAmaryllidaceae = "AMARYLLIDACEAE"
+	PlantFamilyAsteraceae     = "ASTERACEAE"
+	PlantFamilyAmaranthaceae  = "AMARANTHACEAE"
+	PlantFamilyPoaceae        = "POACEAE"
+	PlantFamilyLamiaceae      = "LAMIACEAE"
+	PlantFamilyOther          = "OTHER"
+)
+
+func PlantFamilies() []PlantFamily {
+	return []PlantFamily{
+		{Code: PlantFamilySolanaceae, Label: "Solanaceae (Tomato, Potato, Pepper)"},
+		{Code: PlantFamilyBrassicaceae, Label: "Brassicaceae (Cabbage, Broccoli, Radish)"},
+		{Code: PlantFamilyFabaceae, Label: "Fabaceae (Bean, Pea)"},
+		{Code: PlantFamilyCucurbitaceae, Label: "Cucurbitaceae (Cucumber, Squash, Melon)"},
+		{Code: PlantFamilyApiaceae, Label: "Apiaceae (Carrot, Celery, Parsley)"},
+		{Code: PlantFamilyAmaryllidaceae, Label: "Amaryllidaceae (Onion, Garlic, Leek)"},
+		{Code: PlantFamilyAsteraceae, Label: "Asteraceae (Lettuce, Sunflower)"},
+		{Code: PlantFamilyAmaranthaceae, Label: "Amaranthaceae (Spinach, Beet, Amaranth)"},
+		{Code: PlantFamilyPoaceae, Label: "Poaceae (Corn, Rice)"},
+		{Code: PlantFamilyLamiaceae, Label: "Lamiaceae (Basil, Mint)"},
+		{Code: PlantFamilyOther, Label: "Other"},
+	}
+}
+
+func GetPlantFamily(code string) PlantFamily {
+	for _, v := range PlantFamilies() {
+		if v.Code == code {
+			return v
+		}
+	}
+
+	return PlantFamily{}
+}
+
 type MaterialTypeAgrochemical struct {
 	ChemicalType ChemicalType
 }
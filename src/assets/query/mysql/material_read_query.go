@@ -34,6 +34,7 @@ type materialReadResult struct {
 	Notes          sql.NullString
 	ProducedBy     sql.NullString
 	CreatedDate    time.Time
+	PlantFamily    sql.NullString
 }
 
 func (q MaterialReadQueryMysql) FindAll(materialType, materialTypeDetail string, page, limit int) <-chan query.QueryResult {
@@ -96,6 +97,7 @@ func (q MaterialReadQueryMysql) FindAll(materialType, materialTypeDetail string,
 				&rowsData.Notes,
 				&rowsData.ProducedBy,
 				&rowsData.CreatedDate,
+				&rowsData.PlantFamily,
 			)
 
 			if err != nil {
@@ -183,6 +185,7 @@ func (q MaterialReadQueryMysql) FindAll(materialType, materialTypeDetail string,
 				ExpirationDate: mExpDate,
 				Notes:          notes,
 				ProducedBy:     producedBy,
+				PlantFamily:    rowsData.PlantFamily.String,
 				CreatedDate:    rowsData.CreatedDate,
 			})
 		}
@@ -258,6 +261,7 @@ func (q MaterialReadQueryMysql) FindByID(materialUID uuid.UUID) <-chan query.Que
 			&rowsData.Notes,
 			&rowsData.ProducedBy,
 			&rowsData.CreatedDate,
+			&rowsData.PlantFamily,
 		)
 
 		if err != nil && err != sql.ErrNoRows {
@@ -349,6 +353,7 @@ func (q MaterialReadQueryMysql) FindByID(materialUID uuid.UUID) <-chan query.Que
 			ExpirationDate: mExpDate,
 			Notes:          notes,
 			ProducedBy:     producedBy,
+			PlantFamily:    rowsData.PlantFamily.String,
 			CreatedDate:    rowsData.CreatedDate,
 		}
 
@@ -34,6 +34,7 @@ type materialReadResult struct {
 	Notes          sql.NullString
 	ProducedBy     sql.NullString
 	CreatedDate    string
+	PlantFamily    sql.NullString
 }
 
 func (q MaterialReadQuerySqlite) FindAll(materialType, materialTypeDetail string, page, limit int) <-chan query.QueryResult {
@@ -97,6 +98,7 @@ func (q MaterialReadQuerySqlite) FindAll(materialType, materialTypeDetail string
 				&rowsData.Notes,
 				&rowsData.ProducedBy,
 				&rowsData.CreatedDate,
+				&rowsData.PlantFamily,
 			)
 
 			if err != nil {
@@ -189,6 +191,7 @@ func (q MaterialReadQuerySqlite) FindAll(materialType, materialTypeDetail string
 				ExpirationDate: mExpDate,
 				Notes:          notes,
 				ProducedBy:     producedBy,
+				PlantFamily:    rowsData.PlantFamily.String,
 				CreatedDate:    mCreatedDate,
 			})
 		}
@@ -264,6 +267,7 @@ func (q MaterialReadQuerySqlite) FindByID(materialUID uuid.UUID) <-chan query.Qu
 			&rowsData.Notes,
 			&rowsData.ProducedBy,
 			&rowsData.CreatedDate,
+			&rowsData.PlantFamily,
 		)
 
 		if err != nil && err != sql.ErrNoRows {
@@ -360,6 +364,7 @@ func (q MaterialReadQuerySqlite) FindByID(materialUID uuid.UUID) <-chan query.Qu
 			ExpirationDate: mExpDate,
 			Notes:          notes,
 			ProducedBy:     producedBy,
+			PlantFamily:    rowsData.PlantFamily.String,
 			CreatedDate:    mCreatedDate,
 		}
 
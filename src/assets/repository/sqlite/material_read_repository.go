@@ -48,7 +48,7 @@ func (f *MaterialReadRepositorySqlite) Save(materialRead *storage.MaterialRead)
 			_, err = f.DB.Exec(`UPDATE MATERIAL_READ SET
 				NAME = ?, PRICE_PER_UNIT = ?, CURRENCY_CODE = ?, TYPE = ?, TYPE_DATA = ?,
 				QUANTITY = ?, QUANTITY_UNIT = ?, EXPIRATION_DATE = ?, NOTES = ?,
-				PRODUCED_BY = ?, CREATED_DATE = ?
+				PRODUCED_BY = ?, CREATED_DATE = ?, PLANT_FAMILY = ?
 				WHERE UID = ?`,
 				materialRead.Name,
 				materialRead.PricePerUnit.Amount,
@@ -61,6 +61,7 @@ func (f *MaterialReadRepositorySqlite) Save(materialRead *storage.MaterialRead)
 				materialRead.Notes,
 				materialRead.ProducedBy,
 				materialRead.CreatedDate.Format(time.RFC3339),
+				materialRead.PlantFamily,
 				materialRead.UID)
 
 			if err != nil {
@@ -70,8 +71,8 @@ func (f *MaterialReadRepositorySqlite) Save(materialRead *storage.MaterialRead)
 		} else {
 			_, err = f.DB.Exec(`INSERT INTO MATERIAL_READ
 				(UID, NAME, PRICE_PER_UNIT, CURRENCY_CODE, TYPE, TYPE_DATA, QUANTITY,
-				QUANTITY_UNIT, EXPIRATION_DATE, NOTES, PRODUCED_BY, CREATED_DATE)
-				VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+				QUANTITY_UNIT, EXPIRATION_DATE, NOTES, PRODUCED_BY, CREATED_DATE, PLANT_FAMILY)
+				VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
 				materialRead.UID,
 				materialRead.Name,
 				materialRead.PricePerUnit.Amount,
@@ -83,7 +84,8 @@ func (f *MaterialReadRepositorySqlite) Save(materialRead *storage.MaterialRead)
 				expirationDate,
 				materialRead.Notes,
 				materialRead.ProducedBy,
-				materialRead.CreatedDate.Format(time.RFC3339))
+				materialRead.CreatedDate.Format(time.RFC3339),
+				materialRead.PlantFamily)
 
 			if err != nil {
 				result <- err
@@ -214,6 +214,11 @@ func (s *FarmServer) InitSubscriber() {
 	s.EventBus.Subscribe("MaterialExpirationDateChanged", s.SaveToMaterialReadModel)
 	s.EventBus.Subscribe("MaterialNotesChanged", s.SaveToMaterialReadModel)
 	s.EventBus.Subscribe("MaterialProducedByChanged", s.SaveToMaterialReadModel)
+	s.EventBus.Subscribe("MaterialPlantFamilyChanged", s.SaveToMaterialReadModel)
+	s.EventBus.Subscribe("MaterialConsumed", s.SaveToMaterialReadModel)
+
+	s.EventBus.Subscribe("CropBatchInventoryConsumed", s.ConsumeMaterialForCropBatch)
+	s.EventBus.Subscribe("TaskMaterialConsumed", s.ConsumeMaterialForTask)
 
 }
 
@@ -223,6 +228,7 @@ func (s *FarmServer) Mount(g *echo.Group) {
 	g.GET("/inventories/materials", s.GetMaterials)
 	g.GET("/inventories/materials/simple", s.GetMaterialsSimple)
 	g.GET("/inventories/plant_types", s.GetInventoryPlantTypes)
+	g.GET("/inventories/plant_families", s.GetInventoryPlantFamilies)
 	g.GET("/inventories/materials/available_plant_type", s.GetAvailableMaterialPlantType)
 	g.POST("/inventories/materials/:type", s.SaveMaterial)
 	g.PUT("/inventories/materials/:type/:id", s.UpdateMaterial)
@@ -809,6 +815,11 @@ func (s *FarmServer) SaveArea(c echo.Context) error {
 			return Error(c, err)
 		}
 
+		err = imagehelper.CreateThumbnails(destPath)
+		if err != nil {
+			return Error(c, err)
+		}
+
 		areaPhoto := domain.AreaPhoto{
 			Filename: photo.Filename,
 			MimeType: photo.Header["Content-Type"][0],
@@ -947,6 +958,11 @@ func (s *FarmServer) UpdateArea(c echo.Context) error {
 			return Error(c, err)
 		}
 
+		err = imagehelper.CreateThumbnails(destPath)
+		if err != nil {
+			return Error(c, err)
+		}
+
 		areaPhoto := domain.AreaPhoto{
 			Filename: photo.Filename,
 			MimeType: photo.Header["Content-Type"][0],
@@ -1200,6 +1216,11 @@ func (s *FarmServer) GetAreaPhotos(c echo.Context) error {
 		return Error(c, err)
 	}
 
+	size := c.QueryParam("size")
+	if size != "" && !imagehelper.IsValidThumbnailSize(size) {
+		return Error(c, NewRequestValidationError(INVALID_OPTION, "size"))
+	}
+
 	queryResult := <-s.FarmReadQuery.FindByID(farmUID)
 	if queryResult.Error != nil {
 		return Error(c, queryResult.Error)
@@ -1235,6 +1256,13 @@ func (s *FarmServer) GetAreaPhotos(c echo.Context) error {
 	// Process //
 	srcPath := stringhelper.Join(*config.Config.UploadPathArea, "/", areaRead.Photo.Filename)
 
+	if size != "" {
+		srcPath, err = imagehelper.GetThumbnail(srcPath, size)
+		if err != nil {
+			return Error(c, err)
+		}
+	}
+
 	return c.File(srcPath)
 }
 
@@ -1270,6 +1298,14 @@ func (s *FarmServer) GetInventoryPlantTypes(c echo.Context) error {
 	return c.JSON(http.StatusOK, data)
 }
 
+func (s *FarmServer) GetInventoryPlantFamilies(c echo.Context) error {
+	data := make(map[string][]domain.PlantFamily)
+
+	data["data"] = domain.PlantFamilies()
+
+	return c.JSON(http.StatusOK, data)
+}
+
 func (s *FarmServer) GetMaterials(c echo.Context) error {
 	materialType := c.QueryParam("type")
 	materialTypeDetail := c.QueryParam("type_detail")
@@ -1351,6 +1387,7 @@ func (s *FarmServer) SaveMaterial(c echo.Context) error {
 	expirationDate := c.FormValue("expiration_date")
 	notes := c.FormValue("notes")
 	producedBy := c.FormValue("produced_by")
+	plantFamily := c.FormValue("plant_family")
 
 	// Validate //
 	q, err := strconv.ParseFloat(quantity, 32)
@@ -1438,6 +1475,13 @@ func (s *FarmServer) SaveMaterial(c echo.Context) error {
 		return Error(c, err)
 	}
 
+	if plantFamily != "" {
+		err = material.ChangePlantFamily(plantFamily)
+		if err != nil {
+			return Error(c, NewRequestValidationError(INVALID_OPTION, "plant_family"))
+		}
+	}
+
 	// Persist //
 	err = <-s.MaterialEventRepo.Save(material.UID, material.Version, material.UncommittedChanges)
 	if err != nil {
@@ -1474,6 +1518,7 @@ func (s *FarmServer) UpdateMaterial(c echo.Context) error {
 	expirationDate := c.FormValue("expiration_date")
 	notes := c.FormValue("notes")
 	producedBy := c.FormValue("produced_by")
+	plantFamily := c.FormValue("plant_family")
 
 	// Validate //
 	if pricePerUnit != "" && currencyCode == "" {
@@ -1628,6 +1673,13 @@ func (s *FarmServer) UpdateMaterial(c echo.Context) error {
 		material.ChangeProducedBy(*pb)
 	}
 
+	if plantFamily != "" {
+		err = material.ChangePlantFamily(plantFamily)
+		if err != nil {
+			return Error(c, NewRequestValidationError(INVALID_OPTION, "plant_family"))
+		}
+	}
+
 	// Persist //
 	err = <-s.MaterialEventRepo.Save(material.UID, material.Version, material.UncommittedChanges)
 	if err != nil {
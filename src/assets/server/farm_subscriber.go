@@ -4,7 +4,10 @@ import (
 	"errors"
 
 	"github.com/Tanibox/tania-core/src/assets/domain"
+	"github.com/Tanibox/tania-core/src/assets/repository"
 	"github.com/Tanibox/tania-core/src/assets/storage"
+	growthdomain "github.com/Tanibox/tania-core/src/growth/domain"
+	taskdomain "github.com/Tanibox/tania-core/src/tasks/domain"
 	"github.com/labstack/gommon/log"
 )
 
@@ -550,6 +553,39 @@ func (s *FarmServer) SaveToMaterialReadModel(event interface{}) error {
 		materialRead = &material
 
 		materialRead.ProducedBy = &e.ProducedBy
+
+	case domain.MaterialPlantFamilyChanged:
+		queryResult := <-s.MaterialReadQuery.FindByID(e.MaterialUID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+		}
+
+		material, ok := queryResult.Result.(storage.MaterialRead)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+		}
+
+		materialRead = &material
+
+		materialRead.PlantFamily = e.PlantFamily
+
+	case domain.MaterialConsumed:
+		queryResult := <-s.MaterialReadQuery.FindByID(e.MaterialUID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+		}
+
+		material, ok := queryResult.Result.(storage.MaterialRead)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+		}
+
+		materialRead = &material
+
+		materialRead.Quantity.Value -= e.Quantity.Value
+		if materialRead.Quantity.Value < 0 {
+			materialRead.Quantity.Value = 0
+		}
 	}
 
 	err := <-s.MaterialReadRepo.Save(materialRead)
@@ -559,3 +595,82 @@ func (s *FarmServer) SaveToMaterialReadModel(event interface{}) error {
 
 	return nil
 }
+
+// ConsumeMaterialForCropBatch deducts the material stock consumed by seeding a crop batch
+func (s *FarmServer) ConsumeMaterialForCropBatch(event interface{}) error {
+	e, ok := event.(growthdomain.CropBatchInventoryConsumed)
+	if !ok {
+		log.Error(errors.New("Internal server error. Error type assertion"))
+		return nil
+	}
+
+	eventQueryResult := <-s.MaterialEventQuery.FindAllByID(e.InventoryUID)
+	if eventQueryResult.Error != nil {
+		log.Error(eventQueryResult.Error)
+		return nil
+	}
+
+	events, ok := eventQueryResult.Result.([]storage.MaterialEvent)
+	if !ok || len(events) == 0 {
+		log.Error(errors.New("Material of the crop batch is not found"))
+		return nil
+	}
+
+	material := repository.NewMaterialFromHistory(events)
+
+	err := material.Consume(e.Quantity, e.QuantityUnit, e.UID, domain.MaterialConsumptionCropBatch)
+	if err != nil {
+		log.Error(err)
+		return nil
+	}
+
+	err = <-s.MaterialEventRepo.Save(material.UID, material.Version, material.UncommittedChanges)
+	if err != nil {
+		log.Error(err)
+		return nil
+	}
+
+	s.publishUncommittedEvents(material)
+
+	return nil
+}
+
+// ConsumeMaterialForTask deducts the material stock used by a completed task.
+// The stock stops at zero when the task consumed more than it, which is flagged on the task.
+func (s *FarmServer) ConsumeMaterialForTask(event interface{}) error {
+	e, ok := event.(taskdomain.TaskMaterialConsumed)
+	if !ok {
+		log.Error(errors.New("Internal server error. Error type assertion"))
+		return nil
+	}
+
+	eventQueryResult := <-s.MaterialEventQuery.FindAllByID(e.MaterialID)
+	if eventQueryResult.Error != nil {
+		log.Error(eventQueryResult.Error)
+		return nil
+	}
+
+	events, ok := eventQueryResult.Result.([]storage.MaterialEvent)
+	if !ok || len(events) == 0 {
+		log.Error(errors.New("Material of the task is not found"))
+		return nil
+	}
+
+	material := repository.NewMaterialFromHistory(events)
+
+	err := material.Consume(e.Quantity, e.QuantityUnit, e.UID, domain.MaterialConsumptionTask)
+	if err != nil {
+		log.Error(err)
+		return nil
+	}
+
+	err = <-s.MaterialEventRepo.Save(material.UID, material.Version, material.UncommittedChanges)
+	if err != nil {
+		log.Error(err)
+		return nil
+	}
+
+	s.publishUncommittedEvents(material)
+
+	return nil
+}
@@ -76,15 +76,16 @@ type MaterialSimple struct {
 }
 
 type Material struct {
-	UID            uuid.UUID        `json:"uid"`
-	Name           string           `json:"name"`
-	PricePerUnit   PricePerUnit     `json:"price_per_unit"`
-	Type           MaterialType     `json:"type"`
-	Quantity       MaterialQuantity `json:"quantity"`
-	ExpirationDate *time.Time       `json:"expiration_date,omitempty"`
-	Notes          *string          `json:"notes"`
-	ProducedBy     *string          `json:"produced_by"`
-	CreatedDate    time.Time        `json:"created_date"`
+	UID            uuid.UUID           `json:"uid"`
+	Name           string              `json:"name"`
+	PricePerUnit   PricePerUnit        `json:"price_per_unit"`
+	Type           MaterialType        `json:"type"`
+	Quantity       MaterialQuantity    `json:"quantity"`
+	ExpirationDate *time.Time          `json:"expiration_date,omitempty"`
+	Notes          *string             `json:"notes"`
+	ProducedBy     *string             `json:"produced_by"`
+	PlantFamily    *domain.PlantFamily `json:"plant_family"`
+	CreatedDate    time.Time           `json:"created_date"`
 }
 
 type PricePerUnit struct {
@@ -510,6 +511,12 @@ func MapToMaterial(material domain.Material) Material {
 		m.ProducedBy = material.ProducedBy
 	}
 
+	m.PlantFamily = nil
+	if material.PlantFamily != "" {
+		pf := domain.GetPlantFamily(material.PlantFamily)
+		m.PlantFamily = &pf
+	}
+
 	m.CreatedDate = material.CreatedDate
 
 	return m
@@ -588,6 +595,12 @@ func MapToMaterialFromRead(material storage.MaterialRead) Material {
 		m.ProducedBy = material.ProducedBy
 	}
 
+	m.PlantFamily = nil
+	if material.PlantFamily != "" {
+		pf := domain.GetPlantFamily(material.PlantFamily)
+		m.PlantFamily = &pf
+	}
+
 	m.CreatedDate = material.CreatedDate
 
 	return m
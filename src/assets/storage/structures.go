@@ -113,6 +113,7 @@ type MaterialRead struct {
 	Notes          *string          `json:"notes"`
 	IsExpense      *bool            `json:"is_expense"`
 	ProducedBy     *string          `json:"produced_by"`
+	PlantFamily    string           `json:"plant_family"`
 	CreatedDate    time.Time        `json:"created_date"`
 }
 
@@ -141,6 +141,46 @@ func (w *CropActivityTypeWrapper) UnmarshalJSON(b []byte) error {
 
 		w.Data = a
 
+	case storage.HarvestCorrectionActivityCode:
+		a := storage.HarvestCorrectionActivity{}
+
+		_, err := Decode(f, &mapped, &a)
+		if err != nil {
+			return err
+		}
+
+		w.Data = a
+
+	case storage.DumpRevertActivityCode:
+		a := storage.DumpRevertActivity{}
+
+		_, err := Decode(f, &mapped, &a)
+		if err != nil {
+			return err
+		}
+
+		w.Data = a
+
+	case storage.MoveRevertActivityCode:
+		a := storage.MoveRevertActivity{}
+
+		_, err := Decode(f, &mapped, &a)
+		if err != nil {
+			return err
+		}
+
+		w.Data = a
+
+	case storage.UnarchiveActivityCode:
+		a := storage.UnarchiveActivity{}
+
+		_, err := Decode(f, &mapped, &a)
+		if err != nil {
+			return err
+		}
+
+		w.Data = a
+
 	}
 
 	return nil
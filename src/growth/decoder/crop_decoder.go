@@ -190,6 +190,14 @@ func (w *CropEventWrapper) UnmarshalJSON(b []byte) error {
 
 			e.UpdatedHarvestedStorage = harvestedStorage
 		}
+		if v, ok := mapped["HarvestLot"]; ok {
+			harvestLot, err := makeHarvestLot(v)
+			if err != nil {
+				return err
+			}
+
+			e.HarvestLot = harvestLot
+		}
 		if v, ok := mapped["HarvestedArea"]; ok {
 			code, ok2 := mapped["HarvestedAreaCode"].(string)
 			if !ok2 {
@@ -264,6 +272,22 @@ func (w *CropEventWrapper) UnmarshalJSON(b []byte) error {
 
 				trash.LastUpdated = val
 			}
+			if v2, ok2 := mapped2["reasons"]; ok2 && v2 != nil {
+				val, ok3 := v2.(map[string]interface{})
+				if !ok3 {
+					return errors.New("Error type assertion")
+				}
+
+				trash.Reasons = make(map[string]int)
+				for k, q := range val {
+					qty, ok4 := q.(float64)
+					if !ok4 {
+						return errors.New("Error type assertion")
+					}
+
+					trash.Reasons[k] = int(qty)
+				}
+			}
 
 			e.UpdatedTrash = trash
 		}
@@ -293,6 +317,110 @@ func (w *CropEventWrapper) UnmarshalJSON(b []byte) error {
 
 		w.Data = e
 
+	case "CropBatchHarvestCorrected":
+		e := domain.CropBatchHarvestCorrected{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		if v, ok := mapped["HarvestedArea"]; ok {
+			code, ok2 := mapped["HarvestedAreaCode"].(string)
+			if !ok2 {
+				return errors.New("Error type assertion")
+			}
+
+			area, err := makeCropArea(code, v)
+			if err != nil {
+				return err
+			}
+
+			e.HarvestedArea = area
+		}
+
+		w.Data = e
+
+	case "CropBatchDumpReverted":
+		e := domain.CropBatchDumpReverted{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		if v, ok := mapped["RestoredArea"]; ok {
+			code, ok2 := mapped["RestoredAreaCode"].(string)
+			if !ok2 {
+				return errors.New("Error type assertion")
+			}
+
+			area, err := makeCropArea(code, v)
+			if err != nil {
+				return err
+			}
+
+			e.RestoredArea = area
+		}
+
+		w.Data = e
+
+	case "CropBatchUnarchived":
+		e := domain.CropBatchUnarchived{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		if v, ok := mapped["RestoredArea"]; ok {
+			code, ok2 := mapped["RestoredAreaCode"].(string)
+			if !ok2 {
+				return errors.New("Error type assertion")
+			}
+
+			area, err := makeCropArea(code, v)
+			if err != nil {
+				return err
+			}
+
+			e.RestoredArea = area
+		}
+
+		w.Data = e
+
+	case "CropBatchMoveReverted":
+		e := domain.CropBatchMoveReverted{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		if v, ok := mapped["UpdatedSrcArea"]; ok {
+			code, ok2 := mapped["UpdatedSrcAreaCode"].(string)
+			if !ok2 {
+				return errors.New("Error type assertion")
+			}
+
+			area, err := makeCropArea(code, v)
+			if err != nil {
+				return err
+			}
+
+			e.UpdatedSrcArea = area
+		}
+		if v, ok := mapped["UpdatedDstArea"]; ok {
+			movedArea, err := makeCropMovedArea(v)
+			if err != nil {
+				return err
+			}
+
+			e.UpdatedDstArea = movedArea
+		}
+
+		w.Data = e
+
 	case "CropBatchWatered":
 		e := domain.CropBatchWatered{}
 
@@ -303,6 +431,26 @@ func (w *CropEventWrapper) UnmarshalJSON(b []byte) error {
 
 		w.Data = e
 
+	case "CropBatchInventoryConsumed":
+		e := domain.CropBatchInventoryConsumed{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.Data = e
+
+	case "CropBatchTemplateApplied":
+		e := domain.CropBatchTemplateApplied{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.Data = e
+
 	case "CropBatchPhotoCreated":
 		e := domain.CropBatchPhotoCreated{}
 
@@ -313,6 +461,26 @@ func (w *CropEventWrapper) UnmarshalJSON(b []byte) error {
 
 		w.Data = e
 
+	case "CropBatchPhotoRemoved":
+		e := domain.CropBatchPhotoRemoved{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.Data = e
+
+	case "CropBatchPhotoDescriptionChanged":
+		e := domain.CropBatchPhotoDescriptionChanged{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.Data = e
+
 	case "CropBatchNoteCreated":
 		e := domain.CropBatchNoteCreated{}
 
@@ -410,6 +578,19 @@ func makeCropContainer(v interface{}) (domain.CropContainer, error) {
 	}, nil
 }
 
+// makeCropArea decodes the crop area based on its area code.
+// Values: INITIAL_AREA / MOVED_AREA
+func makeCropArea(code string, v interface{}) (interface{}, error) {
+	switch code {
+	case "INITIAL_AREA":
+		return makeCropInitialArea(v)
+	case "MOVED_AREA":
+		return makeCropMovedArea(v)
+	}
+
+	return nil, errors.New("Unrecognized crop area code")
+}
+
 func makeCropInitialArea(v interface{}) (domain.InitialArea, error) {
 	initialArea := domain.InitialArea{}
 	mapped, ok := v.(map[string]interface{})
@@ -566,3 +747,70 @@ func makeCropMovedArea(v interface{}) (domain.MovedArea, error) {
 
 	return movedArea, nil
 }
+
+func makeHarvestLot(v interface{}) (domain.HarvestLot, error) {
+	harvestLot := domain.HarvestLot{}
+	mapped, ok := v.(map[string]interface{})
+	if !ok {
+		return domain.HarvestLot{}, errors.New("Error type assertion")
+	}
+
+	if v, ok := mapped["uid"]; ok {
+		uid, err := makeUUID(v)
+		if err != nil {
+			return domain.HarvestLot{}, err
+		}
+		harvestLot.UID = uid
+	}
+	if v, ok := mapped["lot_number"]; ok {
+		val, ok2 := v.(string)
+		if !ok2 {
+			return domain.HarvestLot{}, errors.New("Error type assertion")
+		}
+		harvestLot.LotNumber = val
+	}
+	if v, ok := mapped["source_area_id"]; ok {
+		uid, err := makeUUID(v)
+		if err != nil {
+			return domain.HarvestLot{}, err
+		}
+		harvestLot.SourceAreaUID = uid
+	}
+	if v, ok := mapped["grade"]; ok {
+		val, ok2 := v.(string)
+		if !ok2 {
+			return domain.HarvestLot{}, errors.New("Error type assertion")
+		}
+		harvestLot.Grade = val
+	}
+	if v, ok := mapped["produced_quantity"]; ok {
+		val, ok2 := v.(float64)
+		if !ok2 {
+			return domain.HarvestLot{}, errors.New("Error type assertion")
+		}
+		harvestLot.ProducedQuantity = float32(val)
+	}
+	if v, ok := mapped["produced_unit"]; ok {
+		val, ok2 := v.(string)
+		if !ok2 {
+			return domain.HarvestLot{}, errors.New("Error type assertion")
+		}
+		harvestLot.ProducedUnit = val
+	}
+	if v, ok := mapped["produced_gram_quantity"]; ok {
+		val, ok2 := v.(float64)
+		if !ok2 {
+			return domain.HarvestLot{}, errors.New("Error type assertion")
+		}
+		harvestLot.ProducedGramQuantity = float32(val)
+	}
+	if v, ok := mapped["harvest_date"]; ok {
+		val, err := makeTime(v)
+		if err != nil {
+			return domain.HarvestLot{}, err
+		}
+		harvestLot.HarvestDate = val
+	}
+
+	return harvestLot, nil
+}
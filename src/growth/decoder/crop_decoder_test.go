@@ -0,0 +1,113 @@
+package decoder_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	. "github.com/Tanibox/tania-core/src/growth/decoder"
+	"github.com/Tanibox/tania-core/src/growth/domain"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeCropBatchHarvested(t *testing.T) {
+	// Given
+	cropUID, _ := uuid.NewV4()
+	areaUID, _ := uuid.NewV4()
+	lotUID, _ := uuid.NewV4()
+	harvestDate := time.Date(2018, time.May, 10, 8, 30, 0, 0, time.UTC)
+
+	event := domain.CropBatchHarvested{
+		UID:               cropUID,
+		CropStatus:        domain.CropActive,
+		HarvestType:       "PARTIAL",
+		HarvestedQuantity: 5,
+		HarvestLot: domain.HarvestLot{
+			UID:                  lotUID,
+			LotNumber:            "BAS-20180510-01",
+			SourceAreaUID:        areaUID,
+			Grade:                "A",
+			ProducedQuantity:     12,
+			ProducedUnit:         "BUNCH",
+			ProducedGramQuantity: 300,
+			HarvestDate:          harvestDate,
+		},
+		HarvestedArea: domain.InitialArea{
+			AreaUID:         areaUID,
+			InitialQuantity: 20,
+			CurrentQuantity: 15,
+		},
+		HarvestedAreaCode: "INITIAL_AREA",
+		HarvestDate:       harvestDate,
+	}
+
+	e, err := json.Marshal(InterfaceWrapper{
+		Name: "CropBatchHarvested",
+		Data: event,
+	})
+	assert.Nil(t, err)
+
+	// When
+	wrapper := CropEventWrapper{}
+	err = json.Unmarshal(e, &wrapper)
+
+	// Then
+	assert.Nil(t, err)
+
+	decoded, ok := wrapper.Data.(domain.CropBatchHarvested)
+	assert.True(t, ok)
+	assert.Equal(t, event.HarvestLot, decoded.HarvestLot)
+	assert.Equal(t, float32(12), decoded.HarvestLot.ProducedQuantity)
+	assert.Equal(t, "BUNCH", decoded.HarvestLot.ProducedUnit)
+}
+
+func TestDecodeCropBatchHarvestCorrected(t *testing.T) {
+	// Given
+	cropUID, _ := uuid.NewV4()
+	areaUID, _ := uuid.NewV4()
+	lotUID, _ := uuid.NewV4()
+	voidedLotUID, _ := uuid.NewV4()
+	harvestDate := time.Date(2018, time.May, 10, 8, 30, 0, 0, time.UTC)
+
+	event := domain.CropBatchHarvestCorrected{
+		UID:           cropUID,
+		CropStatus:    domain.CropActive,
+		SourceAreaUID: areaUID,
+		UpdatedHarvestLots: []domain.HarvestLot{{
+			UID:                  lotUID,
+			LotNumber:            "BAS-20180510-01",
+			SourceAreaUID:        areaUID,
+			Grade:                "A",
+			ProducedQuantity:     9,
+			ProducedUnit:         "KG",
+			ProducedGramQuantity: 9000,
+			HarvestDate:          harvestDate,
+		}},
+		VoidedHarvestLotUIDs: []uuid.UUID{voidedLotUID},
+		HarvestedArea: domain.InitialArea{
+			AreaUID:         areaUID,
+			InitialQuantity: 20,
+			CurrentQuantity: 20,
+		},
+		HarvestedAreaCode: "INITIAL_AREA",
+	}
+
+	e, err := json.Marshal(InterfaceWrapper{
+		Name: "CropBatchHarvestCorrected",
+		Data: event,
+	})
+	assert.Nil(t, err)
+
+	// When
+	wrapper := CropEventWrapper{}
+	err = json.Unmarshal(e, &wrapper)
+
+	// Then
+	assert.Nil(t, err)
+
+	decoded, ok := wrapper.Data.(domain.CropBatchHarvestCorrected)
+	assert.True(t, ok)
+	assert.Equal(t, event.UpdatedHarvestLots, decoded.UpdatedHarvestLots)
+	assert.Equal(t, event.VoidedHarvestLotUIDs, decoded.VoidedHarvestLotUIDs)
+}
@@ -1,6 +1,8 @@
 package domain
 
 import (
+	"fmt"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -23,6 +25,7 @@ type Crop struct {
 	InitialArea      InitialArea
 	MovedArea        []MovedArea
 	HarvestedStorage []HarvestedStorage
+	HarvestLots      []HarvestLot
 	Trash            []Trash
 
 	// Fields to track care crop
@@ -33,6 +36,9 @@ type Crop struct {
 	// Notes
 	Notes map[uuid.UUID]CropNote
 
+	// The crop template whose tasks are scheduled for the crop batch
+	TemplateUID *uuid.UUID
+
 	// Events
 	Version            int
 	UncommittedChanges []interface{}
@@ -43,6 +49,8 @@ type CropService interface {
 	FindMaterialByID(uid uuid.UUID) ServiceResult
 	FindByBatchID(batchID string) ServiceResult
 	FindAreaByID(uid uuid.UUID) ServiceResult
+	FindTaskCategoryByCode(code string) ServiceResult
+	FindTaskPriorityByCode(code string) ServiceResult
 }
 
 // ServiceResult is the container for service result
@@ -166,11 +174,46 @@ type HarvestedStorage struct {
 	LastUpdated          time.Time `json:"last_updated"`
 }
 
+// HarvestLot is a single harvest of a crop batch.
+// Unlike HarvestedStorage, it is never merged with the other harvests.
+type HarvestLot struct {
+	UID                  uuid.UUID `json:"uid"`
+	LotNumber            string    `json:"lot_number"`
+	SourceAreaUID        uuid.UUID `json:"source_area_id"`
+	Grade                string    `json:"grade"`
+	ProducedQuantity     float32   `json:"produced_quantity"`
+	ProducedUnit         string    `json:"produced_unit"`
+	ProducedGramQuantity float32   `json:"produced_gram_quantity"`
+	HarvestDate          time.Time `json:"harvest_date"`
+}
+
 type Trash struct {
 	Quantity      int       `json:"quantity"`
 	SourceAreaUID uuid.UUID `json:"source_area_id"`
 	CreatedDate   time.Time `json:"created_date"`
 	LastUpdated   time.Time `json:"last_updated"`
+
+	// Reasons is the dumped quantity per dump reason code.
+	// Quantities dumped before the dump reasons were introduced are not in it.
+	Reasons map[string]int `json:"reasons"`
+}
+
+// QuantityByReason returns the dumped quantity per dump reason code.
+// The quantity dumped without reason is counted as DumpReasonOther.
+func (t Trash) QuantityByReason() map[string]int {
+	reasons := make(map[string]int)
+
+	unattributed := t.Quantity
+	for k, v := range t.Reasons {
+		reasons[k] += v
+		unattributed -= v
+	}
+
+	if unattributed > 0 {
+		reasons[DumpReasonOther] += unattributed
+	}
+
+	return reasons
 }
 
 const (
@@ -201,19 +244,102 @@ func GetHarvestType(code string) HarvestType {
 }
 
 const (
-	Kg = "Kg"
-	Gr = "Gr"
+	HarvestGradeA      = "A"
+	HarvestGradeB      = "B"
+	HarvestGradeReject = "REJECT"
+)
+
+type HarvestGrade struct {
+	Code  string `json:"code"`
+	Label string `json:"label"`
+}
+
+func HarvestGrades() []HarvestGrade {
+	return []HarvestGrade{
+		{Code: HarvestGradeA, Label: "Grade A"},
+		{Code: HarvestGradeB, Label: "Grade B"},
+		{Code: HarvestGradeReject, Label: "Reject"},
+	}
+}
+
+func GetHarvestGrade(code string) HarvestGrade {
+	for _, v := range HarvestGrades() {
+		if v.Code == code {
+			return v
+		}
+	}
+
+	return HarvestGrade{}
+}
+
+const (
+	DumpReasonNotGerminated = "NOT_GERMINATED"
+	DumpReasonDisease       = "DISEASE"
+	DumpReasonPest          = "PEST"
+	DumpReasonDamaged       = "DAMAGED"
+	DumpReasonWeather       = "WEATHER"
+	DumpReasonOther         = "OTHER"
+)
+
+type DumpReason struct {
+	Code  string `json:"code"`
+	Label string `json:"label"`
+}
+
+func DumpReasons() []DumpReason {
+	return []DumpReason{
+		{Code: DumpReasonNotGerminated, Label: "Not germinated"},
+		{Code: DumpReasonDisease, Label: "Disease"},
+		{Code: DumpReasonPest, Label: "Pest"},
+		{Code: DumpReasonDamaged, Label: "Damaged"},
+		{Code: DumpReasonWeather, Label: "Weather"},
+		{Code: DumpReasonOther, Label: "Other"},
+	}
+}
+
+func GetDumpReason(code string) DumpReason {
+	for _, v := range DumpReasons() {
+		if v.Code == code {
+			return v
+		}
+	}
+
+	return DumpReason{}
+}
+
+const (
+	Kg        = "Kg"
+	Gr        = "Gr"
+	Piece     = "Piece"
+	Bunch     = "Bunch"
+	Head      = "Head"
+	Clamshell = "Clamshell"
+)
+
+const (
+	ProducedUnitTypeWeight = "WEIGHT"
+	ProducedUnitTypeCount  = "COUNT"
 )
 
+// ProducedUnit is the unit of a harvest. GramWeight is the weight of one unit in gram.
+// It is zero for a count unit without an average weight, so its harvest can't be weighed.
 type ProducedUnit struct {
-	Code  string
-	Label string
+	Code       string  `json:"code"`
+	Label      string  `json:"label"`
+	Type       string  `json:"type"`
+	GramWeight float32 `json:"gram_weight"`
 }
 
+// ProducedUnits returns the built-in units. The inventory materials can have their own
+// count units, or give the average weight of a built-in count unit, with a HarvestUnit.
 func ProducedUnits() []ProducedUnit {
 	return []ProducedUnit{
-		{Code: Kg, Label: "kg"},
-		{Code: Gr, Label: "gr"},
+		{Code: Kg, Label: "kg", Type: ProducedUnitTypeWeight, GramWeight: 1000},
+		{Code: Gr, Label: "gr", Type: ProducedUnitTypeWeight, GramWeight: 1},
+		{Code: Piece, Label: "piece", Type: ProducedUnitTypeCount},
+		{Code: Bunch, Label: "bunch", Type: ProducedUnitTypeCount},
+		{Code: Head, Label: "head", Type: ProducedUnitTypeCount},
+		{Code: Clamshell, Label: "clamshell", Type: ProducedUnitTypeCount},
 	}
 }
 
@@ -227,6 +353,11 @@ func GetProducedUnit(code string) ProducedUnit {
 	return ProducedUnit{}
 }
 
+// GramQuantity converts a quantity in the unit to gram
+func (pu ProducedUnit) GramQuantity(quantity float32) float32 {
+	return quantity * pu.GramWeight
+}
+
 type CropNote struct {
 	UID         uuid.UUID `json:"uid"`
 	Content     string    `json:"content"`
@@ -243,6 +374,12 @@ type CropPhoto struct {
 	Description string    `json:"description"`
 }
 
+// StoredFilename is the name of the uploaded file of the photo.
+// It is named by the photo UID, so the photos with the same filename don't overwrite each other.
+func (p CropPhoto) StoredFilename() string {
+	return p.UID.String() + strings.ToLower(filepath.Ext(p.Filename))
+}
+
 func (state *Crop) TrackChange(event interface{}) {
 	state.UncommittedChanges = append(state.UncommittedChanges, event)
 	state.Transition(event)
@@ -266,6 +403,9 @@ func (state *Crop) Transition(event interface{}) {
 		}
 		state.FarmUID = e.FarmUID
 
+	case CropBatchTemplateApplied:
+		state.TemplateUID = &e.TemplateUID
+
 	case CropBatchInventoryChanged:
 		state.InventoryUID = e.InventoryUID
 		state.BatchID = e.BatchID
@@ -334,6 +474,11 @@ func (state *Crop) Transition(event interface{}) {
 			state.HarvestedStorage = append(state.HarvestedStorage, e.UpdatedHarvestedStorage)
 		}
 
+		// Harvests recorded before harvest lots were introduced don't have a lot
+		if e.HarvestLot.UID != (uuid.UUID{}) {
+			state.HarvestLots = append(state.HarvestLots, e.HarvestLot)
+		}
+
 		if e.HarvestedAreaCode == "INITIAL_AREA" {
 			ha := e.HarvestedArea.(InitialArea)
 			state.InitialArea = ha
@@ -377,6 +522,50 @@ func (state *Crop) Transition(event interface{}) {
 
 		state.Status = GetCropStatus(e.CropStatus)
 
+	case CropBatchHarvestCorrected:
+		for i, v := range state.HarvestedStorage {
+			if v.SourceAreaUID == e.UpdatedHarvestedStorage.SourceAreaUID {
+				state.HarvestedStorage[i] = e.UpdatedHarvestedStorage
+			}
+		}
+
+		state.updateHarvestLots(e.UpdatedHarvestLots, e.VoidedHarvestLotUIDs)
+
+		state.updateArea(e.HarvestedArea)
+		state.Status = GetCropStatus(e.CropStatus)
+
+	case CropBatchDumpReverted:
+		for i, v := range state.Trash {
+			if v.SourceAreaUID == e.UpdatedTrash.SourceAreaUID {
+				state.Trash[i] = e.UpdatedTrash
+			}
+		}
+
+		state.updateArea(e.RestoredArea)
+		state.Status = GetCropStatus(e.CropStatus)
+
+	case CropBatchUnarchived:
+		for i, v := range state.Trash {
+			if v.SourceAreaUID == e.AreaUID && e.DumpedQuantity > 0 {
+				state.Trash[i] = e.UpdatedTrash
+			}
+		}
+
+		for i, v := range state.HarvestedStorage {
+			if v.SourceAreaUID == e.AreaUID && e.HarvestedQuantity > 0 {
+				state.HarvestedStorage[i] = e.UpdatedHarvestedStorage
+			}
+		}
+
+		state.updateHarvestLots(e.UpdatedHarvestLots, e.VoidedHarvestLotUIDs)
+
+		state.updateArea(e.RestoredArea)
+		state.Status = GetCropStatus(e.CropStatus)
+
+	case CropBatchMoveReverted:
+		state.updateArea(e.UpdatedSrcArea)
+		state.updateArea(e.UpdatedDstArea)
+
 	case CropBatchWatered:
 		if state.InitialArea.AreaUID == e.AreaUID {
 			state.InitialArea.LastWatered = e.WateringDate
@@ -412,6 +601,37 @@ func (state *Crop) Transition(event interface{}) {
 			Height:      e.Height,
 			Description: e.Description,
 		})
+
+	case CropBatchPhotoRemoved:
+		photos := []CropPhoto{}
+		for _, v := range state.Photos {
+			if v.UID != e.UID {
+				photos = append(photos, v)
+			}
+		}
+
+		state.Photos = photos
+
+	case CropBatchPhotoDescriptionChanged:
+		for i, v := range state.Photos {
+			if v.UID == e.UID {
+				state.Photos[i].Description = e.Description
+			}
+		}
+	}
+}
+
+// updateArea replaces the crop's initial area or one of its moved area with the given area.
+func (state *Crop) updateArea(area interface{}) {
+	switch v := area.(type) {
+	case InitialArea:
+		state.InitialArea = v
+	case MovedArea:
+		for i, ma := range state.MovedArea {
+			if ma.AreaUID == v.AreaUID {
+				state.MovedArea[i] = v
+			}
+		}
 	}
 }
 
@@ -481,6 +701,96 @@ func CreateCropBatch(
 	return initial, nil
 }
 
+// SeedQuantity returns how many seeds or plants the crop batch was seeded with.
+// It is one per tray cell, or one per pot.
+func (c Crop) SeedQuantity() int {
+	switch v := c.Container.Type.(type) {
+	case Tray:
+		return c.Container.Quantity * v.Cell
+	}
+
+	return c.Container.Quantity
+}
+
+// CurrentQuantityInArea returns how many plants of the crop batch are currently in the area
+func (c Crop) CurrentQuantityInArea(areaUID uuid.UUID) int {
+	quantity := 0
+
+	if c.InitialArea.AreaUID == areaUID {
+		quantity += c.InitialArea.CurrentQuantity
+	}
+
+	for _, v := range c.MovedArea {
+		if v.AreaUID == areaUID {
+			quantity += v.CurrentQuantity
+		}
+	}
+
+	return quantity
+}
+
+// IsCountableInventoryUnit tells whether an inventory material unit is counted per seed or plant,
+// so the quantity consumed by seeding can be computed from SeedQuantity.
+func IsCountableInventoryUnit(unit string) bool {
+	return unit == "SEEDS" || unit == "UNITS"
+}
+
+// ConsumeInventory deducts the quantity, in the inventory material's unit, from the crop's inventory stock.
+// It is rejected when the stock is not enough, unless allowInsufficientStock is true.
+func (c *Crop) ConsumeInventory(cropService CropService, quantity float32, allowInsufficientStock bool) error {
+	if quantity <= 0 {
+		return CropError{Code: CropInventoryErrorInvalidQuantity}
+	}
+
+	serviceResult := cropService.FindMaterialByID(c.InventoryUID)
+	if serviceResult.Error != nil {
+		return serviceResult.Error
+	}
+
+	material, ok := serviceResult.Result.(query.CropMaterialQueryResult)
+	if !ok || material.UID == (uuid.UUID{}) {
+		return CropError{Code: CropMaterialErrorNotFound}
+	}
+
+	if quantity > material.Quantity && !allowInsufficientStock {
+		return CropError{Code: CropInventoryErrorNotEnoughStock}
+	}
+
+	c.TrackChange(CropBatchInventoryConsumed{
+		UID:          c.UID,
+		InventoryUID: material.UID,
+		Quantity:     quantity,
+		QuantityUnit: material.QuantityUnit,
+		ConsumedDate: time.Now(),
+	})
+
+	return nil
+}
+
+// ApplyTemplate schedules the tasks of the crop template for the crop batch, counting the days from its seeding date.
+// The crop template must be for the inventory material of the crop batch.
+func (c *Crop) ApplyTemplate(template CropTemplate) error {
+	if template.UID == (uuid.UUID{}) || template.IsRemoved {
+		return CropError{Code: CropTemplateErrorNotFound}
+	}
+
+	if template.InventoryUID != c.InventoryUID {
+		return CropError{Code: CropTemplateErrorInvalidInventory}
+	}
+
+	c.TrackChange(CropBatchTemplateApplied{
+		UID:          c.UID,
+		BatchID:      c.BatchID,
+		TemplateUID:  template.UID,
+		TemplateName: template.Name,
+		AreaUID:      c.InitialArea.AreaUID,
+		SeedingDate:  c.InitialArea.CreatedDate,
+		Tasks:        template.Tasks,
+	})
+
+	return nil
+}
+
 func (c *Crop) MoveToArea(cropService CropService, sourceAreaUID uuid.UUID, destinationAreaUID uuid.UUID, quantity int) error {
 	// Validate //
 	// Check if source area is exist in DB
@@ -647,6 +957,7 @@ func (c *Crop) Harvest(
 	harvestType string,
 	producedQuantity float32,
 	producedUnit ProducedUnit,
+	grade string,
 	notes string) error {
 
 	// Validate //
@@ -689,6 +1000,15 @@ func (c *Crop) Harvest(
 		return CropError{Code: CropHarvestErrorInvalidHarvestType}
 	}
 
+	hg := GetHarvestGrade(grade)
+	if hg == (HarvestGrade{}) {
+		return CropError{Code: CropHarvestErrorInvalidGrade}
+	}
+
+	if producedUnit.Code == "" {
+		return CropError{Code: CropHarvestErrorInvalidProducedUnit}
+	}
+
 	// Process //
 	harvestDate := time.Now()
 
@@ -749,14 +1069,28 @@ func (c *Crop) Harvest(
 	}
 
 	// Calculate the produced harvest
-	// Produced Quantity always converted to gram
-	totalProduced := producedQuantity
-	if producedUnit.Code == Kg {
-		totalProduced = producedQuantity * 1000
-	}
+	// Produced Quantity always converted to gram. The harvest in a count unit
+	// without an average weight is only kept in its own unit in the harvest lot.
+	totalProduced := producedUnit.GramQuantity(producedQuantity)
 
 	harvestedStorage.ProducedGramQuantity += totalProduced
 
+	harvestLotUID, err := uuid.NewV4()
+	if err != nil {
+		return err
+	}
+
+	harvestLot := HarvestLot{
+		UID:                  harvestLotUID,
+		LotNumber:            c.nextHarvestLotNumber(harvestDate),
+		SourceAreaUID:        srcArea.UID,
+		Grade:                hg.Code,
+		ProducedQuantity:     producedQuantity,
+		ProducedUnit:         producedUnit.Code,
+		ProducedGramQuantity: totalProduced,
+		HarvestDate:          harvestDate,
+	}
+
 	// Check all the quantity in InitialArea and MovedArea,
 	// if its all empty then crop status is marked to archive
 	initialAreaEmpty := false
@@ -800,6 +1134,7 @@ func (c *Crop) Harvest(
 		HarvestedQuantity:       harvestedQuantity,
 		ProducedGramQuantity:    totalProduced,
 		UpdatedHarvestedStorage: harvestedStorage,
+		HarvestLot:              harvestLot,
 		HarvestedArea:           harvestedArea,
 		HarvestedAreaCode:       harvestedAreaCode,
 		HarvestDate:             harvestDate,
@@ -809,7 +1144,21 @@ func (c *Crop) Harvest(
 	return nil
 }
 
-func (c *Crop) Dump(cropService CropService, sourceAreaUID uuid.UUID, quantity int, notes string) error {
+// nextHarvestLotNumber generates the lot number of the crop batch's next harvest lot.
+// It is the batch ID followed by the harvest date and the lot sequence of that day,
+// for example tom-sup-one-2jan-20180305-02.
+func (c *Crop) nextHarvestLotNumber(harvestDate time.Time) string {
+	sequence := 1
+	for _, v := range c.HarvestLots {
+		if v.HarvestDate.Format("20060102") == harvestDate.Format("20060102") {
+			sequence++
+		}
+	}
+
+	return fmt.Sprintf("%s-%s-%02d", c.BatchID, harvestDate.Format("20060102"), sequence)
+}
+
+func (c *Crop) Dump(cropService CropService, sourceAreaUID uuid.UUID, quantity int, reason string, notes string) error {
 	// Validate //
 	// Check if source area is exist in DB
 	serviceResult := cropService.FindAreaByID(sourceAreaUID)
@@ -858,6 +1207,11 @@ func (c *Crop) Dump(cropService CropService, sourceAreaUID uuid.UUID, quantity i
 		return CropError{Code: CropDumpErrorInvalidQuantity}
 	}
 
+	dr := GetDumpReason(reason)
+	if dr == (DumpReason{}) {
+		return CropError{Code: CropDumpErrorInvalidReason}
+	}
+
 	// Check source area existance. If already exist, then just update it
 	var dumpedArea interface{}
 	updatedTrash := Trash{}
@@ -879,6 +1233,15 @@ func (c *Crop) Dump(cropService CropService, sourceAreaUID uuid.UUID, quantity i
 		updatedTrash.LastUpdated = dumpDate
 	}
 
+	// Copy the reasons so the current trash is not changed before the event is applied
+	reasons := make(map[string]int)
+	for k, v := range updatedTrash.Reasons {
+		reasons[k] = v
+	}
+
+	reasons[dr.Code] += quantity
+	updatedTrash.Reasons = reasons
+
 	// Reduce the quantity in the area because it has been dumped
 	dumpedAreaCode := ""
 	if c.InitialArea.AreaUID == srcArea.UID {
@@ -948,31 +1311,26 @@ func (c *Crop) Dump(cropService CropService, sourceAreaUID uuid.UUID, quantity i
 		DumpedArea:     dumpedArea,
 		DumpedAreaCode: dumpedAreaCode,
 		DumpDate:       time.Now(),
+		Reason:         dr.Code,
 		Notes:          notes,
 	})
 
 	return nil
 }
 
-func (c *Crop) Fertilize() error {
-	// c.LastFertilized = time.Now()
-
-	return nil
-}
-
-func (c *Crop) Prune() error {
-	// c.LastPruned = time.Now()
-
-	return nil
-}
-
-func (c *Crop) Pesticide() error {
-	// c.LastPesticided = time.Now()
-
-	return nil
-}
+// CorrectHarvest fixes a mistaken harvest record in a source area.
+// The given quantities replace the harvested storage totals of that area,
+// and any plants that were wrongly counted as harvested are put back to the area.
+func (c *Crop) CorrectHarvest(
+	cropService CropService,
+	sourceAreaUID uuid.UUID,
+	harvestedQuantity int,
+	producedQuantity float32,
+	producedUnit ProducedUnit,
+	notes string,
+	correctedBy uuid.UUID) error {
 
-func (c *Crop) Water(cropService CropService, sourceAreaUID uuid.UUID, wateringDate time.Time) error {
+	// Validate //
 	serviceResult := cropService.FindAreaByID(sourceAreaUID)
 	if serviceResult.Error != nil {
 		return serviceResult.Error
@@ -980,68 +1338,618 @@ func (c *Crop) Water(cropService CropService, sourceAreaUID uuid.UUID, wateringD
 
 	srcArea, ok := serviceResult.Result.(query.CropAreaQueryResult)
 	if !ok {
-		return CropError{Code: CropDumpErrorInvalidSourceArea}
+		return CropError{Code: CropHarvestErrorInvalidSourceArea}
 	}
 
 	if srcArea == (query.CropAreaQueryResult{}) {
-		return CropError{Code: CropDumpErrorSourceAreaNotFound}
+		return CropError{Code: CropHarvestErrorSourceAreaNotFound}
 	}
 
-	if wateringDate.IsZero() {
-		return CropError{Code: CropWaterErrorInvalidWateringDate}
+	harvestedStorage := HarvestedStorage{}
+	isExist := false
+	for _, v := range c.HarvestedStorage {
+		if v.SourceAreaUID == srcArea.UID {
+			harvestedStorage = v
+			isExist = true
+		}
 	}
 
-	c.TrackChange(CropBatchWatered{
-		UID:           c.UID,
-		BatchID:       c.BatchID,
-		ContainerType: c.Container.Type.Code(),
-		AreaUID:       srcArea.UID,
-		AreaName:      srcArea.Name,
-		WateringDate:  wateringDate,
-	})
+	if !isExist {
+		return CropError{Code: CropCorrectionErrorHarvestNotFound}
+	}
 
-	return nil
-}
+	if harvestedQuantity < 0 {
+		return CropError{Code: CropHarvestErrorInvalidQuantity}
+	}
 
-func (c *Crop) ChangeCropType(cropType string) error {
-	ct := GetCropType(cropType)
-	if ct == (CropType{}) {
-		return CropError{Code: CropErrorInvalidCropType}
+	if producedQuantity < 0 {
+		return CropError{Code: CropCorrectionErrorInvalidProducedQuantity}
 	}
 
-	c.TrackChange(CropBatchTypeChanged{
-		UID:  c.UID,
-		Type: ct,
-	})
+	// The corrected totals are in gram, so the unit must have a weight
+	if producedUnit.GramWeight <= 0 {
+		return CropError{Code: CropCorrectionErrorInvalidProducedUnit}
+	}
 
-	return nil
-}
+	// Plants that were harvested by mistake must go back to the area they came from
+	restoredQuantity := harvestedStorage.Quantity - harvestedQuantity
 
-func (c *Crop) ChangeCropStatus(cropStatus string) error {
-	cs := GetCropStatus(cropStatus)
-	if cs == (CropStatus{}) {
-		return CropError{Code: CropErrorInvalidCropStatus}
-	}
+	var harvestedArea interface{}
+	harvestedAreaCode := ""
+	if c.InitialArea.AreaUID == srcArea.UID {
+		ia := c.InitialArea
+		ia.CurrentQuantity += restoredQuantity
 
-	c.Status = cs
+		if ia.CurrentQuantity < 0 {
+			return CropError{Code: CropHarvestErrorNotEnoughQuantity}
+		}
 
-	return nil
-}
+		harvestedArea = ia
+		harvestedAreaCode = "INITIAL_AREA"
+	}
+	for _, v := range c.MovedArea {
+		if v.AreaUID == srcArea.UID {
+			ma := v
+			ma.CurrentQuantity += restoredQuantity
 
-func (c *Crop) ChangeContainer(quantity int, containerType CropContainerType) error {
-	err := validateContainer(quantity, containerType)
-	if err != nil {
-		return err
+			if ma.CurrentQuantity < 0 {
+				return CropError{Code: CropHarvestErrorNotEnoughQuantity}
+			}
+
+			harvestedArea = ma
+			harvestedAreaCode = "MOVED_AREA"
+		}
 	}
 
-	if c.InitialArea.CurrentQuantity != c.InitialArea.InitialQuantity {
-		return CropError{Code: CropContainerErrorInvalidType}
+	if harvestedAreaCode == "" {
+		return CropError{Code: CropHarvestErrorSourceAreaNotFound}
 	}
 
-	c.TrackChange(CropBatchContainerChanged{
-		UID: c.UID,
-		Container: CropContainer{
-			Quantity: quantity,
+	// Process //
+	correctionDate := time.Now()
+
+	totalProduced := producedUnit.GramQuantity(producedQuantity)
+
+	previousHarvestedStorage := harvestedStorage
+
+	harvestedStorage.Quantity = harvestedQuantity
+	harvestedStorage.ProducedGramQuantity = totalProduced
+	harvestedStorage.LastUpdated = correctionDate
+
+	updatedHarvestLots, voidedHarvestLotUIDs := c.correctHarvestLots(srcArea.UID, previousHarvestedStorage, harvestedStorage)
+
+	c.TrackChange(CropBatchHarvestCorrected{
+		UID:                          c.UID,
+		CropStatus:                   c.statusAfterAreaUpdate(harvestedArea),
+		SourceAreaUID:                srcArea.UID,
+		PreviousHarvestedQuantity:    previousHarvestedStorage.Quantity,
+		PreviousProducedGramQuantity: previousHarvestedStorage.ProducedGramQuantity,
+		UpdatedHarvestedStorage:      harvestedStorage,
+		UpdatedHarvestLots:           updatedHarvestLots,
+		VoidedHarvestLotUIDs:         voidedHarvestLotUIDs,
+		HarvestedArea:                harvestedArea,
+		HarvestedAreaCode:            harvestedAreaCode,
+		CorrectedBy:                  correctedBy,
+		CorrectionDate:               correctionDate,
+		Notes:                        notes,
+	})
+
+	return nil
+}
+
+// updateHarvestLots replaces the updated harvest lots and drops the voided ones
+func (state *Crop) updateHarvestLots(updatedHarvestLots []HarvestLot, voidedHarvestLotUIDs []uuid.UUID) {
+	harvestLots := []HarvestLot{}
+	for _, v := range state.HarvestLots {
+		isVoided := false
+		for _, uid := range voidedHarvestLotUIDs {
+			if v.UID == uid {
+				isVoided = true
+			}
+		}
+
+		if isVoided {
+			continue
+		}
+
+		for _, updated := range updatedHarvestLots {
+			if v.UID == updated.UID {
+				v = updated
+			}
+		}
+
+		harvestLots = append(harvestLots, v)
+	}
+
+	state.HarvestLots = harvestLots
+}
+
+// correctHarvestLots applies the correction of the harvested storage of a source area to its harvest lots.
+// The difference of the produced quantity goes to the latest lots first, and a lot with nothing left is voided.
+// A correction to nothing harvested voids all the lots of the area.
+func (c *Crop) correctHarvestLots(sourceAreaUID uuid.UUID, previous, corrected HarvestedStorage) ([]HarvestLot, []uuid.UUID) {
+	updatedHarvestLots := []HarvestLot{}
+	voidedHarvestLotUIDs := []uuid.UUID{}
+
+	difference := corrected.ProducedGramQuantity - previous.ProducedGramQuantity
+
+	for i := len(c.HarvestLots) - 1; i >= 0; i-- {
+		lot := c.HarvestLots[i]
+		if lot.SourceAreaUID != sourceAreaUID {
+			continue
+		}
+
+		if corrected.Quantity == 0 && corrected.ProducedGramQuantity == 0 {
+			voidedHarvestLotUIDs = append(voidedHarvestLotUIDs, lot.UID)
+			continue
+		}
+
+		// The lots of the units without weight can't be corrected by gram
+		if difference == 0 || lot.ProducedGramQuantity <= 0 {
+			continue
+		}
+
+		if lot.ProducedGramQuantity+difference <= 0 {
+			difference += lot.ProducedGramQuantity
+			voidedHarvestLotUIDs = append(voidedHarvestLotUIDs, lot.UID)
+			continue
+		}
+
+		correctedGramQuantity := lot.ProducedGramQuantity + difference
+		lot.ProducedQuantity = lot.ProducedQuantity * correctedGramQuantity / lot.ProducedGramQuantity
+		lot.ProducedGramQuantity = correctedGramQuantity
+		difference = 0
+
+		updatedHarvestLots = append(updatedHarvestLots, lot)
+	}
+
+	return updatedHarvestLots, voidedHarvestLotUIDs
+}
+
+// RevertDump puts back the dumped plants of a source area from the trash to the area.
+func (c *Crop) RevertDump(cropService CropService, sourceAreaUID uuid.UUID, quantity int, notes string, revertedBy uuid.UUID) error {
+	// Validate //
+	serviceResult := cropService.FindAreaByID(sourceAreaUID)
+	if serviceResult.Error != nil {
+		return serviceResult.Error
+	}
+
+	srcArea, ok := serviceResult.Result.(query.CropAreaQueryResult)
+	if !ok {
+		return CropError{Code: CropDumpErrorInvalidSourceArea}
+	}
+
+	if srcArea == (query.CropAreaQueryResult{}) {
+		return CropError{Code: CropDumpErrorSourceAreaNotFound}
+	}
+
+	if quantity <= 0 {
+		return CropError{Code: CropDumpErrorInvalidQuantity}
+	}
+
+	updatedTrash := Trash{}
+	isExist := false
+	for _, v := range c.Trash {
+		if v.SourceAreaUID == srcArea.UID {
+			updatedTrash = v
+			isExist = true
+		}
+	}
+
+	if !isExist {
+		return CropError{Code: CropCorrectionErrorDumpNotFound}
+	}
+
+	if updatedTrash.Quantity < quantity {
+		return CropError{Code: CropCorrectionErrorNotEnoughDumpedQuantity}
+	}
+
+	// Process //
+	revertDate := time.Now()
+
+	updatedTrash.Quantity -= quantity
+	updatedTrash.LastUpdated = revertDate
+	updatedTrash.Reasons = revertDumpReasons(updatedTrash.Reasons, updatedTrash.Quantity)
+
+	var restoredArea interface{}
+	restoredAreaCode := ""
+	if c.InitialArea.AreaUID == srcArea.UID {
+		ia := c.InitialArea
+		ia.CurrentQuantity += quantity
+		ia.LastUpdated = revertDate
+
+		restoredArea = ia
+		restoredAreaCode = "INITIAL_AREA"
+	}
+	for _, v := range c.MovedArea {
+		if v.AreaUID == srcArea.UID {
+			ma := v
+			ma.CurrentQuantity += quantity
+			ma.LastUpdated = revertDate
+
+			restoredArea = ma
+			restoredAreaCode = "MOVED_AREA"
+		}
+	}
+
+	if restoredAreaCode == "" {
+		return CropError{Code: CropDumpErrorSourceAreaNotFound}
+	}
+
+	c.TrackChange(CropBatchDumpReverted{
+		UID:              c.UID,
+		CropStatus:       c.statusAfterAreaUpdate(restoredArea),
+		Quantity:         quantity,
+		UpdatedTrash:     updatedTrash,
+		RestoredArea:     restoredArea,
+		RestoredAreaCode: restoredAreaCode,
+		RevertedBy:       revertedBy,
+		RevertDate:       revertDate,
+		Notes:            notes,
+	})
+
+	return nil
+}
+
+// revertDumpReasons removes the reverted quantity from the dump reasons
+// so they don't add up to more than the remaining dumped quantity.
+// A revert doesn't tell which dump it reverts, so the quantity is removed
+// from the reasons in the reverse order of DumpReasons, starting with DumpReasonOther.
+func revertDumpReasons(reasons map[string]int, remainingQuantity int) map[string]int {
+	reverted := make(map[string]int)
+
+	total := 0
+	for k, v := range reasons {
+		reverted[k] = v
+		total += v
+	}
+
+	drs := DumpReasons()
+	for i := len(drs) - 1; i >= 0 && total > remainingQuantity; i-- {
+		code := drs[i].Code
+
+		removed := total - remainingQuantity
+		if removed > reverted[code] {
+			removed = reverted[code]
+		}
+
+		reverted[code] -= removed
+		total -= removed
+
+		if reverted[code] == 0 {
+			delete(reverted, code)
+		}
+	}
+
+	return reverted
+}
+
+// Unarchive puts an archived crop batch back to ACTIVE by restoring quantity plants
+// to one of its area. It is used when the batch has been archived by mistake,
+// for example by a wrong full dump or harvest. The restored plants are taken out of
+// the trash of the area first, then out of its harvested storage.
+func (c *Crop) Unarchive(cropService CropService, areaUID uuid.UUID, quantity int, notes string, unarchivedBy uuid.UUID) error {
+	// Validate //
+	if c.Status.Code != CropArchived {
+		return CropError{Code: CropUnarchiveErrorNotArchived}
+	}
+
+	serviceResult := cropService.FindAreaByID(areaUID)
+	if serviceResult.Error != nil {
+		return serviceResult.Error
+	}
+
+	area, ok := serviceResult.Result.(query.CropAreaQueryResult)
+	if !ok {
+		return CropError{Code: CropUnarchiveErrorAreaNotFound}
+	}
+
+	if area == (query.CropAreaQueryResult{}) {
+		return CropError{Code: CropUnarchiveErrorAreaNotFound}
+	}
+
+	if quantity <= 0 {
+		return CropError{Code: CropUnarchiveErrorInvalidQuantity}
+	}
+
+	// Process //
+	unarchivedDate := time.Now()
+
+	var restoredArea interface{}
+	restoredAreaCode := ""
+	if c.InitialArea.AreaUID == area.UID {
+		ia := c.InitialArea
+		ia.CurrentQuantity = quantity
+		ia.LastUpdated = unarchivedDate
+
+		restoredArea = ia
+		restoredAreaCode = "INITIAL_AREA"
+	}
+	for _, v := range c.MovedArea {
+		if v.AreaUID == area.UID {
+			ma := v
+			ma.CurrentQuantity = quantity
+			ma.LastUpdated = unarchivedDate
+
+			restoredArea = ma
+			restoredAreaCode = "MOVED_AREA"
+		}
+	}
+
+	if restoredAreaCode == "" {
+		return CropError{Code: CropUnarchiveErrorAreaNotFound}
+	}
+
+	// The restored plants are taken back from the trash of the area first, then from its harvest
+	updatedTrash := Trash{}
+	for _, v := range c.Trash {
+		if v.SourceAreaUID == area.UID {
+			updatedTrash = v
+		}
+	}
+
+	updatedHarvestedStorage := HarvestedStorage{}
+	for _, v := range c.HarvestedStorage {
+		if v.SourceAreaUID == area.UID {
+			updatedHarvestedStorage = v
+		}
+	}
+
+	if quantity > updatedTrash.Quantity+updatedHarvestedStorage.Quantity {
+		return CropError{Code: CropUnarchiveErrorInvalidQuantity}
+	}
+
+	dumpedQuantity := quantity
+	if dumpedQuantity > updatedTrash.Quantity {
+		dumpedQuantity = updatedTrash.Quantity
+	}
+
+	harvestedQuantity := quantity - dumpedQuantity
+
+	if dumpedQuantity > 0 {
+		updatedTrash.Quantity -= dumpedQuantity
+		updatedTrash.LastUpdated = unarchivedDate
+		updatedTrash.Reasons = revertDumpReasons(updatedTrash.Reasons, updatedTrash.Quantity)
+	}
+
+	// The produce of the plants taken back from the harvest is not harvested anymore
+	updatedHarvestLots := []HarvestLot{}
+	voidedHarvestLotUIDs := []uuid.UUID{}
+	if harvestedQuantity > 0 {
+		previousHarvestedStorage := updatedHarvestedStorage
+
+		updatedHarvestedStorage.Quantity -= harvestedQuantity
+		updatedHarvestedStorage.ProducedGramQuantity = previousHarvestedStorage.ProducedGramQuantity *
+			float32(updatedHarvestedStorage.Quantity) / float32(previousHarvestedStorage.Quantity)
+		updatedHarvestedStorage.LastUpdated = unarchivedDate
+
+		updatedHarvestLots, voidedHarvestLotUIDs = c.correctHarvestLots(area.UID, previousHarvestedStorage, updatedHarvestedStorage)
+	}
+
+	c.TrackChange(CropBatchUnarchived{
+		UID:                     c.UID,
+		CropStatus:              CropActive,
+		Quantity:                quantity,
+		AreaUID:                 area.UID,
+		DumpedQuantity:          dumpedQuantity,
+		HarvestedQuantity:       harvestedQuantity,
+		UpdatedTrash:            updatedTrash,
+		UpdatedHarvestedStorage: updatedHarvestedStorage,
+		UpdatedHarvestLots:      updatedHarvestLots,
+		VoidedHarvestLotUIDs:    voidedHarvestLotUIDs,
+		RestoredArea:            restoredArea,
+		RestoredAreaCode:        restoredAreaCode,
+		UnarchivedBy:            unarchivedBy,
+		UnarchivedDate:          unarchivedDate,
+		Notes:                   notes,
+	})
+
+	return nil
+}
+
+// RevertMove moves the plants back from the destination area of a mistaken movement
+// to its source area. Area movement rules are not checked because
+// we only undo a movement that has been done before.
+// The destination area must have been filled from the source area, and at most its current
+// quantity is moved back. The reverted quantity is also removed from its initial quantity,
+// as if it had never been moved there.
+func (c *Crop) RevertMove(
+	cropService CropService,
+	sourceAreaUID uuid.UUID,
+	destinationAreaUID uuid.UUID,
+	quantity int,
+	notes string,
+	revertedBy uuid.UUID) error {
+
+	// Validate //
+	serviceResult := cropService.FindAreaByID(sourceAreaUID)
+	if serviceResult.Error != nil {
+		return serviceResult.Error
+	}
+
+	srcArea, ok := serviceResult.Result.(query.CropAreaQueryResult)
+	if !ok {
+		return CropError{Code: CropMoveToAreaErrorInvalidSourceArea}
+	}
+
+	if srcArea.UID == (uuid.UUID{}) {
+		return CropError{Code: CropMoveToAreaErrorSourceAreaNotFound}
+	}
+
+	serviceResult = cropService.FindAreaByID(destinationAreaUID)
+	if serviceResult.Error != nil {
+		return serviceResult.Error
+	}
+
+	dstArea, ok := serviceResult.Result.(query.CropAreaQueryResult)
+	if !ok {
+		return CropError{Code: CropMoveToAreaErrorInvalidDestinationArea}
+	}
+
+	if dstArea.UID == (uuid.UUID{}) {
+		return CropError{Code: CropMoveToAreaErrorDestinationAreaNotFound}
+	}
+
+	if srcArea.UID == dstArea.UID {
+		return CropError{Code: CropMoveToAreaErrorCannotBeSame}
+	}
+
+	if quantity <= 0 {
+		return CropError{Code: CropMoveToAreaErrorInvalidQuantity}
+	}
+
+	// The destination area must have been filled by a movement from the source area
+	movedArea := MovedArea{}
+	for _, v := range c.MovedArea {
+		if v.AreaUID == dstArea.UID && v.SourceAreaUID == srcArea.UID {
+			movedArea = v
+		}
+	}
+
+	if movedArea.AreaUID == (uuid.UUID{}) {
+		return CropError{Code: CropCorrectionErrorMoveNotFound}
+	}
+
+	if movedArea.CurrentQuantity < quantity {
+		return CropError{Code: CropMoveToAreaErrorInvalidQuantity}
+	}
+
+	// Process //
+	revertDate := time.Now()
+
+	movedArea.CurrentQuantity -= quantity
+	movedArea.InitialQuantity -= quantity
+	movedArea.LastUpdated = revertDate
+
+	var updatedSrcArea interface{}
+	updatedSrcAreaCode := ""
+	if c.InitialArea.AreaUID == srcArea.UID {
+		ia := c.InitialArea
+		ia.CurrentQuantity += quantity
+		ia.LastUpdated = revertDate
+
+		updatedSrcArea = ia
+		updatedSrcAreaCode = "INITIAL_AREA"
+	}
+	for _, v := range c.MovedArea {
+		if v.AreaUID == srcArea.UID {
+			ma := v
+			ma.CurrentQuantity += quantity
+			ma.LastUpdated = revertDate
+
+			updatedSrcArea = ma
+			updatedSrcAreaCode = "MOVED_AREA"
+		}
+	}
+
+	if updatedSrcAreaCode == "" {
+		return CropError{Code: CropMoveToAreaErrorInvalidExistingArea}
+	}
+
+	c.TrackChange(CropBatchMoveReverted{
+		UID:                c.UID,
+		Quantity:           quantity,
+		SrcAreaUID:         srcArea.UID,
+		DstAreaUID:         dstArea.UID,
+		UpdatedSrcArea:     updatedSrcArea,
+		UpdatedSrcAreaCode: updatedSrcAreaCode,
+		UpdatedDstArea:     movedArea,
+		RevertedBy:         revertedBy,
+		RevertDate:         revertDate,
+		Notes:              notes,
+	})
+
+	return nil
+}
+
+func (c *Crop) Fertilize() error {
+	// c.LastFertilized = time.Now()
+
+	return nil
+}
+
+func (c *Crop) Prune() error {
+	// c.LastPruned = time.Now()
+
+	return nil
+}
+
+func (c *Crop) Pesticide() error {
+	// c.LastPesticided = time.Now()
+
+	return nil
+}
+
+func (c *Crop) Water(cropService CropService, sourceAreaUID uuid.UUID, wateringDate time.Time) error {
+	serviceResult := cropService.FindAreaByID(sourceAreaUID)
+	if serviceResult.Error != nil {
+		return serviceResult.Error
+	}
+
+	srcArea, ok := serviceResult.Result.(query.CropAreaQueryResult)
+	if !ok {
+		return CropError{Code: CropDumpErrorInvalidSourceArea}
+	}
+
+	if srcArea == (query.CropAreaQueryResult{}) {
+		return CropError{Code: CropDumpErrorSourceAreaNotFound}
+	}
+
+	if wateringDate.IsZero() {
+		return CropError{Code: CropWaterErrorInvalidWateringDate}
+	}
+
+	c.TrackChange(CropBatchWatered{
+		UID:           c.UID,
+		BatchID:       c.BatchID,
+		ContainerType: c.Container.Type.Code(),
+		AreaUID:       srcArea.UID,
+		AreaName:      srcArea.Name,
+		WateringDate:  wateringDate,
+	})
+
+	return nil
+}
+
+func (c *Crop) ChangeCropType(cropType string) error {
+	ct := GetCropType(cropType)
+	if ct == (CropType{}) {
+		return CropError{Code: CropErrorInvalidCropType}
+	}
+
+	c.TrackChange(CropBatchTypeChanged{
+		UID:  c.UID,
+		Type: ct,
+	})
+
+	return nil
+}
+
+func (c *Crop) ChangeCropStatus(cropStatus string) error {
+	cs := GetCropStatus(cropStatus)
+	if cs == (CropStatus{}) {
+		return CropError{Code: CropErrorInvalidCropStatus}
+	}
+
+	c.Status = cs
+
+	return nil
+}
+
+func (c *Crop) ChangeContainer(quantity int, containerType CropContainerType) error {
+	err := validateContainer(quantity, containerType)
+	if err != nil {
+		return err
+	}
+
+	if c.InitialArea.CurrentQuantity != c.InitialArea.InitialQuantity {
+		return CropError{Code: CropContainerErrorInvalidType}
+	}
+
+	c.TrackChange(CropBatchContainerChanged{
+		UID: c.UID,
+		Container: CropContainer{
+			Quantity: quantity,
 			Type:     containerType,
 		},
 	})
@@ -1118,7 +2026,8 @@ func (c *Crop) RemoveNote(uid uuid.UUID) error {
 	return nil
 }
 
-func (c *Crop) AddPhoto(filename, mimeType string, size, width, height int, description string) error {
+// AddPhoto adds the photo whose file is uploaded under its StoredFilename
+func (c *Crop) AddPhoto(uid uuid.UUID, filename, mimeType string, size, width, height int, description string) error {
 	if filename == "" {
 		return CropError{CropErrorPhotoInvalidFilename}
 	}
@@ -1135,11 +2044,6 @@ func (c *Crop) AddPhoto(filename, mimeType string, size, width, height int, desc
 		return CropError{CropErrorPhotoInvalidDescription}
 	}
 
-	uid, err := uuid.NewV4()
-	if err != nil {
-		return err
-	}
-
 	c.TrackChange(CropBatchPhotoCreated{
 		UID:         uid,
 		CropUID:     c.UID,
@@ -1154,6 +2058,50 @@ func (c *Crop) AddPhoto(filename, mimeType string, size, width, height int, desc
 	return nil
 }
 
+func (c *Crop) RemovePhoto(uid uuid.UUID) error {
+	found, err := c.findPhoto(uid)
+	if err != nil {
+		return err
+	}
+
+	c.TrackChange(CropBatchPhotoRemoved{
+		UID:      found.UID,
+		CropUID:  c.UID,
+		Filename: found.Filename,
+	})
+
+	return nil
+}
+
+func (c *Crop) ChangePhotoDescription(uid uuid.UUID, description string) error {
+	if description == "" {
+		return CropError{CropErrorPhotoInvalidDescription}
+	}
+
+	found, err := c.findPhoto(uid)
+	if err != nil {
+		return err
+	}
+
+	c.TrackChange(CropBatchPhotoDescriptionChanged{
+		UID:         found.UID,
+		CropUID:     c.UID,
+		Description: description,
+	})
+
+	return nil
+}
+
+func (c Crop) findPhoto(uid uuid.UUID) (CropPhoto, error) {
+	for _, v := range c.Photos {
+		if v.UID == uid {
+			return v, nil
+		}
+	}
+
+	return CropPhoto{}, CropError{CropErrorPhotoNotFound}
+}
+
 // CalculateDaysSinceSeeding will find how long since its been seeded
 // It basically tell use the days since this crop is created.
 func (c Crop) CalculateDaysSinceSeeding() int {
@@ -1166,6 +2114,33 @@ func (c Crop) CalculateDaysSinceSeeding() int {
 	return days
 }
 
+// statusAfterAreaUpdate calculates the crop status if one of its area is replaced with updatedArea.
+// Crop is archived when there is no more plant left in all of its area.
+func (c Crop) statusAfterAreaUpdate(updatedArea interface{}) string {
+	total := 0
+
+	initialArea := c.InitialArea
+	if ia, ok := updatedArea.(InitialArea); ok {
+		initialArea = ia
+	}
+
+	total += initialArea.CurrentQuantity
+
+	for _, v := range c.MovedArea {
+		if ma, ok := updatedArea.(MovedArea); ok && ma.AreaUID == v.AreaUID {
+			v = ma
+		}
+
+		total += v.CurrentQuantity
+	}
+
+	if total > 0 {
+		return CropActive
+	}
+
+	return CropArchived
+}
+
 func generateBatchID(cropService CropService, inventory query.CropMaterialQueryResult, createdDate time.Time) (string, error) {
 	// Generate Batch ID
 	// Format the date to become daymonth format like 25jan
@@ -54,6 +54,56 @@ const (
 
 	CropNoteErrorInvalidContent
 	CropNoteErrorNotFound
+
+	// Crop correction errors
+	CropCorrectionErrorHarvestNotFound
+	CropCorrectionErrorInvalidProducedQuantity
+	CropCorrectionErrorInvalidProducedUnit
+	CropCorrectionErrorDumpNotFound
+	CropCorrectionErrorNotEnoughDumpedQuantity
+	CropCorrectionErrorMoveNotFound
+
+	CropErrorPhotoNotFound
+
+	CropInventoryErrorInvalidQuantity
+	CropInventoryErrorNotEnoughStock
+
+	CropHarvestErrorInvalidGrade
+
+	CropDumpErrorInvalidReason
+
+	// Crop template errors
+	CropTemplateErrorInvalidName
+	CropTemplateErrorEmptyTasks
+	CropTemplateErrorInvalidTaskTitle
+	CropTemplateErrorInvalidTaskCategory
+	CropTemplateErrorInvalidTaskPriority
+	CropTemplateErrorInvalidDayOffset
+	CropTemplateErrorInvalidRepetition
+	CropTemplateErrorNotFound
+	CropTemplateErrorInvalidInventory
+
+	CropUnarchiveErrorNotArchived
+	CropUnarchiveErrorAreaNotFound
+	CropUnarchiveErrorInvalidQuantity
+
+	CropHarvestErrorInvalidProducedUnit
+
+	// Harvest unit errors
+	HarvestUnitErrorInvalidCode
+	HarvestUnitErrorWeightUnit
+	HarvestUnitErrorInvalidAverageWeight
+	HarvestUnitErrorAlreadyExists
+	HarvestUnitErrorNotFound
+
+	SuccessionPlanErrorInvalidName
+	SuccessionPlanErrorEmptyAreas
+	SuccessionPlanErrorInvalidInterval
+	SuccessionPlanErrorInvalidDateRange
+	SuccessionPlanErrorTooManyBatches
+	SuccessionPlanErrorNotFound
+	SuccessionPlanErrorBatchNotFound
+	SuccessionPlanErrorBatchNotPlanned
 )
 
 // CropError is a custom error from Go built-in error
@@ -147,6 +197,90 @@ func (e CropError) Error() string {
 		return "Invalid crop note content"
 	case CropNoteErrorNotFound:
 		return "Crop note not found"
+
+	case CropCorrectionErrorHarvestNotFound:
+		return "There is no harvest to correct in this source area"
+	case CropCorrectionErrorInvalidProducedQuantity:
+		return "Invalid produced quantity"
+	case CropCorrectionErrorInvalidProducedUnit:
+		return "Invalid produced unit"
+	case CropCorrectionErrorDumpNotFound:
+		return "There is no dumped crop to revert in this source area"
+	case CropCorrectionErrorNotEnoughDumpedQuantity:
+		return "Not enough dumped quantity to revert"
+	case CropCorrectionErrorMoveNotFound:
+		return "There is no movement from the source area to the destination area to revert"
+
+	case CropErrorPhotoNotFound:
+		return "Crop photo not found"
+
+	case CropInventoryErrorInvalidQuantity:
+		return "Invalid inventory quantity to consume"
+	case CropInventoryErrorNotEnoughStock:
+		return "Not enough inventory stock for this crop batch"
+
+	case CropHarvestErrorInvalidGrade:
+		return "Invalid harvest grade"
+
+	case CropDumpErrorInvalidReason:
+		return "Invalid dump reason"
+
+	case CropTemplateErrorInvalidName:
+		return "Invalid crop template name"
+	case CropTemplateErrorEmptyTasks:
+		return "Crop template must have at least one task"
+	case CropTemplateErrorInvalidTaskTitle:
+		return "Invalid crop template task title"
+	case CropTemplateErrorInvalidTaskCategory:
+		return "Invalid crop template task category"
+	case CropTemplateErrorInvalidTaskPriority:
+		return "Invalid crop template task priority"
+	case CropTemplateErrorInvalidDayOffset:
+		return "Invalid crop template task day offset. It must be at least one day after seeding"
+	case CropTemplateErrorInvalidRepetition:
+		return "Invalid crop template task repetition. The last day must be after the day offset"
+	case CropTemplateErrorNotFound:
+		return "Crop template not found"
+	case CropTemplateErrorInvalidInventory:
+		return "Crop template is not for the inventory of this crop batch"
+
+	case CropUnarchiveErrorNotArchived:
+		return "Crop batch is not archived"
+	case CropUnarchiveErrorAreaNotFound:
+		return "Area to restore is not one of the crop batch areas"
+	case CropUnarchiveErrorInvalidQuantity:
+		return "Invalid quantity to restore. It must not exceed the quantity that has been dumped or harvested from the area"
+
+	case CropHarvestErrorInvalidProducedUnit:
+		return "Invalid harvest produced unit"
+
+	case HarvestUnitErrorInvalidCode:
+		return "Invalid harvest unit code"
+	case HarvestUnitErrorWeightUnit:
+		return "Harvest unit code is already a weight unit"
+	case HarvestUnitErrorInvalidAverageWeight:
+		return "Invalid harvest unit average weight"
+	case HarvestUnitErrorAlreadyExists:
+		return "Harvest unit already exists for this inventory"
+	case HarvestUnitErrorNotFound:
+		return "Harvest unit not found"
+
+	case SuccessionPlanErrorInvalidName:
+		return "Invalid succession plan name"
+	case SuccessionPlanErrorEmptyAreas:
+		return "Succession plan must have at least one area"
+	case SuccessionPlanErrorInvalidInterval:
+		return "Invalid succession plan interval. It must be at least one day"
+	case SuccessionPlanErrorInvalidDateRange:
+		return "Invalid succession plan dates. The end date must not be before the start date"
+	case SuccessionPlanErrorTooManyBatches:
+		return "Succession plan has too many crop batches. Use a longer interval or a shorter date range"
+	case SuccessionPlanErrorNotFound:
+		return "Succession plan not found"
+	case SuccessionPlanErrorBatchNotFound:
+		return "Planned crop batch not found"
+	case SuccessionPlanErrorBatchNotPlanned:
+		return "Planned crop batch has already been confirmed or skipped"
 	default:
 		return "Unrecognized Crop Error Code"
 	}
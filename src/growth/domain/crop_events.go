@@ -19,6 +19,24 @@ type CropBatchCreated struct {
 	Quantity       int
 }
 
+type CropBatchInventoryConsumed struct {
+	UID          uuid.UUID
+	InventoryUID uuid.UUID
+	Quantity     float32
+	QuantityUnit string
+	ConsumedDate time.Time
+}
+
+type CropBatchTemplateApplied struct {
+	UID          uuid.UUID
+	BatchID      string
+	TemplateUID  uuid.UUID
+	TemplateName string
+	AreaUID      uuid.UUID
+	SeedingDate  time.Time
+	Tasks        []CropTemplateTask
+}
+
 type CropBatchTypeChanged struct {
 	UID  uuid.UUID
 	Type CropType
@@ -54,6 +72,7 @@ type CropBatchHarvested struct {
 	HarvestedQuantity       int
 	ProducedGramQuantity    float32
 	UpdatedHarvestedStorage HarvestedStorage
+	HarvestLot              HarvestLot
 	HarvestedArea           interface{}
 	HarvestedAreaCode       string // Values: INITIAL_AREA / MOVED_AREA
 	HarvestDate             time.Time
@@ -68,6 +87,7 @@ type CropBatchDumped struct {
 	DumpedArea     interface{}
 	DumpedAreaCode string // Values: INITIAL_AREA / MOVED_AREA
 	DumpDate       time.Time
+	Reason         string
 	Notes          string
 }
 
@@ -104,3 +124,74 @@ type CropBatchPhotoCreated struct {
 	Height      int
 	Description string
 }
+
+type CropBatchPhotoRemoved struct {
+	UID      uuid.UUID
+	CropUID  uuid.UUID
+	Filename string
+}
+
+type CropBatchPhotoDescriptionChanged struct {
+	UID         uuid.UUID
+	CropUID     uuid.UUID
+	Description string
+}
+
+type CropBatchHarvestCorrected struct {
+	UID                          uuid.UUID
+	CropStatus                   string // Values: ACTIVE / ARCHIVED
+	SourceAreaUID                uuid.UUID
+	PreviousHarvestedQuantity    int
+	PreviousProducedGramQuantity float32
+	UpdatedHarvestedStorage      HarvestedStorage
+	UpdatedHarvestLots           []HarvestLot
+	VoidedHarvestLotUIDs         []uuid.UUID
+	HarvestedArea                interface{}
+	HarvestedAreaCode            string // Values: INITIAL_AREA / MOVED_AREA
+	CorrectedBy                  uuid.UUID
+	CorrectionDate               time.Time
+	Notes                        string
+}
+
+type CropBatchDumpReverted struct {
+	UID              uuid.UUID
+	CropStatus       string // Values: ACTIVE / ARCHIVED
+	Quantity         int
+	UpdatedTrash     Trash
+	RestoredArea     interface{}
+	RestoredAreaCode string // Values: INITIAL_AREA / MOVED_AREA
+	RevertedBy       uuid.UUID
+	RevertDate       time.Time
+	Notes            string
+}
+
+type CropBatchUnarchived struct {
+	UID                     uuid.UUID
+	CropStatus              string // Values: ACTIVE
+	Quantity                int
+	AreaUID                 uuid.UUID
+	DumpedQuantity          int
+	HarvestedQuantity       int
+	UpdatedTrash            Trash
+	UpdatedHarvestedStorage HarvestedStorage
+	UpdatedHarvestLots      []HarvestLot
+	VoidedHarvestLotUIDs    []uuid.UUID
+	RestoredArea            interface{}
+	RestoredAreaCode        string // Values: INITIAL_AREA / MOVED_AREA
+	UnarchivedBy            uuid.UUID
+	UnarchivedDate          time.Time
+	Notes                   string
+}
+
+type CropBatchMoveReverted struct {
+	UID                uuid.UUID
+	Quantity           int
+	SrcAreaUID         uuid.UUID
+	DstAreaUID         uuid.UUID
+	UpdatedSrcAreaCode string // Values: INITIAL_AREA / MOVED_AREA
+	UpdatedSrcArea     interface{}
+	UpdatedDstArea     MovedArea
+	RevertedBy         uuid.UUID
+	RevertDate         time.Time
+	Notes              string
+}
@@ -0,0 +1,93 @@
+package domain
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+// RotationRule forbids planting a plant family again in the same area
+// before the given number of years has passed since it was last planted there.
+type RotationRule struct {
+	PlantFamily string `json:"plant_family"`
+	Years       int    `json:"years"`
+}
+
+// PlantingRecord is a past planting of an area, used to check the crop rotation
+type PlantingRecord struct {
+	CropUID     uuid.UUID
+	BatchID     string
+	PlantFamily string
+	PlantedDate time.Time
+}
+
+// RotationWarning tells that a planting breaks a rotation rule.
+// It is only a warning, the crop batch is still created or moved.
+type RotationWarning struct {
+	PlantFamily         string    `json:"plant_family"`
+	Years               int       `json:"years"`
+	PreviousCropUID     uuid.UUID `json:"previous_crop_id"`
+	PreviousBatchID     string    `json:"previous_batch_id"`
+	PreviousPlantedDate time.Time `json:"previous_planted_date"`
+	Message             string    `json:"message"`
+}
+
+// ParseRotationRule parses a rotation rule written as PLANT_FAMILY:YEARS, for example SOLANACEAE:3
+func ParseRotationRule(rule string) (RotationRule, error) {
+	parts := strings.Split(rule, ":")
+	if len(parts) != 2 {
+		return RotationRule{}, errors.New("Invalid crop rotation rule " + rule + ". It should be written as PLANT_FAMILY:YEARS")
+	}
+
+	years, err := strconv.Atoi(strings.TrimSpace(parts[1]))
+	if err != nil || years <= 0 {
+		return RotationRule{}, errors.New("Invalid crop rotation rule " + rule + ". Years should be a positive number")
+	}
+
+	return RotationRule{
+		PlantFamily: strings.ToUpper(strings.TrimSpace(parts[0])),
+		Years:       years,
+	}, nil
+}
+
+// CheckCropRotation checks the planting of a plant family in an area at the given date
+// against the rotation rules and the planting history of the area.
+// It returns one warning per past planting that is too recent.
+func CheckCropRotation(rules []RotationRule, plantFamily string, plantingDate time.Time, history []PlantingRecord) []RotationWarning {
+	warnings := []RotationWarning{}
+
+	if plantFamily == "" {
+		return warnings
+	}
+
+	for _, rule := range rules {
+		if rule.PlantFamily != plantFamily {
+			continue
+		}
+
+		for _, record := range history {
+			if record.PlantFamily != plantFamily {
+				continue
+			}
+
+			if !record.PlantedDate.AddDate(rule.Years, 0, 0).After(plantingDate) {
+				continue
+			}
+
+			warnings = append(warnings, RotationWarning{
+				PlantFamily:         plantFamily,
+				Years:               rule.Years,
+				PreviousCropUID:     record.CropUID,
+				PreviousBatchID:     record.BatchID,
+				PreviousPlantedDate: record.PlantedDate,
+				Message: "Crop batch " + record.BatchID + " of the same plant family was planted in this area on " +
+					record.PlantedDate.Format("2006-01-02") + ". The rotation rule is " + strconv.Itoa(rule.Years) + " years",
+			})
+		}
+	}
+
+	return warnings
+}
@@ -0,0 +1,202 @@
+package domain
+
+import (
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+// CropTemplate is a named schedule of tasks for the crop batches seeded from an inventory material,
+// such as transplanting at day 14, fertilizing weekly and harvesting at day 45
+type CropTemplate struct {
+	UID          uuid.UUID
+	Name         string
+	InventoryUID uuid.UUID
+	Tasks        []CropTemplateTask
+	CreatedDate  time.Time
+	IsRemoved    bool
+
+	// Events
+	Version            int
+	UncommittedChanges []interface{}
+}
+
+// CropTemplateTask is a task created for each crop batch seeded with the crop template.
+// DayOffset is the number of days after the seeding date when the task is due.
+// When RepeatEveryDays is set, the task is also due every RepeatEveryDays days until RepeatUntilDay.
+type CropTemplateTask struct {
+	Title           string     `json:"title"`
+	Description     string     `json:"description"`
+	Category        string     `json:"category"`
+	Priority        string     `json:"priority"`
+	MaterialUID     *uuid.UUID `json:"material_id"`
+	DayOffset       int        `json:"day_offset"`
+	RepeatEveryDays int        `json:"repeat_every_days"`
+	RepeatUntilDay  int        `json:"repeat_until_day"`
+}
+
+// DayOffsets returns the number of days after the seeding date of each occurrence of the task
+func (t CropTemplateTask) DayOffsets() []int {
+	offsets := []int{t.DayOffset}
+
+	if t.RepeatEveryDays <= 0 {
+		return offsets
+	}
+
+	for day := t.DayOffset + t.RepeatEveryDays; day <= t.RepeatUntilDay; day += t.RepeatEveryDays {
+		offsets = append(offsets, day)
+	}
+
+	return offsets
+}
+
+func CreateCropTemplate(cropService CropService, name string, inventoryUID uuid.UUID, tasks []CropTemplateTask) (*CropTemplate, error) {
+	err := validateCropTemplateName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceResult := cropService.FindMaterialByID(inventoryUID)
+	if serviceResult.Error != nil {
+		return nil, serviceResult.Error
+	}
+
+	err = validateCropTemplateTasks(cropService, tasks)
+	if err != nil {
+		return nil, err
+	}
+
+	uid, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+
+	initial := &CropTemplate{}
+
+	initial.TrackChange(CropTemplateCreated{
+		UID:          uid,
+		Name:         name,
+		InventoryUID: inventoryUID,
+		Tasks:        tasks,
+		CreatedDate:  time.Now(),
+	})
+
+	return initial, nil
+}
+
+func (ct *CropTemplate) ChangeName(name string) error {
+	err := validateCropTemplateName(name)
+	if err != nil {
+		return err
+	}
+
+	ct.TrackChange(CropTemplateNameChanged{
+		UID:  ct.UID,
+		Name: name,
+	})
+
+	return nil
+}
+
+// ChangeTasks replaces the tasks of the crop template.
+// The tasks already created for the crop batches seeded with it are kept.
+func (ct *CropTemplate) ChangeTasks(cropService CropService, tasks []CropTemplateTask) error {
+	err := validateCropTemplateTasks(cropService, tasks)
+	if err != nil {
+		return err
+	}
+
+	ct.TrackChange(CropTemplateTasksChanged{
+		UID:   ct.UID,
+		Tasks: tasks,
+	})
+
+	return nil
+}
+
+func (ct *CropTemplate) Remove() error {
+	if ct.IsRemoved {
+		return CropError{Code: CropTemplateErrorNotFound}
+	}
+
+	ct.TrackChange(CropTemplateRemoved{
+		UID: ct.UID,
+	})
+
+	return nil
+}
+
+func (ct *CropTemplate) TrackChange(event interface{}) {
+	ct.UncommittedChanges = append(ct.UncommittedChanges, event)
+	ct.Transition(event)
+}
+
+func (ct *CropTemplate) Transition(event interface{}) {
+	switch e := event.(type) {
+	case CropTemplateCreated:
+		ct.UID = e.UID
+		ct.Name = e.Name
+		ct.InventoryUID = e.InventoryUID
+		ct.Tasks = e.Tasks
+		ct.CreatedDate = e.CreatedDate
+
+	case CropTemplateNameChanged:
+		ct.Name = e.Name
+
+	case CropTemplateTasksChanged:
+		ct.Tasks = e.Tasks
+
+	case CropTemplateRemoved:
+		ct.IsRemoved = true
+	}
+}
+
+func validateCropTemplateName(name string) error {
+	if name == "" {
+		return CropError{Code: CropTemplateErrorInvalidName}
+	}
+
+	return nil
+}
+
+// validateCropTemplateTasks checks the tasks with the same rules as the tasks domain, through the crop service,
+// so the tasks can be created when the crop template is applied to a crop batch.
+// The day offsets start from the day after seeding, because a task cannot be due in the past.
+func validateCropTemplateTasks(cropService CropService, tasks []CropTemplateTask) error {
+	if len(tasks) == 0 {
+		return CropError{Code: CropTemplateErrorEmptyTasks}
+	}
+
+	for _, v := range tasks {
+		if v.Title == "" {
+			return CropError{Code: CropTemplateErrorInvalidTaskTitle}
+		}
+
+		serviceResult := cropService.FindTaskCategoryByCode(v.Category)
+		if serviceResult.Error != nil {
+			return serviceResult.Error
+		}
+
+		serviceResult = cropService.FindTaskPriorityByCode(v.Priority)
+		if serviceResult.Error != nil {
+			return serviceResult.Error
+		}
+
+		if v.DayOffset < 1 {
+			return CropError{Code: CropTemplateErrorInvalidDayOffset}
+		}
+
+		if v.RepeatEveryDays < 0 || (v.RepeatEveryDays > 0 && v.RepeatUntilDay <= v.DayOffset) {
+			return CropError{Code: CropTemplateErrorInvalidRepetition}
+		}
+
+		if v.MaterialUID != nil {
+			serviceResult := cropService.FindMaterialByID(*v.MaterialUID)
+			if serviceResult.Error != nil {
+				return serviceResult.Error
+			}
+		}
+	}
+
+	return nil
+}
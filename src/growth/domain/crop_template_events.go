@@ -0,0 +1,29 @@
+package domain
+
+import (
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+type CropTemplateCreated struct {
+	UID          uuid.UUID
+	Name         string
+	InventoryUID uuid.UUID
+	Tasks        []CropTemplateTask
+	CreatedDate  time.Time
+}
+
+type CropTemplateNameChanged struct {
+	UID  uuid.UUID
+	Name string
+}
+
+type CropTemplateTasksChanged struct {
+	UID   uuid.UUID
+	Tasks []CropTemplateTask
+}
+
+type CropTemplateRemoved struct {
+	UID uuid.UUID
+}
@@ -0,0 +1,162 @@
+package domain_test
+
+import (
+	"testing"
+
+	. "github.com/Tanibox/tania-core/src/growth/domain"
+	"github.com/Tanibox/tania-core/src/growth/query"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreateCropTemplate(t *testing.T) {
+	// Given
+	cropServiceMock := new(CropServiceMock)
+
+	inventoryUID, _ := uuid.NewV4()
+	cropServiceMock.On("FindMaterialByID", inventoryUID).Return(ServiceResult{
+		Result: query.CropMaterialQueryResult{UID: inventoryUID, Name: "Lettuce Batavia"},
+	})
+
+	fertilizerUID, _ := uuid.NewV4()
+	cropServiceMock.On("FindMaterialByID", fertilizerUID).Return(ServiceResult{
+		Result: query.CropMaterialQueryResult{UID: fertilizerUID, Name: "Compost"},
+	})
+
+	cropServiceMock.On("FindTaskCategoryByCode", mock.Anything).Return(ServiceResult{})
+	cropServiceMock.On("FindTaskPriorityByCode", mock.Anything).Return(ServiceResult{})
+
+	tasks := []CropTemplateTask{
+		{Title: "Transplant", Category: "CROP", Priority: "NORMAL", DayOffset: 14},
+		{Title: "Fertilize", Category: "NUTRIENT", Priority: "NORMAL", MaterialUID: &fertilizerUID,
+			DayOffset: 7, RepeatEveryDays: 7, RepeatUntilDay: 30},
+		{Title: "Harvest", Category: "CROP", Priority: "URGENT", DayOffset: 45},
+	}
+
+	// When
+	cropTemplate, err := CreateCropTemplate(cropServiceMock, "Lettuce", inventoryUID, tasks)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, "Lettuce", cropTemplate.Name)
+	assert.Equal(t, inventoryUID, cropTemplate.InventoryUID)
+	assert.Equal(t, tasks, cropTemplate.Tasks)
+	assert.Equal(t, []int{14}, cropTemplate.Tasks[0].DayOffsets())
+	assert.Equal(t, []int{7, 14, 21, 28}, cropTemplate.Tasks[1].DayOffsets())
+
+	// When
+	err = cropTemplate.ChangeName("Lettuce Summer")
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, "Lettuce Summer", cropTemplate.Name)
+
+	// When
+	err = cropTemplate.ChangeTasks(cropServiceMock, tasks[:1])
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, tasks[:1], cropTemplate.Tasks)
+
+	// When
+	err = cropTemplate.Remove()
+
+	// Then
+	assert.Nil(t, err)
+	assert.True(t, cropTemplate.IsRemoved)
+	assert.Equal(t, CropError{Code: CropTemplateErrorNotFound}, cropTemplate.Remove())
+}
+
+func TestInvalidCropTemplate(t *testing.T) {
+	// Given
+	cropServiceMock := new(CropServiceMock)
+
+	inventoryUID, _ := uuid.NewV4()
+	cropServiceMock.On("FindMaterialByID", inventoryUID).Return(ServiceResult{
+		Result: query.CropMaterialQueryResult{UID: inventoryUID, Name: "Lettuce Batavia"},
+	})
+
+	cropServiceMock.On("FindTaskCategoryByCode", "CROP").Return(ServiceResult{})
+	cropServiceMock.On("FindTaskCategoryByCode", "HARVEST").Return(ServiceResult{
+		Error: CropError{Code: CropTemplateErrorInvalidTaskCategory},
+	})
+	cropServiceMock.On("FindTaskPriorityByCode", "NORMAL").Return(ServiceResult{})
+	cropServiceMock.On("FindTaskPriorityByCode", "").Return(ServiceResult{
+		Error: CropError{Code: CropTemplateErrorInvalidTaskPriority},
+	})
+
+	task := CropTemplateTask{Title: "Transplant", Category: "CROP", Priority: "NORMAL", DayOffset: 14}
+
+	var tableTests = []struct {
+		name     string
+		tasks    func() []CropTemplateTask
+		expected error
+	}{
+		{"", func() []CropTemplateTask { return []CropTemplateTask{task} }, CropError{Code: CropTemplateErrorInvalidName}},
+		{"Lettuce", func() []CropTemplateTask { return []CropTemplateTask{} }, CropError{Code: CropTemplateErrorEmptyTasks}},
+		{"Lettuce", func() []CropTemplateTask { t := task; t.Title = ""; return []CropTemplateTask{t} }, CropError{Code: CropTemplateErrorInvalidTaskTitle}},
+		{"Lettuce", func() []CropTemplateTask { t := task; t.Category = "HARVEST"; return []CropTemplateTask{t} }, CropError{Code: CropTemplateErrorInvalidTaskCategory}},
+		{"Lettuce", func() []CropTemplateTask { t := task; t.Priority = ""; return []CropTemplateTask{t} }, CropError{Code: CropTemplateErrorInvalidTaskPriority}},
+		{"Lettuce", func() []CropTemplateTask { t := task; t.DayOffset = 0; return []CropTemplateTask{t} }, CropError{Code: CropTemplateErrorInvalidDayOffset}},
+		{"Lettuce", func() []CropTemplateTask { t := task; t.RepeatEveryDays = 7; return []CropTemplateTask{t} }, CropError{Code: CropTemplateErrorInvalidRepetition}},
+	}
+
+	for _, test := range tableTests {
+		// When
+		_, err := CreateCropTemplate(cropServiceMock, test.name, inventoryUID, test.tasks())
+
+		// Then
+		assert.Equal(t, test.expected, err)
+	}
+}
+
+func TestApplyCropTemplate(t *testing.T) {
+	// Given
+	inventoryUID, _ := uuid.NewV4()
+	areaUID, _ := uuid.NewV4()
+	cropUID, _ := uuid.NewV4()
+	templateUID, _ := uuid.NewV4()
+
+	crop := &Crop{
+		UID:          cropUID,
+		BatchID:      "let-bat-18oct",
+		InventoryUID: inventoryUID,
+		InitialArea:  InitialArea{AreaUID: areaUID},
+	}
+
+	cropTemplate := CropTemplate{
+		UID:          templateUID,
+		Name:         "Lettuce",
+		InventoryUID: inventoryUID,
+		Tasks:        []CropTemplateTask{{Title: "Transplant", Category: "CROP", Priority: "NORMAL", DayOffset: 14}},
+	}
+
+	// When
+	err := crop.ApplyTemplate(cropTemplate)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, &templateUID, crop.TemplateUID)
+
+	event, ok := crop.UncommittedChanges[0].(CropBatchTemplateApplied)
+	assert.True(t, ok)
+	assert.Equal(t, areaUID, event.AreaUID)
+	assert.Equal(t, cropTemplate.Tasks, event.Tasks)
+
+	// When
+	otherInventoryUID, _ := uuid.NewV4()
+	cropTemplate.InventoryUID = otherInventoryUID
+	err = crop.ApplyTemplate(cropTemplate)
+
+	// Then
+	assert.Equal(t, CropError{Code: CropTemplateErrorInvalidInventory}, err)
+
+	// When
+	cropTemplate.InventoryUID = inventoryUID
+	cropTemplate.IsRemoved = true
+	err = crop.ApplyTemplate(cropTemplate)
+
+	// Then
+	assert.Equal(t, CropError{Code: CropTemplateErrorNotFound}, err)
+}
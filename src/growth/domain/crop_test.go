@@ -29,6 +29,14 @@ func (m CropServiceMock) FindAreaByID(uid uuid.UUID) ServiceResult {
 	args := m.Called(uid)
 	return args.Get(0).(ServiceResult)
 }
+func (m CropServiceMock) FindTaskCategoryByCode(code string) ServiceResult {
+	args := m.Called(code)
+	return args.Get(0).(ServiceResult)
+}
+func (m CropServiceMock) FindTaskPriorityByCode(code string) ServiceResult {
+	args := m.Called(code)
+	return args.Get(0).(ServiceResult)
+}
 
 func TestCreateCropBatch(t *testing.T) {
 	// Given
@@ -63,7 +71,7 @@ func TestCreateCropBatch(t *testing.T) {
 	// When
 	crop, _ := CreateCropBatch(cropServiceMock, areaAUID, CropTypeSeeding, inventoryUID, 20, containerType)
 	crop.MoveToArea(cropServiceMock, areaAUID, areaBUID, 15)
-	crop.Dump(cropServiceMock, areaBUID, 5, "Notes")
+	crop.Dump(cropServiceMock, areaBUID, 5, DumpReasonDisease, "Notes")
 	crop.Fertilize()
 	crop.Pesticide()
 	crop.Prune()
@@ -78,6 +86,13 @@ func TestCreateCropBatch(t *testing.T) {
 	// Dump
 	assert.Equal(t, areaBUID, crop.Trash[0].SourceAreaUID)
 	assert.Equal(t, 5, crop.Trash[0].Quantity)
+	assert.Equal(t, map[string]int{DumpReasonDisease: 5}, crop.Trash[0].Reasons)
+
+	// When
+	err := crop.Dump(cropServiceMock, areaBUID, 1, "FORGOTTEN", "Notes")
+
+	// Then
+	assert.Equal(t, CropError{Code: CropDumpErrorInvalidReason}, err)
 }
 
 func TestHarvestCropBatch(t *testing.T) {
@@ -113,8 +128,14 @@ func TestHarvestCropBatch(t *testing.T) {
 	// When
 	crop, _ := CreateCropBatch(cropServiceMock, areaAUID, CropTypeSeeding, inventoryUID, 20, containerType)
 	crop.MoveToArea(cropServiceMock, areaAUID, areaBUID, 15)
-	err1 := crop.Harvest(cropServiceMock, areaBUID, HarvestTypePartial, 10, GetProducedUnit(Kg), "Notes")
-	err2 := crop.Harvest(cropServiceMock, areaAUID, HarvestTypePartial, 10, GetProducedUnit(Kg), "Notes")
+
+	// Then
+	assert.Equal(t, 5, crop.CurrentQuantityInArea(areaAUID))
+	assert.Equal(t, 15, crop.CurrentQuantityInArea(areaBUID))
+
+	// When
+	err1 := crop.Harvest(cropServiceMock, areaBUID, HarvestTypePartial, 10, GetProducedUnit(Kg), HarvestGradeA, "Notes")
+	err2 := crop.Harvest(cropServiceMock, areaAUID, HarvestTypePartial, 10, GetProducedUnit(Kg), HarvestGradeA, "Notes")
 
 	// Then
 	cropServiceMock.AssertExpectations(t)
@@ -127,13 +148,25 @@ func TestHarvestCropBatch(t *testing.T) {
 	assert.NotNil(t, err2)
 
 	// When
-	crop.Harvest(cropServiceMock, areaBUID, HarvestTypeAll, 2000, GetProducedUnit(Gr), "Notes")
+	crop.Harvest(cropServiceMock, areaBUID, HarvestTypeAll, 2000, GetProducedUnit(Gr), HarvestGradeB, "Notes")
+	err3 := crop.Harvest(cropServiceMock, areaBUID, HarvestTypePartial, 10, GetProducedUnit(Kg), "C", "Notes")
 
 	// Then
 	assert.Equal(t, 0, crop.MovedArea[0].CurrentQuantity)
 	assert.Equal(t, 15, crop.HarvestedStorage[0].Quantity)
 	assert.Equal(t, float32(12000), crop.HarvestedStorage[0].ProducedGramQuantity)
 
+	// Harvest lots are not merged
+	today := time.Now().Format("20060102")
+	assert.Len(t, crop.HarvestLots, 2)
+	assert.Equal(t, batchID+"-"+today+"-01", crop.HarvestLots[0].LotNumber)
+	assert.Equal(t, HarvestGradeA, crop.HarvestLots[0].Grade)
+	assert.Equal(t, float32(10000), crop.HarvestLots[0].ProducedGramQuantity)
+	assert.Equal(t, batchID+"-"+today+"-02", crop.HarvestLots[1].LotNumber)
+	assert.Equal(t, HarvestGradeB, crop.HarvestLots[1].Grade)
+	assert.Equal(t, float32(2000), crop.HarvestLots[1].ProducedGramQuantity)
+
+	assert.Equal(t, CropError{Code: CropHarvestErrorInvalidGrade}, err3)
 }
 
 func TestWaterCrop(t *testing.T) {
@@ -219,14 +252,14 @@ func TestCropHarvestArchiveStatus(t *testing.T) {
 	// When
 	crop, _ := CreateCropBatch(cropServiceMock, areaAUID, CropTypeSeeding, inventoryUID, 20, containerType)
 	crop.MoveToArea(cropServiceMock, areaAUID, areaBUID, 15)
-	crop.Harvest(cropServiceMock, areaBUID, HarvestTypeAll, 2000, GetProducedUnit(Gr), "Notes")
+	crop.Harvest(cropServiceMock, areaBUID, HarvestTypeAll, 2000, GetProducedUnit(Gr), HarvestGradeA, "Notes")
 
 	// Then
 	assert.Equal(t, crop.Status.Code, CropActive)
 
 	// When
 	crop.MoveToArea(cropServiceMock, areaAUID, areaBUID, 5)
-	crop.Harvest(cropServiceMock, areaBUID, HarvestTypeAll, 3000, GetProducedUnit(Gr), "Notes")
+	crop.Harvest(cropServiceMock, areaBUID, HarvestTypeAll, 3000, GetProducedUnit(Gr), HarvestGradeA, "Notes")
 
 	// Then
 	assert.Equal(t, crop.Status.Code, CropArchived)
@@ -265,14 +298,435 @@ func TestCropDumpArchiveStatus(t *testing.T) {
 	// When
 	crop, _ := CreateCropBatch(cropServiceMock, areaAUID, CropTypeSeeding, inventoryUID, 20, containerType)
 	crop.MoveToArea(cropServiceMock, areaAUID, areaBUID, 15)
-	crop.Dump(cropServiceMock, areaBUID, 15, "Notes")
+	crop.Dump(cropServiceMock, areaBUID, 15, DumpReasonDisease, "Notes")
 
 	// Then
 	assert.Equal(t, crop.Status.Code, CropActive)
 
 	// When
-	crop.Dump(cropServiceMock, areaAUID, 5, "Notes")
+	crop.Dump(cropServiceMock, areaAUID, 5, DumpReasonDisease, "Notes")
 
 	// Then
 	assert.Equal(t, crop.Status.Code, CropArchived)
 }
+
+func TestCropCorrections(t *testing.T) {
+	// Given
+	cropServiceMock := new(CropServiceMock)
+
+	areaAUID, _ := uuid.NewV4()
+	areaBUID, _ := uuid.NewV4()
+	areaAServiceResult := ServiceResult{
+		Result: query.CropAreaQueryResult{UID: areaAUID, Type: "SEEDING"},
+	}
+	areaBServiceResult := ServiceResult{
+		Result: query.CropAreaQueryResult{UID: areaBUID, Type: "GROWING"},
+	}
+	cropServiceMock.On("FindAreaByID", areaAUID).Return(areaAServiceResult)
+	cropServiceMock.On("FindAreaByID", areaBUID).Return(areaBServiceResult)
+
+	inventoryUID, _ := uuid.NewV4()
+	inventoryServiceResult := ServiceResult{
+		Result: query.CropMaterialQueryResult{
+			UID:  inventoryUID,
+			Name: "Tomato Super One",
+		},
+	}
+	cropServiceMock.On("FindMaterialByID", inventoryUID).Return(inventoryServiceResult)
+
+	date := strings.ToLower(time.Now().Format("2Jan"))
+	batchID := fmt.Sprintf("%s%s", "tom-sup-one-", date)
+	cropServiceMock.On("FindByBatchID", batchID).Return(ServiceResult{})
+
+	containerType := Tray{Cell: 15}
+	userUID, _ := uuid.NewV4()
+
+	// When
+	crop, _ := CreateCropBatch(cropServiceMock, areaAUID, CropTypeSeeding, inventoryUID, 20, containerType)
+	crop.MoveToArea(cropServiceMock, areaAUID, areaBUID, 15)
+	crop.Harvest(cropServiceMock, areaBUID, HarvestTypeAll, 50, GetProducedUnit(Kg), HarvestGradeA, "Notes")
+	crop.Dump(cropServiceMock, areaAUID, 5, DumpReasonDisease, "Notes")
+
+	// Then
+	assert.Equal(t, CropArchived, crop.Status.Code)
+
+	// When
+	err := crop.CorrectHarvest(cropServiceMock, areaBUID, 5, 5, GetProducedUnit(Kg), "Typo", userUID)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, CropActive, crop.Status.Code)
+	assert.Equal(t, 10, crop.MovedArea[0].CurrentQuantity)
+	assert.Equal(t, 5, crop.HarvestedStorage[0].Quantity)
+	assert.Equal(t, float32(5000), crop.HarvestedStorage[0].ProducedGramQuantity)
+
+	// When
+	err = crop.RevertDump(cropServiceMock, areaAUID, 5, "Wrong area", userUID)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, 5, crop.InitialArea.CurrentQuantity)
+	assert.Equal(t, 0, crop.Trash[0].Quantity)
+	assert.Empty(t, crop.Trash[0].Reasons)
+
+	// When
+	err = crop.RevertDump(cropServiceMock, areaAUID, 1, "Nothing left", userUID)
+
+	// Then
+	assert.Equal(t, CropError{Code: CropCorrectionErrorNotEnoughDumpedQuantity}, err)
+
+	// When
+	err = crop.RevertMove(cropServiceMock, areaAUID, areaBUID, 10, "Wrong quantity", userUID)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, 15, crop.InitialArea.CurrentQuantity)
+	assert.Equal(t, 0, crop.MovedArea[0].CurrentQuantity)
+
+	// When
+	err = crop.RevertMove(cropServiceMock, areaBUID, areaAUID, 1, "Wrong direction", userUID)
+
+	// Then
+	assert.Equal(t, CropError{Code: CropCorrectionErrorMoveNotFound}, err)
+}
+
+func TestCropCorrectHarvestLots(t *testing.T) {
+	// Given
+	cropServiceMock := new(CropServiceMock)
+
+	areaUID, _ := uuid.NewV4()
+	cropServiceMock.On("FindAreaByID", areaUID).Return(ServiceResult{
+		Result: query.CropAreaQueryResult{UID: areaUID, Type: "GROWING"},
+	})
+
+	inventoryUID, _ := uuid.NewV4()
+	cropServiceMock.On("FindMaterialByID", inventoryUID).Return(ServiceResult{
+		Result: query.CropMaterialQueryResult{UID: inventoryUID, Name: "Tomato Super One"},
+	})
+
+	date := strings.ToLower(time.Now().Format("2Jan"))
+	batchID := fmt.Sprintf("%s%s", "tom-sup-one-", date)
+	cropServiceMock.On("FindByBatchID", batchID).Return(ServiceResult{})
+
+	userUID, _ := uuid.NewV4()
+
+	crop, _ := CreateCropBatch(cropServiceMock, areaUID, CropTypeGrowing, inventoryUID, 20, Tray{Cell: 15})
+	crop.Harvest(cropServiceMock, areaUID, HarvestTypePartial, 10, GetProducedUnit(Kg), HarvestGradeA, "Notes")
+	crop.Harvest(cropServiceMock, areaUID, HarvestTypePartial, 2, GetProducedUnit(Kg), HarvestGradeB, "Notes")
+
+	lotAUID := crop.HarvestLots[0].UID
+	lotBUID := crop.HarvestLots[1].UID
+
+	// When
+	err := crop.CorrectHarvest(cropServiceMock, areaUID, 0, 11, GetProducedUnit(Kg), "Typo", userUID)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Len(t, crop.HarvestLots, 2)
+	assert.Equal(t, float32(10000), crop.HarvestLots[0].ProducedGramQuantity)
+	assert.Equal(t, float32(1000), crop.HarvestLots[1].ProducedGramQuantity)
+	assert.Equal(t, float32(1), crop.HarvestLots[1].ProducedQuantity)
+
+	event, ok := crop.UncommittedChanges[len(crop.UncommittedChanges)-1].(CropBatchHarvestCorrected)
+	assert.True(t, ok)
+	assert.Len(t, event.UpdatedHarvestLots, 1)
+	assert.Equal(t, lotBUID, event.UpdatedHarvestLots[0].UID)
+	assert.Empty(t, event.VoidedHarvestLotUIDs)
+
+	// When
+	err = crop.CorrectHarvest(cropServiceMock, areaUID, 0, 9, GetProducedUnit(Kg), "Grade B was dumped", userUID)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Len(t, crop.HarvestLots, 1)
+	assert.Equal(t, lotAUID, crop.HarvestLots[0].UID)
+	assert.Equal(t, float32(9000), crop.HarvestLots[0].ProducedGramQuantity)
+	assert.Equal(t, float32(9), crop.HarvestLots[0].ProducedQuantity)
+
+	event, ok = crop.UncommittedChanges[len(crop.UncommittedChanges)-1].(CropBatchHarvestCorrected)
+	assert.True(t, ok)
+	assert.Equal(t, []uuid.UUID{lotBUID}, event.VoidedHarvestLotUIDs)
+
+	// When
+	err = crop.CorrectHarvest(cropServiceMock, areaUID, 0, 0, GetProducedUnit(Kg), "Not harvested", userUID)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Empty(t, crop.HarvestLots)
+	assert.Equal(t, 20, crop.InitialArea.CurrentQuantity)
+}
+
+func TestCropUnarchive(t *testing.T) {
+	// Given
+	cropServiceMock := new(CropServiceMock)
+
+	areaAUID, _ := uuid.NewV4()
+	areaBUID, _ := uuid.NewV4()
+	areaCUID, _ := uuid.NewV4()
+	cropServiceMock.On("FindAreaByID", areaAUID).Return(ServiceResult{
+		Result: query.CropAreaQueryResult{UID: areaAUID, Type: "SEEDING"},
+	})
+	cropServiceMock.On("FindAreaByID", areaBUID).Return(ServiceResult{
+		Result: query.CropAreaQueryResult{UID: areaBUID, Type: "GROWING"},
+	})
+	cropServiceMock.On("FindAreaByID", areaCUID).Return(ServiceResult{
+		Result: query.CropAreaQueryResult{UID: areaCUID, Type: "GROWING"},
+	})
+
+	inventoryUID, _ := uuid.NewV4()
+	cropServiceMock.On("FindMaterialByID", inventoryUID).Return(ServiceResult{
+		Result: query.CropMaterialQueryResult{UID: inventoryUID, Name: "Tomato Super One"},
+	})
+
+	date := strings.ToLower(time.Now().Format("2Jan"))
+	batchID := fmt.Sprintf("%s%s", "tom-sup-one-", date)
+	cropServiceMock.On("FindByBatchID", batchID).Return(ServiceResult{})
+
+	userUID, _ := uuid.NewV4()
+
+	crop, _ := CreateCropBatch(cropServiceMock, areaAUID, CropTypeSeeding, inventoryUID, 20, Tray{Cell: 15})
+	crop.MoveToArea(cropServiceMock, areaAUID, areaBUID, 15)
+
+	// When
+	err := crop.Unarchive(cropServiceMock, areaBUID, 10, "Still active", userUID)
+
+	// Then
+	assert.Equal(t, CropError{Code: CropUnarchiveErrorNotArchived}, err)
+
+	// When
+	crop.Dump(cropServiceMock, areaAUID, 5, DumpReasonDisease, "Notes")
+	crop.Dump(cropServiceMock, areaBUID, 15, DumpReasonDisease, "Wrong batch")
+
+	// Then
+	assert.Equal(t, CropArchived, crop.Status.Code)
+
+	// When
+	err = crop.Unarchive(cropServiceMock, areaCUID, 10, "Not its area", userUID)
+
+	// Then
+	assert.Equal(t, CropError{Code: CropUnarchiveErrorAreaNotFound}, err)
+
+	// When
+	err = crop.Unarchive(cropServiceMock, areaBUID, 16, "Too many", userUID)
+
+	// Then
+	assert.Equal(t, CropError{Code: CropUnarchiveErrorInvalidQuantity}, err)
+
+	// When
+	err = crop.Unarchive(cropServiceMock, areaBUID, 12, "Wrong batch", userUID)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, CropActive, crop.Status.Code)
+	assert.Equal(t, 12, crop.MovedArea[0].CurrentQuantity)
+	assert.Equal(t, 0, crop.InitialArea.CurrentQuantity)
+	assert.Equal(t, 5, crop.Trash[0].Quantity)
+	assert.Equal(t, 3, crop.Trash[1].Quantity)
+	assert.Equal(t, map[string]int{DumpReasonDisease: 3}, crop.Trash[1].Reasons)
+
+	// When
+	crop.Harvest(cropServiceMock, areaBUID, HarvestTypeAll, 2, GetProducedUnit(Kg), HarvestGradeA, "Notes")
+
+	// Then
+	assert.Equal(t, CropArchived, crop.Status.Code)
+	assert.Equal(t, 12, crop.HarvestedStorage[0].Quantity)
+
+	// When
+	err = crop.Unarchive(cropServiceMock, areaBUID, 16, "Too many", userUID)
+
+	// Then
+	assert.Equal(t, CropError{Code: CropUnarchiveErrorInvalidQuantity}, err)
+
+	// When
+	err = crop.Unarchive(cropServiceMock, areaBUID, 9, "Wrong harvest", userUID)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, 9, crop.MovedArea[0].CurrentQuantity)
+	assert.Equal(t, 0, crop.Trash[1].Quantity)
+	assert.Equal(t, 6, crop.HarvestedStorage[0].Quantity)
+	assert.Equal(t, float32(1000), crop.HarvestedStorage[0].ProducedGramQuantity)
+	assert.Len(t, crop.HarvestLots, 1)
+	assert.Equal(t, float32(1000), crop.HarvestLots[0].ProducedGramQuantity)
+	assert.Equal(t, float32(1), crop.HarvestLots[0].ProducedQuantity)
+
+	// When
+	err = crop.Harvest(cropServiceMock, areaBUID, HarvestTypeAll, 3, GetProducedUnit(Kg), HarvestGradeA, "Notes")
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, CropArchived, crop.Status.Code)
+	assert.Equal(t, 15, crop.HarvestedStorage[0].Quantity)
+	assert.Equal(t, float32(4000), crop.HarvestedStorage[0].ProducedGramQuantity)
+	assert.Len(t, crop.HarvestLots, 2)
+	assert.Equal(t, float32(3000), crop.HarvestLots[1].ProducedGramQuantity)
+
+	// When
+	err = crop.Unarchive(cropServiceMock, areaBUID, 15, "Not harvested at all", userUID)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, 0, crop.HarvestedStorage[0].Quantity)
+	assert.Equal(t, float32(0), crop.HarvestedStorage[0].ProducedGramQuantity)
+	assert.Len(t, crop.HarvestLots, 0)
+}
+
+func TestCropPhotos(t *testing.T) {
+	// Given
+	cropServiceMock := new(CropServiceMock)
+
+	areaUID, _ := uuid.NewV4()
+	areaServiceResult := ServiceResult{
+		Result: query.CropAreaQueryResult{UID: areaUID, Type: "SEEDING"},
+	}
+	cropServiceMock.On("FindAreaByID", areaUID).Return(areaServiceResult)
+
+	inventoryUID, _ := uuid.NewV4()
+	inventoryServiceResult := ServiceResult{
+		Result: query.CropMaterialQueryResult{
+			UID:  inventoryUID,
+			Name: "Tomato Super One",
+		},
+	}
+	cropServiceMock.On("FindMaterialByID", inventoryUID).Return(inventoryServiceResult)
+
+	date := strings.ToLower(time.Now().Format("2Jan"))
+	batchID := fmt.Sprintf("%s%s", "tom-sup-one-", date)
+	cropServiceMock.On("FindByBatchID", batchID).Return(ServiceResult{})
+
+	crop, _ := CreateCropBatch(cropServiceMock, areaUID, CropTypeSeeding, inventoryUID, 20, Tray{Cell: 15})
+	firstUID, _ := uuid.NewV4()
+	secondUID, _ := uuid.NewV4()
+
+	crop.AddPhoto(firstUID, "IMG_0001.JPG", "image/jpeg", 100, 10, 10, "First photo")
+	crop.AddPhoto(secondUID, "IMG_0001.JPG", "image/jpeg", 100, 10, 10, "Second photo")
+
+	// Then
+	assert.Equal(t, firstUID.String()+".jpg", crop.Photos[0].StoredFilename())
+	assert.Equal(t, secondUID.String()+".jpg", crop.Photos[1].StoredFilename())
+
+	// When
+	err := crop.ChangePhotoDescription(firstUID, "Seedlings")
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, "Seedlings", crop.Photos[0].Description)
+
+	// When
+	err = crop.ChangePhotoDescription(firstUID, "")
+
+	// Then
+	assert.Equal(t, CropError{CropErrorPhotoInvalidDescription}, err)
+
+	// When
+	err = crop.RemovePhoto(secondUID)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(crop.Photos))
+	assert.Equal(t, firstUID, crop.Photos[0].UID)
+
+	// When
+	err = crop.RemovePhoto(secondUID)
+
+	// Then
+	assert.Equal(t, CropError{CropErrorPhotoNotFound}, err)
+}
+
+func TestCropConsumeInventory(t *testing.T) {
+	// Given
+	cropServiceMock := new(CropServiceMock)
+
+	areaUID, _ := uuid.NewV4()
+	areaServiceResult := ServiceResult{
+		Result: query.CropAreaQueryResult{UID: areaUID, Type: "SEEDING"},
+	}
+	cropServiceMock.On("FindAreaByID", areaUID).Return(areaServiceResult)
+
+	inventoryUID, _ := uuid.NewV4()
+	inventoryServiceResult := ServiceResult{
+		Result: query.CropMaterialQueryResult{
+			UID:          inventoryUID,
+			Name:         "Tomato Super One",
+			Quantity:     100,
+			QuantityUnit: "SEEDS",
+		},
+	}
+	cropServiceMock.On("FindMaterialByID", inventoryUID).Return(inventoryServiceResult)
+
+	date := strings.ToLower(time.Now().Format("2Jan"))
+	batchID := fmt.Sprintf("%s%s", "tom-sup-one-", date)
+	cropServiceMock.On("FindByBatchID", batchID).Return(ServiceResult{})
+
+	crop, _ := CreateCropBatch(cropServiceMock, areaUID, CropTypeSeeding, inventoryUID, 5, Tray{Cell: 15})
+
+	// When
+	seedQuantity := crop.SeedQuantity()
+	err := crop.ConsumeInventory(cropServiceMock, float32(seedQuantity), false)
+
+	// Then
+	assert.Equal(t, 75, seedQuantity)
+	assert.Nil(t, err)
+
+	// When
+	err = crop.ConsumeInventory(cropServiceMock, 150, false)
+
+	// Then
+	assert.Equal(t, CropError{Code: CropInventoryErrorNotEnoughStock}, err)
+
+	// When
+	err = crop.ConsumeInventory(cropServiceMock, 150, true)
+
+	// Then
+	assert.Nil(t, err)
+
+	event, ok := crop.UncommittedChanges[len(crop.UncommittedChanges)-1].(CropBatchInventoryConsumed)
+	assert.True(t, ok)
+	assert.Equal(t, float32(150), event.Quantity)
+	assert.Equal(t, "SEEDS", event.QuantityUnit)
+}
+
+func TestCheckCropRotation(t *testing.T) {
+	// Given
+	rules := []RotationRule{
+		{PlantFamily: "SOLANACEAE", Years: 3},
+		{PlantFamily: "BRASSICACEAE", Years: 2},
+	}
+
+	tomatoUID, _ := uuid.NewV4()
+	cabbageUID, _ := uuid.NewV4()
+	oldTomatoUID, _ := uuid.NewV4()
+
+	plantingDate := time.Date(2018, time.June, 1, 0, 0, 0, 0, time.UTC)
+	history := []PlantingRecord{
+		{CropUID: tomatoUID, BatchID: "tom-1", PlantFamily: "SOLANACEAE", PlantedDate: plantingDate.AddDate(-1, 0, 0)},
+		{CropUID: cabbageUID, BatchID: "cab-1", PlantFamily: "BRASSICACEAE", PlantedDate: plantingDate.AddDate(-1, 0, 0)},
+		{CropUID: oldTomatoUID, BatchID: "tom-0", PlantFamily: "SOLANACEAE", PlantedDate: plantingDate.AddDate(-3, 0, 0)},
+	}
+
+	// When
+	solanaceaeWarnings := CheckCropRotation(rules, "SOLANACEAE", plantingDate, history)
+	fabaceaeWarnings := CheckCropRotation(rules, "FABACEAE", plantingDate, history)
+	noFamilyWarnings := CheckCropRotation(rules, "", plantingDate, history)
+
+	// Then
+	assert.Len(t, solanaceaeWarnings, 1)
+	assert.Equal(t, tomatoUID, solanaceaeWarnings[0].PreviousCropUID)
+	assert.Equal(t, 3, solanaceaeWarnings[0].Years)
+
+	assert.Empty(t, fabaceaeWarnings)
+	assert.Empty(t, noFamilyWarnings)
+
+	// When
+	rule, err := ParseRotationRule("solanaceae:3")
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, RotationRule{PlantFamily: "SOLANACEAE", Years: 3}, rule)
+
+	// When
+	_, err = ParseRotationRule("SOLANACEAE")
+
+	// Then
+	assert.NotNil(t, err)
+}
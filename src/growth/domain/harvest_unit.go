@@ -0,0 +1,144 @@
+package domain
+
+import (
+	"strings"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+// HarvestUnit is a count unit of the harvest of an inventory material, like a bunch of basil
+// or a clamshell of microgreens. It can also give the average weight of one of the built-in
+// count units for that material, so its harvest can be weighed.
+type HarvestUnit struct {
+	UID               uuid.UUID
+	InventoryUID      uuid.UUID
+	Code              string
+	Label             string
+	AverageGramWeight float32
+	CreatedDate       time.Time
+	IsRemoved         bool
+
+	// Events
+	Version            int
+	UncommittedChanges []interface{}
+}
+
+// CreateHarvestUnit creates a count unit for the inventory. averageGramWeight is optional,
+// it is zero when one unit of the harvest has no usual weight.
+func CreateHarvestUnit(cropService CropService, inventoryUID uuid.UUID, code, label string, averageGramWeight float32) (*HarvestUnit, error) {
+	code = strings.TrimSpace(code)
+	if code == "" || strings.ContainsAny(code, " \t") {
+		return nil, CropError{Code: HarvestUnitErrorInvalidCode}
+	}
+
+	if pu := GetProducedUnit(code); pu.Type == ProducedUnitTypeWeight {
+		return nil, CropError{Code: HarvestUnitErrorWeightUnit}
+	}
+
+	if averageGramWeight < 0 {
+		return nil, CropError{Code: HarvestUnitErrorInvalidAverageWeight}
+	}
+
+	serviceResult := cropService.FindMaterialByID(inventoryUID)
+	if serviceResult.Error != nil {
+		return nil, serviceResult.Error
+	}
+
+	uid, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+
+	initial := &HarvestUnit{}
+
+	initial.TrackChange(HarvestUnitCreated{
+		UID:               uid,
+		InventoryUID:      inventoryUID,
+		Code:              code,
+		Label:             harvestUnitLabel(code, label),
+		AverageGramWeight: averageGramWeight,
+		CreatedDate:       time.Now(),
+	})
+
+	return initial, nil
+}
+
+// Change updates the label and the average weight of the unit.
+// The weight of the harvests already done with this unit is not changed.
+func (hu *HarvestUnit) Change(label string, averageGramWeight float32) error {
+	if hu.IsRemoved {
+		return CropError{Code: HarvestUnitErrorNotFound}
+	}
+
+	if averageGramWeight < 0 {
+		return CropError{Code: HarvestUnitErrorInvalidAverageWeight}
+	}
+
+	hu.TrackChange(HarvestUnitChanged{
+		UID:               hu.UID,
+		Label:             harvestUnitLabel(hu.Code, label),
+		AverageGramWeight: averageGramWeight,
+	})
+
+	return nil
+}
+
+func (hu *HarvestUnit) Remove() error {
+	if hu.IsRemoved {
+		return CropError{Code: HarvestUnitErrorNotFound}
+	}
+
+	hu.TrackChange(HarvestUnitRemoved{
+		UID: hu.UID,
+	})
+
+	return nil
+}
+
+// ProducedUnit returns the unit to harvest the crop batches of the inventory in
+func (hu HarvestUnit) ProducedUnit() ProducedUnit {
+	return ProducedUnit{
+		Code:       hu.Code,
+		Label:      hu.Label,
+		Type:       ProducedUnitTypeCount,
+		GramWeight: hu.AverageGramWeight,
+	}
+}
+
+func (hu *HarvestUnit) TrackChange(event interface{}) {
+	hu.UncommittedChanges = append(hu.UncommittedChanges, event)
+	hu.Transition(event)
+}
+
+func (hu *HarvestUnit) Transition(event interface{}) {
+	switch e := event.(type) {
+	case HarvestUnitCreated:
+		hu.UID = e.UID
+		hu.InventoryUID = e.InventoryUID
+		hu.Code = e.Code
+		hu.Label = e.Label
+		hu.AverageGramWeight = e.AverageGramWeight
+		hu.CreatedDate = e.CreatedDate
+
+	case HarvestUnitChanged:
+		hu.Label = e.Label
+		hu.AverageGramWeight = e.AverageGramWeight
+
+	case HarvestUnitRemoved:
+		hu.IsRemoved = true
+	}
+}
+
+// harvestUnitLabel defaults the label to the one of the built-in unit, or to the code
+func harvestUnitLabel(code, label string) string {
+	if label != "" {
+		return label
+	}
+
+	if pu := GetProducedUnit(code); pu.Label != "" {
+		return pu.Label
+	}
+
+	return strings.ToLower(code)
+}
@@ -0,0 +1,26 @@
+package domain
+
+import (
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+type HarvestUnitCreated struct {
+	UID               uuid.UUID
+	InventoryUID      uuid.UUID
+	Code              string
+	Label             string
+	AverageGramWeight float32
+	CreatedDate       time.Time
+}
+
+type HarvestUnitChanged struct {
+	UID               uuid.UUID
+	Label             string
+	AverageGramWeight float32
+}
+
+type HarvestUnitRemoved struct {
+	UID uuid.UUID
+}
@@ -0,0 +1,121 @@
+package domain_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/Tanibox/tania-core/src/growth/domain"
+	"github.com/Tanibox/tania-core/src/growth/query"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateHarvestUnit(t *testing.T) {
+	// Given
+	cropServiceMock := new(CropServiceMock)
+
+	inventoryUID, _ := uuid.NewV4()
+	cropServiceMock.On("FindMaterialByID", inventoryUID).Return(ServiceResult{
+		Result: query.CropMaterialQueryResult{UID: inventoryUID, Name: "Basil"},
+	})
+
+	// When
+	harvestUnit, err := CreateHarvestUnit(cropServiceMock, inventoryUID, Bunch, "", 25)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, inventoryUID, harvestUnit.InventoryUID)
+	assert.Equal(t, Bunch, harvestUnit.Code)
+	assert.Equal(t, "bunch", harvestUnit.Label)
+	assert.Equal(t, ProducedUnit{Code: Bunch, Label: "bunch", Type: ProducedUnitTypeCount, GramWeight: 25},
+		harvestUnit.ProducedUnit())
+
+	// When
+	err = harvestUnit.Change("small bunch", 20)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, "small bunch", harvestUnit.Label)
+	assert.Equal(t, float32(20), harvestUnit.AverageGramWeight)
+
+	// When
+	err = harvestUnit.Remove()
+
+	// Then
+	assert.Nil(t, err)
+	assert.True(t, harvestUnit.IsRemoved)
+	assert.Equal(t, CropError{Code: HarvestUnitErrorNotFound}, harvestUnit.Remove())
+}
+
+func TestInvalidHarvestUnit(t *testing.T) {
+	// Given
+	cropServiceMock := new(CropServiceMock)
+
+	inventoryUID, _ := uuid.NewV4()
+	cropServiceMock.On("FindMaterialByID", inventoryUID).Return(ServiceResult{
+		Result: query.CropMaterialQueryResult{UID: inventoryUID, Name: "Basil"},
+	})
+
+	var tableTests = []struct {
+		code              string
+		averageGramWeight float32
+		expected          error
+	}{
+		{"", 0, CropError{Code: HarvestUnitErrorInvalidCode}},
+		{"Small Bunch", 0, CropError{Code: HarvestUnitErrorInvalidCode}},
+		{Kg, 0, CropError{Code: HarvestUnitErrorWeightUnit}},
+		{Bunch, -1, CropError{Code: HarvestUnitErrorInvalidAverageWeight}},
+	}
+
+	for _, test := range tableTests {
+		// When
+		_, err := CreateHarvestUnit(cropServiceMock, inventoryUID, test.code, "", test.averageGramWeight)
+
+		// Then
+		assert.Equal(t, test.expected, err)
+	}
+}
+
+func TestHarvestCropBatchInCountUnit(t *testing.T) {
+	// Given
+	cropServiceMock := new(CropServiceMock)
+
+	areaUID, _ := uuid.NewV4()
+	cropServiceMock.On("FindAreaByID", areaUID).Return(ServiceResult{
+		Result: query.CropAreaQueryResult{UID: areaUID, Type: "GROWING"},
+	})
+
+	inventoryUID, _ := uuid.NewV4()
+	cropServiceMock.On("FindMaterialByID", inventoryUID).Return(ServiceResult{
+		Result: query.CropMaterialQueryResult{UID: inventoryUID, Name: "Basil Genovese"},
+	})
+
+	date := strings.ToLower(time.Now().Format("2Jan"))
+	batchID := fmt.Sprintf("%s%s", "bas-gen-", date)
+	cropServiceMock.On("FindByBatchID", batchID).Return(ServiceResult{})
+
+	crop, _ := CreateCropBatch(cropServiceMock, areaUID, CropTypeGrowing, inventoryUID, 20, Pot{})
+
+	bunch := ProducedUnit{Code: Bunch, Label: "bunch", Type: ProducedUnitTypeCount, GramWeight: 25}
+
+	// When
+	err1 := crop.Harvest(cropServiceMock, areaUID, HarvestTypePartial, 12, bunch, HarvestGradeA, "")
+	err2 := crop.Harvest(cropServiceMock, areaUID, HarvestTypePartial, 3, GetProducedUnit(Piece), HarvestGradeA, "")
+	err3 := crop.Harvest(cropServiceMock, areaUID, HarvestTypePartial, 3, ProducedUnit{}, HarvestGradeA, "")
+
+	// Then
+	assert.Nil(t, err1)
+	assert.Nil(t, err2)
+	assert.Equal(t, CropError{Code: CropHarvestErrorInvalidProducedUnit}, err3)
+
+	assert.Len(t, crop.HarvestLots, 2)
+	assert.Equal(t, float32(12), crop.HarvestLots[0].ProducedQuantity)
+	assert.Equal(t, Bunch, crop.HarvestLots[0].ProducedUnit)
+	assert.Equal(t, float32(300), crop.HarvestLots[0].ProducedGramQuantity)
+	assert.Equal(t, float32(3), crop.HarvestLots[1].ProducedQuantity)
+	assert.Equal(t, Piece, crop.HarvestLots[1].ProducedUnit)
+	assert.Equal(t, float32(0), crop.HarvestLots[1].ProducedGramQuantity)
+	assert.Equal(t, float32(300), crop.HarvestedStorage[0].ProducedGramQuantity)
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/Tanibox/tania-core/src/growth/domain"
 	"github.com/Tanibox/tania-core/src/growth/query"
 	"github.com/Tanibox/tania-core/src/growth/storage"
+	taskdomain "github.com/Tanibox/tania-core/src/tasks/domain"
 	uuid "github.com/satori/go.uuid"
 )
 
@@ -93,3 +94,31 @@ func (s CropServiceInMemory) FindAreaByID(uid uuid.UUID) domain.ServiceResult {
 		Result: area,
 	}
 }
+
+// FindTaskCategoryByCode finds the task category of the crop template tasks
+func (s CropServiceInMemory) FindTaskCategoryByCode(code string) domain.ServiceResult {
+	category, err := taskdomain.FindTaskCategoryByCode(code)
+	if err != nil {
+		return domain.ServiceResult{
+			Error: domain.CropError{Code: domain.CropTemplateErrorInvalidTaskCategory},
+		}
+	}
+
+	return domain.ServiceResult{
+		Result: category,
+	}
+}
+
+// FindTaskPriorityByCode finds the task priority of the crop template tasks
+func (s CropServiceInMemory) FindTaskPriorityByCode(code string) domain.ServiceResult {
+	priority, err := taskdomain.FindTaskPriorityByCode(code)
+	if err != nil {
+		return domain.ServiceResult{
+			Error: domain.CropError{Code: domain.CropTemplateErrorInvalidTaskPriority},
+		}
+	}
+
+	return domain.ServiceResult{
+		Result: priority,
+	}
+}
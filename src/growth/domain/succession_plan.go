@@ -0,0 +1,273 @@
+package domain
+
+import (
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+// SuccessionPlanMaxBatches limits the number of crop batches planned at once
+const SuccessionPlanMaxBatches = 366
+
+const (
+	PlannedBatchStatusPlanned   = "PLANNED"
+	PlannedBatchStatusConfirmed = "CONFIRMED"
+	PlannedBatchStatusSkipped   = "SKIPPED"
+
+	// PlannedBatchStatusOverdue is not stored. It is shown for the planned crop batches
+	// which have not been confirmed or skipped by their seeding date.
+	PlannedBatchStatusOverdue = "OVERDUE"
+)
+
+// SuccessionPlan seeds the same inventory every IntervalDays days between StartDate and EndDate,
+// for a continuous harvest. The planned crop batches rotate through the areas in order.
+// A planned crop batch is only created when it is confirmed.
+type SuccessionPlan struct {
+	UID            uuid.UUID
+	Name           string
+	InventoryUID   uuid.UUID
+	AreaUIDs       []uuid.UUID
+	CropType       string
+	ContainerType  string
+	ContainerCell  int
+	Quantity       int
+	IntervalDays   int
+	StartDate      time.Time
+	EndDate        time.Time
+	PlannedBatches []PlannedBatch
+	CreatedDate    time.Time
+	IsRemoved      bool
+
+	// Events
+	Version            int
+	UncommittedChanges []interface{}
+}
+
+// PlannedBatch is a crop batch of a succession plan which is not seeded yet.
+// CropUID is set once it has been confirmed into a real crop batch.
+type PlannedBatch struct {
+	UID         uuid.UUID  `json:"uid"`
+	AreaUID     uuid.UUID  `json:"area_id"`
+	SeedingDate time.Time  `json:"seeding_date"`
+	Quantity    int        `json:"quantity"`
+	Status      string     `json:"status"`
+	CropUID     *uuid.UUID `json:"crop_id"`
+}
+
+func CreateSuccessionPlan(
+	cropService CropService,
+	name string,
+	inventoryUID uuid.UUID,
+	areaUIDs []uuid.UUID,
+	cropType string,
+	containerType CropContainerType,
+	quantity int,
+	intervalDays int,
+	startDate time.Time,
+	endDate time.Time) (*SuccessionPlan, error) {
+
+	if name == "" {
+		return nil, CropError{Code: SuccessionPlanErrorInvalidName}
+	}
+
+	if len(areaUIDs) == 0 {
+		return nil, CropError{Code: SuccessionPlanErrorEmptyAreas}
+	}
+
+	for _, v := range areaUIDs {
+		serviceResult := cropService.FindAreaByID(v)
+		if serviceResult.Error != nil {
+			return nil, serviceResult.Error
+		}
+	}
+
+	serviceResult := cropService.FindMaterialByID(inventoryUID)
+	if serviceResult.Error != nil {
+		return nil, serviceResult.Error
+	}
+
+	if GetCropType(cropType) == (CropType{}) {
+		return nil, CropError{Code: CropErrorInvalidCropType}
+	}
+
+	err := validateContainer(quantity, containerType)
+	if err != nil {
+		return nil, err
+	}
+
+	if intervalDays <= 0 {
+		return nil, CropError{Code: SuccessionPlanErrorInvalidInterval}
+	}
+
+	if endDate.Before(startDate) {
+		return nil, CropError{Code: SuccessionPlanErrorInvalidDateRange}
+	}
+
+	plannedBatches := []PlannedBatch{}
+	for date, i := startDate, 0; !date.After(endDate); date, i = date.AddDate(0, 0, intervalDays), i+1 {
+		if i == SuccessionPlanMaxBatches {
+			return nil, CropError{Code: SuccessionPlanErrorTooManyBatches}
+		}
+
+		uid, err := uuid.NewV4()
+		if err != nil {
+			return nil, err
+		}
+
+		plannedBatches = append(plannedBatches, PlannedBatch{
+			UID:         uid,
+			AreaUID:     areaUIDs[i%len(areaUIDs)],
+			SeedingDate: date,
+			Quantity:    quantity,
+			Status:      PlannedBatchStatusPlanned,
+		})
+	}
+
+	uid, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+
+	containerCell := 0
+	if t, ok := containerType.(Tray); ok {
+		containerCell = t.Cell
+	}
+
+	initial := &SuccessionPlan{}
+
+	initial.TrackChange(SuccessionPlanCreated{
+		UID:            uid,
+		Name:           name,
+		InventoryUID:   inventoryUID,
+		AreaUIDs:       areaUIDs,
+		CropType:       cropType,
+		ContainerType:  containerType.Code(),
+		ContainerCell:  containerCell,
+		Quantity:       quantity,
+		IntervalDays:   intervalDays,
+		StartDate:      startDate,
+		EndDate:        endDate,
+		PlannedBatches: plannedBatches,
+		CreatedDate:    time.Now(),
+	})
+
+	return initial, nil
+}
+
+// Container returns the container of the crop batches of the plan
+func (sp SuccessionPlan) Container() CropContainerType {
+	if sp.ContainerType == (Tray{}).Code() {
+		return Tray{Cell: sp.ContainerCell}
+	}
+
+	return Pot{}
+}
+
+// PlannedBatch returns the planned crop batch with the UID, or nil when it is not in the plan
+func (sp SuccessionPlan) PlannedBatch(uid uuid.UUID) *PlannedBatch {
+	for i, v := range sp.PlannedBatches {
+		if v.UID == uid {
+			return &sp.PlannedBatches[i]
+		}
+	}
+
+	return nil
+}
+
+// ConfirmBatch records the crop batch created for a planned crop batch.
+// The area and the quantity are the actual ones, which may differ from the plan.
+func (sp *SuccessionPlan) ConfirmBatch(plannedBatchUID uuid.UUID, crop Crop) error {
+	pb := sp.PlannedBatch(plannedBatchUID)
+	if pb == nil {
+		return CropError{Code: SuccessionPlanErrorBatchNotFound}
+	}
+
+	if pb.Status != PlannedBatchStatusPlanned {
+		return CropError{Code: SuccessionPlanErrorBatchNotPlanned}
+	}
+
+	sp.TrackChange(SuccessionPlanBatchConfirmed{
+		UID:             sp.UID,
+		PlannedBatchUID: plannedBatchUID,
+		CropUID:         crop.UID,
+		BatchID:         crop.BatchID,
+		AreaUID:         crop.InitialArea.AreaUID,
+		Quantity:        crop.InitialArea.InitialQuantity,
+		ConfirmedDate:   crop.InitialArea.CreatedDate,
+	})
+
+	return nil
+}
+
+// SkipBatch marks a planned crop batch as not seeded, so it is not counted as overdue
+func (sp *SuccessionPlan) SkipBatch(plannedBatchUID uuid.UUID) error {
+	pb := sp.PlannedBatch(plannedBatchUID)
+	if pb == nil {
+		return CropError{Code: SuccessionPlanErrorBatchNotFound}
+	}
+
+	if pb.Status != PlannedBatchStatusPlanned {
+		return CropError{Code: SuccessionPlanErrorBatchNotPlanned}
+	}
+
+	sp.TrackChange(SuccessionPlanBatchSkipped{
+		UID:             sp.UID,
+		PlannedBatchUID: plannedBatchUID,
+	})
+
+	return nil
+}
+
+// Remove removes the plan. The crop batches already confirmed are kept.
+func (sp *SuccessionPlan) Remove() error {
+	if sp.IsRemoved {
+		return CropError{Code: SuccessionPlanErrorNotFound}
+	}
+
+	sp.TrackChange(SuccessionPlanRemoved{
+		UID: sp.UID,
+	})
+
+	return nil
+}
+
+func (sp *SuccessionPlan) TrackChange(event interface{}) {
+	sp.UncommittedChanges = append(sp.UncommittedChanges, event)
+	sp.Transition(event)
+}
+
+func (sp *SuccessionPlan) Transition(event interface{}) {
+	switch e := event.(type) {
+	case SuccessionPlanCreated:
+		sp.UID = e.UID
+		sp.Name = e.Name
+		sp.InventoryUID = e.InventoryUID
+		sp.AreaUIDs = e.AreaUIDs
+		sp.CropType = e.CropType
+		sp.ContainerType = e.ContainerType
+		sp.ContainerCell = e.ContainerCell
+		sp.Quantity = e.Quantity
+		sp.IntervalDays = e.IntervalDays
+		sp.StartDate = e.StartDate
+		sp.EndDate = e.EndDate
+		// The planned batches are copied, so confirming one doesn't change the event
+		sp.PlannedBatches = append([]PlannedBatch{}, e.PlannedBatches...)
+		sp.CreatedDate = e.CreatedDate
+
+	case SuccessionPlanBatchConfirmed:
+		if pb := sp.PlannedBatch(e.PlannedBatchUID); pb != nil {
+			cropUID := e.CropUID
+
+			pb.Status = PlannedBatchStatusConfirmed
+			pb.CropUID = &cropUID
+		}
+
+	case SuccessionPlanBatchSkipped:
+		if pb := sp.PlannedBatch(e.PlannedBatchUID); pb != nil {
+			pb.Status = PlannedBatchStatusSkipped
+		}
+
+	case SuccessionPlanRemoved:
+		sp.IsRemoved = true
+	}
+}
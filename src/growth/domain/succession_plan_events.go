@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+type SuccessionPlanCreated struct {
+	UID            uuid.UUID
+	Name           string
+	InventoryUID   uuid.UUID
+	AreaUIDs       []uuid.UUID
+	CropType       string
+	ContainerType  string
+	ContainerCell  int
+	Quantity       int
+	IntervalDays   int
+	StartDate      time.Time
+	EndDate        time.Time
+	PlannedBatches []PlannedBatch
+	CreatedDate    time.Time
+}
+
+type SuccessionPlanBatchConfirmed struct {
+	UID             uuid.UUID
+	PlannedBatchUID uuid.UUID
+	CropUID         uuid.UUID
+	BatchID         string
+	AreaUID         uuid.UUID
+	Quantity        int
+	ConfirmedDate   time.Time
+}
+
+type SuccessionPlanBatchSkipped struct {
+	UID             uuid.UUID
+	PlannedBatchUID uuid.UUID
+}
+
+type SuccessionPlanRemoved struct {
+	UID uuid.UUID
+}
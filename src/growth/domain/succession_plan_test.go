@@ -0,0 +1,122 @@
+package domain_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/Tanibox/tania-core/src/growth/domain"
+	"github.com/Tanibox/tania-core/src/growth/query"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateSuccessionPlan(t *testing.T) {
+	// Given
+	cropServiceMock := new(CropServiceMock)
+
+	areaAUID, _ := uuid.NewV4()
+	areaBUID, _ := uuid.NewV4()
+	cropServiceMock.On("FindAreaByID", areaAUID).Return(ServiceResult{
+		Result: query.CropAreaQueryResult{UID: areaAUID, Type: "SEEDING"},
+	})
+	cropServiceMock.On("FindAreaByID", areaBUID).Return(ServiceResult{
+		Result: query.CropAreaQueryResult{UID: areaBUID, Type: "SEEDING"},
+	})
+
+	inventoryUID, _ := uuid.NewV4()
+	cropServiceMock.On("FindMaterialByID", inventoryUID).Return(ServiceResult{
+		Result: query.CropMaterialQueryResult{UID: inventoryUID, Name: "Lettuce Batavia"},
+	})
+
+	date := strings.ToLower(time.Now().Format("2Jan"))
+	batchID := fmt.Sprintf("%s%s", "let-bat-", date)
+	cropServiceMock.On("FindByBatchID", batchID).Return(ServiceResult{})
+
+	startDate := time.Date(2018, time.October, 1, 0, 0, 0, 0, time.Local)
+	endDate := time.Date(2018, time.October, 29, 0, 0, 0, 0, time.Local)
+
+	// When
+	plan, err := CreateSuccessionPlan(cropServiceMock, "Weekly lettuce", inventoryUID, []uuid.UUID{areaAUID, areaBUID},
+		CropTypeSeeding, Tray{Cell: 50}, 4, 7, startDate, endDate)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, Tray{Cell: 50}, plan.Container())
+	assert.Len(t, plan.PlannedBatches, 5)
+	assert.Equal(t, startDate, plan.PlannedBatches[0].SeedingDate)
+	assert.Equal(t, areaAUID, plan.PlannedBatches[0].AreaUID)
+	assert.Equal(t, startDate.AddDate(0, 0, 7), plan.PlannedBatches[1].SeedingDate)
+	assert.Equal(t, areaBUID, plan.PlannedBatches[1].AreaUID)
+	assert.Equal(t, endDate, plan.PlannedBatches[4].SeedingDate)
+	assert.Equal(t, areaAUID, plan.PlannedBatches[4].AreaUID)
+
+	// When
+	crop, _ := CreateCropBatch(cropServiceMock, areaBUID, CropTypeSeeding, inventoryUID, 3, plan.Container())
+	err = plan.ConfirmBatch(plan.PlannedBatches[0].UID, *crop)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, PlannedBatchStatusConfirmed, plan.PlannedBatches[0].Status)
+	assert.Equal(t, crop.UID, *plan.PlannedBatches[0].CropUID)
+	assert.Equal(t, CropError{Code: SuccessionPlanErrorBatchNotPlanned}, plan.ConfirmBatch(plan.PlannedBatches[0].UID, *crop))
+
+	// The created event keeps the planned crop batches as they were planned
+	created := plan.UncommittedChanges[0].(SuccessionPlanCreated)
+	assert.Equal(t, PlannedBatchStatusPlanned, created.PlannedBatches[0].Status)
+
+	// When
+	err = plan.SkipBatch(plan.PlannedBatches[1].UID)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, PlannedBatchStatusSkipped, plan.PlannedBatches[1].Status)
+
+	// When
+	unknownUID, _ := uuid.NewV4()
+	err = plan.SkipBatch(unknownUID)
+
+	// Then
+	assert.Equal(t, CropError{Code: SuccessionPlanErrorBatchNotFound}, err)
+}
+
+func TestInvalidSuccessionPlan(t *testing.T) {
+	// Given
+	cropServiceMock := new(CropServiceMock)
+
+	areaUID, _ := uuid.NewV4()
+	cropServiceMock.On("FindAreaByID", areaUID).Return(ServiceResult{
+		Result: query.CropAreaQueryResult{UID: areaUID, Type: "SEEDING"},
+	})
+
+	inventoryUID, _ := uuid.NewV4()
+	cropServiceMock.On("FindMaterialByID", inventoryUID).Return(ServiceResult{
+		Result: query.CropMaterialQueryResult{UID: inventoryUID, Name: "Lettuce Batavia"},
+	})
+
+	startDate := time.Date(2018, time.October, 1, 0, 0, 0, 0, time.Local)
+
+	var tableTests = []struct {
+		name         string
+		areaUIDs     []uuid.UUID
+		intervalDays int
+		endDate      time.Time
+		expected     error
+	}{
+		{"", []uuid.UUID{areaUID}, 7, startDate, CropError{Code: SuccessionPlanErrorInvalidName}},
+		{"Lettuce", []uuid.UUID{}, 7, startDate, CropError{Code: SuccessionPlanErrorEmptyAreas}},
+		{"Lettuce", []uuid.UUID{areaUID}, 0, startDate, CropError{Code: SuccessionPlanErrorInvalidInterval}},
+		{"Lettuce", []uuid.UUID{areaUID}, 7, startDate.AddDate(0, 0, -1), CropError{Code: SuccessionPlanErrorInvalidDateRange}},
+		{"Lettuce", []uuid.UUID{areaUID}, 1, startDate.AddDate(2, 0, 0), CropError{Code: SuccessionPlanErrorTooManyBatches}},
+	}
+
+	for _, test := range tableTests {
+		// When
+		_, err := CreateSuccessionPlan(cropServiceMock, test.name, inventoryUID, test.areaUIDs,
+			CropTypeSeeding, Pot{}, 10, test.intervalDays, startDate, test.endDate)
+
+		// Then
+		assert.Equal(t, test.expected, err)
+	}
+}
@@ -1,17 +1,21 @@
 package inmemory
 
 import (
+	"sort"
+
 	"github.com/Tanibox/tania-core/src/growth/query"
 	"github.com/Tanibox/tania-core/src/growth/storage"
+	"github.com/Tanibox/tania-core/src/helper/paginationhelper"
 	uuid "github.com/satori/go.uuid"
 )
 
 type CropActivityQueryInMemory struct {
-	Storage *storage.CropActivityStorage
+	Storage         *storage.CropActivityStorage
+	CropReadStorage *storage.CropReadStorage
 }
 
-func NewCropActivityQueryInMemory(s *storage.CropActivityStorage) query.CropActivityQuery {
-	return CropActivityQueryInMemory{Storage: s}
+func NewCropActivityQueryInMemory(s *storage.CropActivityStorage, cropReadStorage *storage.CropReadStorage) query.CropActivityQuery {
+	return CropActivityQueryInMemory{Storage: s, CropReadStorage: cropReadStorage}
 }
 
 func (s CropActivityQueryInMemory) FindAllByCropID(uid uuid.UUID) <-chan query.QueryResult {
@@ -63,3 +67,106 @@ func (s CropActivityQueryInMemory) FindByCropIDAndActivityType(uid uuid.UUID, ac
 
 	return result
 }
+
+// FindAllByFilter returns a page of the crop activities matching the filter.
+// All the matching activities are returned when limit is zero.
+func (s CropActivityQueryInMemory) FindAllByFilter(filter query.CropActivityFilter, page, limit int) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		activities := s.findAllByFilter(filter)
+
+		if limit > 0 {
+			offset := paginationhelper.CalculatePageToOffset(page, limit)
+
+			if offset > len(activities) {
+				offset = len(activities)
+			}
+
+			end := offset + limit
+			if end > len(activities) {
+				end = len(activities)
+			}
+
+			activities = activities[offset:end]
+		}
+
+		result <- query.QueryResult{Result: activities}
+
+		close(result)
+	}()
+
+	return result
+}
+
+func (s CropActivityQueryInMemory) CountAllByFilter(filter query.CropActivityFilter) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		result <- query.QueryResult{Result: len(s.findAllByFilter(filter))}
+
+		close(result)
+	}()
+
+	return result
+}
+
+func (s CropActivityQueryInMemory) findAllByFilter(filter query.CropActivityFilter) []storage.CropActivity {
+	farmCrops := map[uuid.UUID]bool{}
+	if filter.FarmUID != (uuid.UUID{}) {
+		s.CropReadStorage.Lock.RLock()
+		for _, val := range s.CropReadStorage.CropReadMap {
+			if val.FarmUID == filter.FarmUID {
+				farmCrops[val.UID] = true
+			}
+		}
+		s.CropReadStorage.Lock.RUnlock()
+	}
+
+	activityTypeCodes := map[string]bool{}
+	for _, v := range filter.ActivityTypeCodes {
+		activityTypeCodes[v] = true
+	}
+
+	s.Storage.Lock.RLock()
+	defer s.Storage.Lock.RUnlock()
+
+	activities := []storage.CropActivity{}
+	for _, val := range s.Storage.CropActivityMap {
+		if filter.CropUID != (uuid.UUID{}) && val.UID != filter.CropUID {
+			continue
+		}
+
+		if filter.FarmUID != (uuid.UUID{}) && !farmCrops[val.UID] {
+			continue
+		}
+
+		if filter.AreaUID != (uuid.UUID{}) && !val.HasArea(filter.AreaUID) {
+			continue
+		}
+
+		if len(activityTypeCodes) > 0 && !activityTypeCodes[val.ActivityType.Code()] {
+			continue
+		}
+
+		if filter.StartDate != nil && val.CreatedDate.Before(*filter.StartDate) {
+			continue
+		}
+
+		if filter.EndDate != nil && !val.CreatedDate.Before(*filter.EndDate) {
+			continue
+		}
+
+		activities = append(activities, val)
+	}
+
+	sort.SliceStable(activities, func(i, j int) bool {
+		if filter.Ascending {
+			return activities[i].CreatedDate.Before(activities[j].CreatedDate)
+		}
+
+		return activities[i].CreatedDate.After(activities[j].CreatedDate)
+	})
+
+	return activities
+}
@@ -0,0 +1,116 @@
+package inmemory
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/growth/query"
+	"github.com/Tanibox/tania-core/src/growth/storage"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCropActivityInMemoryFindAllByFilter(t *testing.T) {
+	// Given
+	farmUID, _ := uuid.NewV4()
+	otherFarmUID, _ := uuid.NewV4()
+	cropUID, _ := uuid.NewV4()
+	otherCropUID, _ := uuid.NewV4()
+	seedingAreaUID, _ := uuid.NewV4()
+	growingAreaUID, _ := uuid.NewV4()
+
+	day := func(d int) time.Time {
+		return time.Date(2018, time.May, d, 8, 0, 0, 0, time.UTC)
+	}
+
+	cropReadStorage := storage.CreateCropReadStorage()
+	cropReadStorage.CropReadMap[cropUID] = storage.CropRead{UID: cropUID, FarmUID: farmUID}
+	cropReadStorage.CropReadMap[otherCropUID] = storage.CropRead{UID: otherCropUID, FarmUID: otherFarmUID}
+
+	cropActivityStorage := storage.CreateCropActivityStorage()
+	cropActivityStorage.CropActivityMap = []storage.CropActivity{
+		{UID: cropUID, ActivityType: storage.SeedActivity{AreaUID: seedingAreaUID}, CreatedDate: day(1)},
+		{UID: cropUID, ActivityType: storage.WaterActivity{AreaUID: seedingAreaUID}, CreatedDate: day(3)},
+		{UID: cropUID, ActivityType: storage.MoveActivity{SrcAreaUID: seedingAreaUID, DstAreaUID: growingAreaUID}, CreatedDate: day(10)},
+		{UID: cropUID, ActivityType: storage.TaskNutrientActivity{AreaName: "Growing B"}, CreatedDate: day(11)},
+		{UID: cropUID, ActivityType: storage.WaterActivity{AreaUID: growingAreaUID}, CreatedDate: day(12)},
+		{UID: otherCropUID, ActivityType: storage.WaterActivity{AreaUID: growingAreaUID}, CreatedDate: day(5)},
+	}
+
+	activityQuery := NewCropActivityQueryInMemory(cropActivityStorage, cropReadStorage)
+
+	startDate := day(3)
+	endDate := day(11)
+
+	tests := []struct {
+		name   string
+		filter query.CropActivityFilter
+		page   int
+		limit  int
+		dates  []time.Time
+	}{
+		{
+			name:   "crop activities, most recent first",
+			filter: query.CropActivityFilter{CropUID: cropUID},
+			dates:  []time.Time{day(12), day(11), day(10), day(3), day(1)},
+		},
+		{
+			name:   "farm activities, oldest first",
+			filter: query.CropActivityFilter{FarmUID: otherFarmUID, Ascending: true},
+			dates:  []time.Time{day(5)},
+		},
+		{
+			name:   "area activities",
+			filter: query.CropActivityFilter{CropUID: cropUID, AreaUID: growingAreaUID},
+			dates:  []time.Time{day(12), day(10)},
+		},
+		{
+			name:   "activity types",
+			filter: query.CropActivityFilter{FarmUID: farmUID, ActivityTypeCodes: []string{storage.WaterActivityCode, storage.TaskNutrientActivityCode}},
+			dates:  []time.Time{day(12), day(11), day(3)},
+		},
+		{
+			name:   "start date is inclusive and end date is exclusive",
+			filter: query.CropActivityFilter{CropUID: cropUID, StartDate: &startDate, EndDate: &endDate},
+			dates:  []time.Time{day(10), day(3)},
+		},
+		{
+			name:   "second page",
+			filter: query.CropActivityFilter{CropUID: cropUID},
+			page:   2,
+			limit:  2,
+			dates:  []time.Time{day(10), day(3)},
+		},
+		{
+			name:   "page after the last one",
+			filter: query.CropActivityFilter{CropUID: cropUID},
+			page:   4,
+			limit:  2,
+			dates:  []time.Time{},
+		},
+	}
+
+	for _, test := range tests {
+		// When
+		result := <-activityQuery.FindAllByFilter(test.filter, test.page, test.limit)
+		count := <-activityQuery.CountAllByFilter(test.filter)
+
+		// Then
+		assert.Nil(t, result.Error, test.name)
+		assert.Nil(t, count.Error, test.name)
+
+		activities, ok := result.Result.([]storage.CropActivity)
+		assert.True(t, ok, test.name)
+
+		dates := []time.Time{}
+		for _, v := range activities {
+			dates = append(dates, v.CreatedDate)
+		}
+
+		assert.Equal(t, test.dates, dates, test.name)
+
+		if test.limit == 0 {
+			assert.Equal(t, len(test.dates), count.Result, test.name)
+		}
+	}
+}
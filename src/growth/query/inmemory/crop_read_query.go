@@ -1,6 +1,9 @@
 package inmemory
 
 import (
+	"sort"
+	"strings"
+
 	"github.com/Tanibox/tania-core/src/growth/query"
 	"github.com/Tanibox/tania-core/src/growth/storage"
 	uuid "github.com/satori/go.uuid"
@@ -122,7 +125,7 @@ func (s CropReadQueryInMemory) CountAllCropsByFarm(farmUID uuid.UUID, status str
 	return result
 }
 
-func (s CropReadQueryInMemory) FindAllCropsArchives(farmUID uuid.UUID, page, limit int) <-chan query.QueryResult {
+func (s CropReadQueryInMemory) FindAllCropsArchives(farmUID uuid.UUID, filter query.CropArchiveFilter, page, limit int) <-chan query.QueryResult {
 	result := make(chan query.QueryResult)
 
 	go func() {
@@ -131,7 +134,7 @@ func (s CropReadQueryInMemory) FindAllCropsArchives(farmUID uuid.UUID, page, lim
 
 		archives := []storage.CropRead{}
 		for _, val := range s.Storage.CropReadMap {
-			if val.FarmUID == farmUID {
+			if val.FarmUID == farmUID && matchCropArchiveFilter(val, filter) {
 
 				// A crop's current quantity which have zero value should go to archives
 				initialEmpty := true
@@ -170,7 +173,7 @@ func (s CropReadQueryInMemory) FindAllCropsArchives(farmUID uuid.UUID, page, lim
 	return result
 }
 
-func (s CropReadQueryInMemory) CountAllArchivedCropsByFarm(farmUID uuid.UUID) <-chan query.QueryResult {
+func (s CropReadQueryInMemory) CountAllArchivedCropsByFarm(farmUID uuid.UUID, filter query.CropArchiveFilter) <-chan query.QueryResult {
 	result := make(chan query.QueryResult)
 
 	go func() {
@@ -179,7 +182,7 @@ func (s CropReadQueryInMemory) CountAllArchivedCropsByFarm(farmUID uuid.UUID) <-
 
 		total := 0
 		for _, val := range s.Storage.CropReadMap {
-			if val.FarmUID == farmUID {
+			if val.FarmUID == farmUID && matchCropArchiveFilter(val, filter) {
 
 				// A crop's current quantity which have zero value should go to archives
 				initialEmpty := true
@@ -218,6 +221,26 @@ func (s CropReadQueryInMemory) CountAllArchivedCropsByFarm(farmUID uuid.UUID) <-
 	return result
 }
 
+func matchCropArchiveFilter(cropRead storage.CropRead, filter query.CropArchiveFilter) bool {
+	if filter.BatchID != "" && !strings.Contains(strings.ToLower(cropRead.BatchID), strings.ToLower(filter.BatchID)) {
+		return false
+	}
+
+	if filter.Variety != "" && !strings.Contains(strings.ToLower(cropRead.Inventory.Name), strings.ToLower(filter.Variety)) {
+		return false
+	}
+
+	if filter.StartDate != nil && cropRead.InitialArea.CreatedDate.Before(*filter.StartDate) {
+		return false
+	}
+
+	if filter.EndDate != nil && !cropRead.InitialArea.CreatedDate.Before(*filter.EndDate) {
+		return false
+	}
+
+	return true
+}
+
 func (s CropReadQueryInMemory) FindAllCropsByArea(areaUID uuid.UUID) <-chan query.QueryResult {
 	result := make(chan query.QueryResult)
 
@@ -367,3 +390,58 @@ func (s CropReadQueryInMemory) CountTotalBatch(farmUID uuid.UUID) <-chan query.Q
 
 	return result
 }
+
+// FindAllCropsByInventory returns all the crop batches, including the archived ones,
+// that are planted from the inventory
+func (s CropReadQueryInMemory) FindAllCropsByInventory(inventoryUID uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		s.Storage.Lock.RLock()
+		defer s.Storage.Lock.RUnlock()
+
+		cropReads := []storage.CropRead{}
+		for _, val := range s.Storage.CropReadMap {
+			if val.Inventory.UID == inventoryUID {
+				cropReads = append(cropReads, val)
+			}
+		}
+
+		sort.Slice(cropReads, func(i, j int) bool {
+			return cropReads[i].InitialArea.CreatedDate.After(cropReads[j].InitialArea.CreatedDate)
+		})
+
+		result <- query.QueryResult{Result: cropReads}
+
+		close(result)
+	}()
+
+	return result
+}
+
+// FindAllCropsByFarmWithArchives returns every crop batch of the farm, the archived ones included, without pagination
+func (s CropReadQueryInMemory) FindAllCropsByFarmWithArchives(farmUID uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		s.Storage.Lock.RLock()
+		defer s.Storage.Lock.RUnlock()
+
+		cropReads := []storage.CropRead{}
+		for _, val := range s.Storage.CropReadMap {
+			if val.FarmUID == farmUID {
+				cropReads = append(cropReads, val)
+			}
+		}
+
+		sort.Slice(cropReads, func(i, j int) bool {
+			return cropReads[i].InitialArea.CreatedDate.After(cropReads[j].InitialArea.CreatedDate)
+		})
+
+		result <- query.QueryResult{Result: cropReads}
+
+		close(result)
+	}()
+
+	return result
+}
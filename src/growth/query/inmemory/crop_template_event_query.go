@@ -0,0 +1,43 @@
+package inmemory
+
+import (
+	"sort"
+
+	"github.com/Tanibox/tania-core/src/growth/query"
+	"github.com/Tanibox/tania-core/src/growth/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type CropTemplateEventQueryInMemory struct {
+	Storage *storage.CropTemplateEventStorage
+}
+
+func NewCropTemplateEventQueryInMemory(s *storage.CropTemplateEventStorage) query.CropTemplateEventQuery {
+	return &CropTemplateEventQueryInMemory{Storage: s}
+}
+
+func (f *CropTemplateEventQueryInMemory) FindAllByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		f.Storage.Lock.RLock()
+		defer f.Storage.Lock.RUnlock()
+
+		events := []storage.CropTemplateEvent{}
+		for _, v := range f.Storage.CropTemplateEvents {
+			if v.CropTemplateUID == uid {
+				events = append(events, v)
+			}
+		}
+
+		sort.Slice(events, func(i, j int) bool {
+			return events[i].Version < events[j].Version
+		})
+
+		result <- query.QueryResult{Result: events}
+
+		close(result)
+	}()
+
+	return result
+}
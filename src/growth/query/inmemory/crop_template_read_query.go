@@ -0,0 +1,62 @@
+package inmemory
+
+import (
+	"sort"
+
+	"github.com/Tanibox/tania-core/src/growth/query"
+	"github.com/Tanibox/tania-core/src/growth/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type CropTemplateReadQueryInMemory struct {
+	Storage *storage.CropTemplateReadStorage
+}
+
+func NewCropTemplateReadQueryInMemory(s *storage.CropTemplateReadStorage) query.CropTemplateReadQuery {
+	return CropTemplateReadQueryInMemory{Storage: s}
+}
+
+// FindAll returns the crop templates sorted by name.
+// If inventoryUID is not nil, only the crop templates of that inventory are returned.
+func (s CropTemplateReadQueryInMemory) FindAll(inventoryUID *uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		s.Storage.Lock.RLock()
+		defer s.Storage.Lock.RUnlock()
+
+		cropTemplates := []storage.CropTemplateRead{}
+		for _, val := range s.Storage.CropTemplateReadMap {
+			if inventoryUID != nil && val.Inventory.UID != *inventoryUID {
+				continue
+			}
+
+			cropTemplates = append(cropTemplates, val)
+		}
+
+		sort.Slice(cropTemplates, func(i, j int) bool {
+			return cropTemplates[i].Name < cropTemplates[j].Name
+		})
+
+		result <- query.QueryResult{Result: cropTemplates}
+
+		close(result)
+	}()
+
+	return result
+}
+
+func (s CropTemplateReadQueryInMemory) FindByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		s.Storage.Lock.RLock()
+		defer s.Storage.Lock.RUnlock()
+
+		result <- query.QueryResult{Result: s.Storage.CropTemplateReadMap[uid]}
+
+		close(result)
+	}()
+
+	return result
+}
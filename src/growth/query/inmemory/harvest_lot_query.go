@@ -0,0 +1,98 @@
+package inmemory
+
+import (
+	"sort"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/growth/query"
+	"github.com/Tanibox/tania-core/src/growth/storage"
+	"github.com/Tanibox/tania-core/src/helper/datetimehelper"
+	uuid "github.com/satori/go.uuid"
+)
+
+type HarvestLotQueryInMemory struct {
+	Storage *storage.HarvestLotStorage
+}
+
+func NewHarvestLotQueryInMemory(s *storage.HarvestLotStorage) query.HarvestLotQuery {
+	return HarvestLotQueryInMemory{Storage: s}
+}
+
+// FindAllByFarm returns the harvest lots of the farm, the most recent first.
+// If harvestDate is not nil, only the lots harvested on that day are returned.
+func (s HarvestLotQueryInMemory) FindAllByFarm(farmUID uuid.UUID, harvestDate *time.Time) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		s.Storage.Lock.RLock()
+		defer s.Storage.Lock.RUnlock()
+
+		harvestLots := []storage.HarvestLot{}
+		for _, val := range s.Storage.HarvestLotMap {
+			if val.FarmUID != farmUID {
+				continue
+			}
+
+			if harvestDate != nil {
+				start, end := datetimehelper.DayRange(*harvestDate)
+				if val.HarvestDate.Before(start) || !val.HarvestDate.Before(end) {
+					continue
+				}
+			}
+
+			harvestLots = append(harvestLots, val)
+		}
+
+		sort.Slice(harvestLots, func(i, j int) bool {
+			return harvestLots[i].HarvestDate.After(harvestLots[j].HarvestDate)
+		})
+
+		result <- query.QueryResult{Result: harvestLots}
+
+		close(result)
+	}()
+
+	return result
+}
+
+// FindAllByCrop returns the harvest lots of the crop batch, the most recent first
+func (s HarvestLotQueryInMemory) FindAllByCrop(cropUID uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		s.Storage.Lock.RLock()
+		defer s.Storage.Lock.RUnlock()
+
+		harvestLots := []storage.HarvestLot{}
+		for _, val := range s.Storage.HarvestLotMap {
+			if val.CropUID == cropUID {
+				harvestLots = append(harvestLots, val)
+			}
+		}
+
+		sort.Slice(harvestLots, func(i, j int) bool {
+			return harvestLots[i].HarvestDate.After(harvestLots[j].HarvestDate)
+		})
+
+		result <- query.QueryResult{Result: harvestLots}
+
+		close(result)
+	}()
+
+	return result
+}
+
+func (s HarvestLotQueryInMemory) FindByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		s.Storage.Lock.RLock()
+		defer s.Storage.Lock.RUnlock()
+
+		result <- query.QueryResult{Result: s.Storage.HarvestLotMap[uid]}
+
+		close(result)
+	}()
+
+	return result
+}
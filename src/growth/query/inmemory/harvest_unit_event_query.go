@@ -0,0 +1,43 @@
+package inmemory
+
+import (
+	"sort"
+
+	"github.com/Tanibox/tania-core/src/growth/query"
+	"github.com/Tanibox/tania-core/src/growth/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type HarvestUnitEventQueryInMemory struct {
+	Storage *storage.HarvestUnitEventStorage
+}
+
+func NewHarvestUnitEventQueryInMemory(s *storage.HarvestUnitEventStorage) query.HarvestUnitEventQuery {
+	return &HarvestUnitEventQueryInMemory{Storage: s}
+}
+
+func (f *HarvestUnitEventQueryInMemory) FindAllByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		f.Storage.Lock.RLock()
+		defer f.Storage.Lock.RUnlock()
+
+		events := []storage.HarvestUnitEvent{}
+		for _, v := range f.Storage.HarvestUnitEvents {
+			if v.HarvestUnitUID == uid {
+				events = append(events, v)
+			}
+		}
+
+		sort.Slice(events, func(i, j int) bool {
+			return events[i].Version < events[j].Version
+		})
+
+		result <- query.QueryResult{Result: events}
+
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,84 @@
+package inmemory
+
+import (
+	"sort"
+
+	"github.com/Tanibox/tania-core/src/growth/query"
+	"github.com/Tanibox/tania-core/src/growth/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type HarvestUnitReadQueryInMemory struct {
+	Storage *storage.HarvestUnitReadStorage
+}
+
+func NewHarvestUnitReadQueryInMemory(s *storage.HarvestUnitReadStorage) query.HarvestUnitReadQuery {
+	return HarvestUnitReadQueryInMemory{Storage: s}
+}
+
+// FindAll returns the harvest units sorted by code.
+// If inventoryUID is not nil, only the harvest units of that inventory are returned.
+func (s HarvestUnitReadQueryInMemory) FindAll(inventoryUID *uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		s.Storage.Lock.RLock()
+		defer s.Storage.Lock.RUnlock()
+
+		harvestUnits := []storage.HarvestUnitRead{}
+		for _, val := range s.Storage.HarvestUnitReadMap {
+			if inventoryUID != nil && val.Inventory.UID != *inventoryUID {
+				continue
+			}
+
+			harvestUnits = append(harvestUnits, val)
+		}
+
+		sort.Slice(harvestUnits, func(i, j int) bool {
+			return harvestUnits[i].Code < harvestUnits[j].Code
+		})
+
+		result <- query.QueryResult{Result: harvestUnits}
+
+		close(result)
+	}()
+
+	return result
+}
+
+func (s HarvestUnitReadQueryInMemory) FindByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		s.Storage.Lock.RLock()
+		defer s.Storage.Lock.RUnlock()
+
+		result <- query.QueryResult{Result: s.Storage.HarvestUnitReadMap[uid]}
+
+		close(result)
+	}()
+
+	return result
+}
+
+func (s HarvestUnitReadQueryInMemory) FindByInventoryAndCode(inventoryUID uuid.UUID, code string) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		s.Storage.Lock.RLock()
+		defer s.Storage.Lock.RUnlock()
+
+		harvestUnit := storage.HarvestUnitRead{}
+		for _, val := range s.Storage.HarvestUnitReadMap {
+			if val.Inventory.UID == inventoryUID && val.Code == code {
+				harvestUnit = val
+			}
+		}
+
+		result <- query.QueryResult{Result: harvestUnit}
+
+		close(result)
+	}()
+
+	return result
+}
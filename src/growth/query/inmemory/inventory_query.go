@@ -28,6 +28,9 @@ func (s MaterialReadQueryInMemory) FindByID(inventoryUID uuid.UUID) <-chan query
 				ci.UID = val.UID
 				ci.Name = val.Name
 				ci.TypeCode = val.Type.Code()
+				ci.Quantity = val.Quantity.Value
+				ci.QuantityUnit = val.Quantity.Unit.Code
+				ci.PlantFamily = val.PlantFamily
 
 				// WARNING, domain leakage
 				switch v := val.Type.(type) {
@@ -64,6 +67,9 @@ func (q MaterialReadQueryInMemory) FindMaterialByPlantTypeCodeAndName(plantTypeC
 					ci.Name = val.Name
 					ci.TypeCode = val.Type.Code()
 					ci.PlantTypeCode = v.PlantType.Code
+					ci.Quantity = val.Quantity.Value
+					ci.QuantityUnit = val.Quantity.Unit.Code
+					ci.PlantFamily = val.PlantFamily
 				}
 			case assetsdomain.MaterialTypePlant:
 				if v.PlantType.Code == plantTypeCode && val.Name == name {
@@ -71,6 +77,9 @@ func (q MaterialReadQueryInMemory) FindMaterialByPlantTypeCodeAndName(plantTypeC
 					ci.Name = val.Name
 					ci.TypeCode = val.Type.Code()
 					ci.PlantTypeCode = v.PlantType.Code
+					ci.Quantity = val.Quantity.Value
+					ci.QuantityUnit = val.Quantity.Unit.Code
+					ci.PlantFamily = val.PlantFamily
 				}
 			}
 		}
@@ -0,0 +1,44 @@
+package inmemory
+
+import (
+	"sort"
+
+	"github.com/Tanibox/tania-core/src/growth/query"
+	"github.com/Tanibox/tania-core/src/growth/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type PlantingHistoryQueryInMemory struct {
+	Storage *storage.PlantingHistoryStorage
+}
+
+func NewPlantingHistoryQueryInMemory(s *storage.PlantingHistoryStorage) query.PlantingHistoryQuery {
+	return PlantingHistoryQueryInMemory{Storage: s}
+}
+
+// FindAllByArea returns the planting history of the area, the most recent first
+func (s PlantingHistoryQueryInMemory) FindAllByArea(areaUID uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		s.Storage.Lock.RLock()
+		defer s.Storage.Lock.RUnlock()
+
+		histories := []storage.PlantingHistory{}
+		for _, val := range s.Storage.PlantingHistoryMap {
+			if val.AreaUID == areaUID {
+				histories = append(histories, val)
+			}
+		}
+
+		sort.Slice(histories, func(i, j int) bool {
+			return histories[i].PlantedDate.After(histories[j].PlantedDate)
+		})
+
+		result <- query.QueryResult{Result: histories}
+
+		close(result)
+	}()
+
+	return result
+}
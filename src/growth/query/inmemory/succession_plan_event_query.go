@@ -0,0 +1,43 @@
+package inmemory
+
+import (
+	"sort"
+
+	"github.com/Tanibox/tania-core/src/growth/query"
+	"github.com/Tanibox/tania-core/src/growth/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type SuccessionPlanEventQueryInMemory struct {
+	Storage *storage.SuccessionPlanEventStorage
+}
+
+func NewSuccessionPlanEventQueryInMemory(s *storage.SuccessionPlanEventStorage) query.SuccessionPlanEventQuery {
+	return &SuccessionPlanEventQueryInMemory{Storage: s}
+}
+
+func (f *SuccessionPlanEventQueryInMemory) FindAllByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		f.Storage.Lock.RLock()
+		defer f.Storage.Lock.RUnlock()
+
+		events := []storage.SuccessionPlanEvent{}
+		for _, v := range f.Storage.SuccessionPlanEvents {
+			if v.SuccessionPlanUID == uid {
+				events = append(events, v)
+			}
+		}
+
+		sort.Slice(events, func(i, j int) bool {
+			return events[i].Version < events[j].Version
+		})
+
+		result <- query.QueryResult{Result: events}
+
+		close(result)
+	}()
+
+	return result
+}
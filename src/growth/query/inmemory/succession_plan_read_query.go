@@ -0,0 +1,62 @@
+package inmemory
+
+import (
+	"sort"
+
+	"github.com/Tanibox/tania-core/src/growth/query"
+	"github.com/Tanibox/tania-core/src/growth/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type SuccessionPlanReadQueryInMemory struct {
+	Storage *storage.SuccessionPlanReadStorage
+}
+
+func NewSuccessionPlanReadQueryInMemory(s *storage.SuccessionPlanReadStorage) query.SuccessionPlanReadQuery {
+	return SuccessionPlanReadQueryInMemory{Storage: s}
+}
+
+// FindAll returns the succession plans sorted by name.
+// If inventoryUID is not nil, only the succession plans of that inventory are returned.
+func (s SuccessionPlanReadQueryInMemory) FindAll(inventoryUID *uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		s.Storage.Lock.RLock()
+		defer s.Storage.Lock.RUnlock()
+
+		successionPlans := []storage.SuccessionPlanRead{}
+		for _, val := range s.Storage.SuccessionPlanReadMap {
+			if inventoryUID != nil && val.Inventory.UID != *inventoryUID {
+				continue
+			}
+
+			successionPlans = append(successionPlans, val)
+		}
+
+		sort.Slice(successionPlans, func(i, j int) bool {
+			return successionPlans[i].Name < successionPlans[j].Name
+		})
+
+		result <- query.QueryResult{Result: successionPlans}
+
+		close(result)
+	}()
+
+	return result
+}
+
+func (s SuccessionPlanReadQueryInMemory) FindByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		s.Storage.Lock.RLock()
+		defer s.Storage.Lock.RUnlock()
+
+		result <- query.QueryResult{Result: s.Storage.SuccessionPlanReadMap[uid]}
+
+		close(result)
+	}()
+
+	return result
+}
@@ -2,6 +2,8 @@ package sqlite
 
 import (
 	"database/sql"
+	"encoding/json"
+	"strings"
 	"time"
 
 	"github.com/Tanibox/tania-core/src/growth/domain"
@@ -92,6 +94,7 @@ type cropReadTrashResult struct {
 	SourceAreaName string
 	CreatedDate    time.Time
 	LastUpdated    time.Time
+	Reasons        []byte
 }
 
 type cropReadNotesResult struct {
@@ -288,7 +291,7 @@ func (s CropReadQueryMysql) CountAllCropsByFarm(farmUID uuid.UUID, status string
 	return result
 }
 
-func (s CropReadQueryMysql) FindAllCropsArchives(farmUID uuid.UUID, page, limit int) <-chan query.QueryResult {
+func (s CropReadQueryMysql) FindAllCropsArchives(farmUID uuid.UUID, filter query.CropArchiveFilter, page, limit int) <-chan query.QueryResult {
 	result := make(chan query.QueryResult)
 
 	go func() {
@@ -298,9 +301,11 @@ func (s CropReadQueryMysql) FindAllCropsArchives(farmUID uuid.UUID, page, limit
 
 		offset := paginationhelper.CalculatePageToOffset(page, limit)
 
-		rows, err := s.DB.Query(`SELECT UID FROM CROP_READ
-			WHERE FARM_UID = ? AND STATUS = ? ORDER BY INITIAL_AREA_CREATED_DATE DESC LIMIT ? OFFSET ?`,
-			farmUID.Bytes(), domain.CropArchived, limit, offset)
+		where, params := s.archiveFilterToSQL(farmUID, filter)
+		params = append(params, limit, offset)
+
+		rows, err := s.DB.Query(`SELECT UID FROM CROP_READ`+where+`
+			ORDER BY INITIAL_AREA_CREATED_DATE DESC LIMIT ? OFFSET ?`, params...)
 
 		if err != nil {
 			result <- query.QueryResult{Error: err}
@@ -360,14 +365,15 @@ func (s CropReadQueryMysql) FindAllCropsArchives(farmUID uuid.UUID, page, limit
 	return result
 }
 
-func (s CropReadQueryMysql) CountAllArchivedCropsByFarm(farmUID uuid.UUID) <-chan query.QueryResult {
+func (s CropReadQueryMysql) CountAllArchivedCropsByFarm(farmUID uuid.UUID, filter query.CropArchiveFilter) <-chan query.QueryResult {
 	result := make(chan query.QueryResult)
 
 	go func() {
 		total := 0
 
-		err := s.DB.QueryRow(`SELECT COUNT(UID) FROM CROP_READ
-			WHERE FARM_UID = ? AND STATUS = ?`, farmUID.Bytes(), domain.CropArchived).Scan(&total)
+		where, params := s.archiveFilterToSQL(farmUID, filter)
+
+		err := s.DB.QueryRow(`SELECT COUNT(UID) FROM CROP_READ`+where, params...).Scan(&total)
 		if err != nil {
 			result <- query.QueryResult{Error: err}
 		}
@@ -379,6 +385,33 @@ func (s CropReadQueryMysql) CountAllArchivedCropsByFarm(farmUID uuid.UUID) <-cha
 	return result
 }
 
+func (s CropReadQueryMysql) archiveFilterToSQL(farmUID uuid.UUID, filter query.CropArchiveFilter) (string, []interface{}) {
+	conditions := []string{`FARM_UID = ?`, `STATUS = ?`}
+	params := []interface{}{farmUID.Bytes(), domain.CropArchived}
+
+	if filter.BatchID != "" {
+		conditions = append(conditions, `BATCH_ID LIKE ?`)
+		params = append(params, "%"+filter.BatchID+"%")
+	}
+
+	if filter.Variety != "" {
+		conditions = append(conditions, `INVENTORY_NAME LIKE ?`)
+		params = append(params, "%"+filter.Variety+"%")
+	}
+
+	if filter.StartDate != nil {
+		conditions = append(conditions, `INITIAL_AREA_CREATED_DATE >= ?`)
+		params = append(params, *filter.StartDate)
+	}
+
+	if filter.EndDate != nil {
+		conditions = append(conditions, `INITIAL_AREA_CREATED_DATE < ?`)
+		params = append(params, *filter.EndDate)
+	}
+
+	return ` WHERE ` + strings.Join(conditions, ` AND `), params
+}
+
 func (s CropReadQueryMysql) FindAllCropsByArea(areaUID uuid.UUID) <-chan query.QueryResult {
 	result := make(chan query.QueryResult)
 
@@ -619,6 +652,107 @@ func (s CropReadQueryMysql) CountTotalBatch(farmUID uuid.UUID) <-chan query.Quer
 	return result
 }
 
+// FindAllCropsByInventory returns all the crop batches, including the archived ones,
+// that are planted from the inventory
+func (s CropReadQueryMysql) FindAllCropsByInventory(inventoryUID uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		cropUIDs := []uuid.UUID{}
+
+		rows, err := s.DB.Query(`SELECT UID FROM CROP_READ WHERE INVENTORY_UID = ?
+			ORDER BY INITIAL_AREA_CREATED_DATE DESC`, inventoryUID.Bytes())
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		for rows.Next() {
+			uid := []byte{}
+			err := rows.Scan(&uid)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			cropUID, err := uuid.FromBytes(uid)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			cropUIDs = append(cropUIDs, cropUID)
+		}
+
+		cropReads := []storage.CropRead{}
+		for _, v := range cropUIDs {
+			queryResult := <-s.FindByID(v)
+			if queryResult.Error != nil {
+				result <- query.QueryResult{Error: queryResult.Error}
+			}
+
+			cropRead, ok := queryResult.Result.(storage.CropRead)
+			if ok {
+				cropReads = append(cropReads, cropRead)
+			}
+		}
+
+		result <- query.QueryResult{Result: cropReads}
+		close(result)
+	}()
+
+	return result
+}
+
+// FindAllCropsByFarmWithArchives returns every crop batch of the farm, the archived ones included, without pagination
+func (s CropReadQueryMysql) FindAllCropsByFarmWithArchives(farmUID uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		cropUIDs := []uuid.UUID{}
+
+		rows, err := s.DB.Query(`SELECT UID FROM CROP_READ WHERE FARM_UID = ?
+			ORDER BY INITIAL_AREA_CREATED_DATE DESC`, farmUID.Bytes())
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		for rows.Next() {
+			uid := []byte{}
+			err := rows.Scan(&uid)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			cropUID, err := uuid.FromBytes(uid)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			cropUIDs = append(cropUIDs, cropUID)
+		}
+
+		cropReads := []storage.CropRead{}
+		for _, v := range cropUIDs {
+			queryResult := <-s.FindByID(v)
+			if queryResult.Error != nil {
+				result <- query.QueryResult{Error: queryResult.Error}
+			}
+
+			cropRead, ok := queryResult.Result.(storage.CropRead)
+			if ok {
+				cropReads = append(cropReads, cropRead)
+			}
+		}
+
+		result <- query.QueryResult{Result: cropReads}
+		close(result)
+	}()
+
+	return result
+}
+
 func (s CropReadQueryMysql) populateCrop(cropUID uuid.UUID, cropRead *storage.CropRead) error {
 	rowsData := cropReadResult{}
 
@@ -946,7 +1080,19 @@ func (s CropReadQueryMysql) populateCropTrash(uid uuid.UUID, cropRead *storage.C
 			&trashRowsData.SourceAreaUID,
 			&trashRowsData.SourceAreaName,
 			&trashRowsData.CreatedDate,
-			&trashRowsData.LastUpdated)
+			&trashRowsData.LastUpdated,
+			&trashRowsData.Reasons)
+		if err != nil {
+			return err
+		}
+
+		var reasons map[string]int
+		if len(trashRowsData.Reasons) > 0 {
+			err = json.Unmarshal(trashRowsData.Reasons, &reasons)
+			if err != nil {
+				return err
+			}
+		}
 
 		sourceAreaUID, err := uuid.FromBytes(trashRowsData.SourceAreaUID)
 		if err != nil {
@@ -959,6 +1105,7 @@ func (s CropReadQueryMysql) populateCropTrash(uid uuid.UUID, cropRead *storage.C
 			SourceAreaName: trashRowsData.SourceAreaName,
 			CreatedDate:    trashRowsData.CreatedDate,
 			LastUpdated:    trashRowsData.LastUpdated,
+			Reasons:        reasons,
 		})
 	}
 
@@ -0,0 +1,76 @@
+package sqlite
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/growth/decoder"
+	"github.com/Tanibox/tania-core/src/growth/query"
+	"github.com/Tanibox/tania-core/src/growth/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type CropTemplateEventQueryMysql struct {
+	DB *sql.DB
+}
+
+func NewCropTemplateEventQueryMysql(db *sql.DB) query.CropTemplateEventQuery {
+	return &CropTemplateEventQueryMysql{DB: db}
+}
+
+func (f *CropTemplateEventQueryMysql) FindAllByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		result <- f.findAll(uid)
+		close(result)
+	}()
+
+	return result
+}
+
+func (f *CropTemplateEventQueryMysql) findAll(uid uuid.UUID) query.QueryResult {
+	events := []storage.CropTemplateEvent{}
+
+	rows, err := f.DB.Query(`SELECT * FROM CROP_TEMPLATE_EVENT WHERE CROP_TEMPLATE_UID = ? ORDER BY VERSION ASC`, uid.Bytes())
+	if err != nil {
+		return query.QueryResult{Error: err}
+	}
+	defer rows.Close()
+
+	rowsData := struct {
+		ID              int
+		CropTemplateUID []byte
+		Version         int
+		CreatedDate     time.Time
+		Event           []byte
+	}{}
+
+	for rows.Next() {
+		err = rows.Scan(&rowsData.ID, &rowsData.CropTemplateUID, &rowsData.Version, &rowsData.CreatedDate, &rowsData.Event)
+		if err != nil {
+			return query.QueryResult{Error: err}
+		}
+
+		wrapper := decoder.CropTemplateEventWrapper{}
+		err = json.Unmarshal(rowsData.Event, &wrapper)
+		if err != nil {
+			return query.QueryResult{Error: err}
+		}
+
+		cropTemplateUID, err := uuid.FromBytes(rowsData.CropTemplateUID)
+		if err != nil {
+			return query.QueryResult{Error: err}
+		}
+
+		events = append(events, storage.CropTemplateEvent{
+			CropTemplateUID: cropTemplateUID,
+			Version:         rowsData.Version,
+			CreatedDate:     rowsData.CreatedDate,
+			Event:           wrapper.Data,
+		})
+	}
+
+	return query.QueryResult{Result: events}
+}
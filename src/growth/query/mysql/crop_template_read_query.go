@@ -0,0 +1,128 @@
+package sqlite
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/growth/query"
+	"github.com/Tanibox/tania-core/src/growth/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type CropTemplateReadQueryMysql struct {
+	DB *sql.DB
+}
+
+func NewCropTemplateReadQueryMysql(db *sql.DB) query.CropTemplateReadQuery {
+	return CropTemplateReadQueryMysql{DB: db}
+}
+
+type cropTemplateReadResult struct {
+	UID           []byte
+	Name          string
+	InventoryUID  []byte
+	InventoryName string
+	Tasks         []byte
+	CreatedDate   time.Time
+}
+
+// FindAll returns the crop templates sorted by name.
+// If inventoryUID is not nil, only the crop templates of that inventory are returned.
+func (s CropTemplateReadQueryMysql) FindAll(inventoryUID *uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		sql := `SELECT * FROM CROP_TEMPLATE_READ`
+		params := []interface{}{}
+
+		if inventoryUID != nil {
+			sql += ` WHERE INVENTORY_UID = ?`
+			params = append(params, inventoryUID.Bytes())
+		}
+
+		sql += ` ORDER BY NAME ASC`
+
+		result <- s.findAll(sql, params...)
+		close(result)
+	}()
+
+	return result
+}
+
+func (s CropTemplateReadQueryMysql) FindByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		queryResult := s.findAll(`SELECT * FROM CROP_TEMPLATE_READ WHERE UID = ?`, uid.Bytes())
+		if queryResult.Error != nil {
+			result <- queryResult
+			close(result)
+			return
+		}
+
+		cropTemplate := storage.CropTemplateRead{}
+		if cropTemplates := queryResult.Result.([]storage.CropTemplateRead); len(cropTemplates) > 0 {
+			cropTemplate = cropTemplates[0]
+		}
+
+		result <- query.QueryResult{Result: cropTemplate}
+		close(result)
+	}()
+
+	return result
+}
+
+func (s CropTemplateReadQueryMysql) findAll(sql string, params ...interface{}) query.QueryResult {
+	cropTemplates := []storage.CropTemplateRead{}
+	rowsData := cropTemplateReadResult{}
+
+	rows, err := s.DB.Query(sql, params...)
+	if err != nil {
+		return query.QueryResult{Error: err}
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		err = rows.Scan(
+			&rowsData.UID,
+			&rowsData.Name,
+			&rowsData.InventoryUID,
+			&rowsData.InventoryName,
+			&rowsData.Tasks,
+			&rowsData.CreatedDate,
+		)
+		if err != nil {
+			return query.QueryResult{Error: err}
+		}
+
+		uid, err := uuid.FromBytes(rowsData.UID)
+		if err != nil {
+			return query.QueryResult{Error: err}
+		}
+
+		inventoryUID, err := uuid.FromBytes(rowsData.InventoryUID)
+		if err != nil {
+			return query.QueryResult{Error: err}
+		}
+
+		cropTemplate := storage.CropTemplateRead{
+			UID:  uid,
+			Name: rowsData.Name,
+			Inventory: storage.CropTemplateInventory{
+				UID:  inventoryUID,
+				Name: rowsData.InventoryName,
+			},
+			CreatedDate: rowsData.CreatedDate,
+		}
+
+		err = json.Unmarshal(rowsData.Tasks, &cropTemplate.Tasks)
+		if err != nil {
+			return query.QueryResult{Error: err}
+		}
+
+		cropTemplates = append(cropTemplates, cropTemplate)
+	}
+
+	return query.QueryResult{Result: cropTemplates}
+}
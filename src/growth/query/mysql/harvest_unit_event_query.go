@@ -0,0 +1,76 @@
+package sqlite
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/growth/decoder"
+	"github.com/Tanibox/tania-core/src/growth/query"
+	"github.com/Tanibox/tania-core/src/growth/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type HarvestUnitEventQueryMysql struct {
+	DB *sql.DB
+}
+
+func NewHarvestUnitEventQueryMysql(db *sql.DB) query.HarvestUnitEventQuery {
+	return &HarvestUnitEventQueryMysql{DB: db}
+}
+
+func (f *HarvestUnitEventQueryMysql) FindAllByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		result <- f.findAll(uid)
+		close(result)
+	}()
+
+	return result
+}
+
+func (f *HarvestUnitEventQueryMysql) findAll(uid uuid.UUID) query.QueryResult {
+	events := []storage.HarvestUnitEvent{}
+
+	rows, err := f.DB.Query(`SELECT * FROM HARVEST_UNIT_EVENT WHERE HARVEST_UNIT_UID = ? ORDER BY VERSION ASC`, uid.Bytes())
+	if err != nil {
+		return query.QueryResult{Error: err}
+	}
+	defer rows.Close()
+
+	rowsData := struct {
+		ID             int
+		HarvestUnitUID []byte
+		Version        int
+		CreatedDate    time.Time
+		Event          []byte
+	}{}
+
+	for rows.Next() {
+		err = rows.Scan(&rowsData.ID, &rowsData.HarvestUnitUID, &rowsData.Version, &rowsData.CreatedDate, &rowsData.Event)
+		if err != nil {
+			return query.QueryResult{Error: err}
+		}
+
+		wrapper := decoder.HarvestUnitEventWrapper{}
+		err = json.Unmarshal(rowsData.Event, &wrapper)
+		if err != nil {
+			return query.QueryResult{Error: err}
+		}
+
+		harvestUnitUID, err := uuid.FromBytes(rowsData.HarvestUnitUID)
+		if err != nil {
+			return query.QueryResult{Error: err}
+		}
+
+		events = append(events, storage.HarvestUnitEvent{
+			HarvestUnitUID: harvestUnitUID,
+			Version:        rowsData.Version,
+			CreatedDate:    rowsData.CreatedDate,
+			Event:          wrapper.Data,
+		})
+	}
+
+	return query.QueryResult{Result: events}
+}
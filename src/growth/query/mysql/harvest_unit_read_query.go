@@ -0,0 +1,137 @@
+package sqlite
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/growth/query"
+	"github.com/Tanibox/tania-core/src/growth/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type HarvestUnitReadQueryMysql struct {
+	DB *sql.DB
+}
+
+func NewHarvestUnitReadQueryMysql(db *sql.DB) query.HarvestUnitReadQuery {
+	return HarvestUnitReadQueryMysql{DB: db}
+}
+
+type harvestUnitReadResult struct {
+	UID               []byte
+	InventoryUID      []byte
+	InventoryName     string
+	Code              string
+	Label             string
+	AverageGramWeight float32
+	CreatedDate       time.Time
+}
+
+// FindAll returns the harvest units sorted by code.
+// If inventoryUID is not nil, only the harvest units of that inventory are returned.
+func (s HarvestUnitReadQueryMysql) FindAll(inventoryUID *uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		sql := `SELECT * FROM HARVEST_UNIT_READ`
+		params := []interface{}{}
+
+		if inventoryUID != nil {
+			sql += ` WHERE INVENTORY_UID = ?`
+			params = append(params, inventoryUID.Bytes())
+		}
+
+		sql += ` ORDER BY CODE ASC`
+
+		result <- s.findAll(sql, params...)
+		close(result)
+	}()
+
+	return result
+}
+
+func (s HarvestUnitReadQueryMysql) FindByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		result <- s.findOne(`SELECT * FROM HARVEST_UNIT_READ WHERE UID = ?`, uid.Bytes())
+		close(result)
+	}()
+
+	return result
+}
+
+func (s HarvestUnitReadQueryMysql) FindByInventoryAndCode(inventoryUID uuid.UUID, code string) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		result <- s.findOne(`SELECT * FROM HARVEST_UNIT_READ WHERE INVENTORY_UID = ? AND CODE = ?`, inventoryUID.Bytes(), code)
+		close(result)
+	}()
+
+	return result
+}
+
+func (s HarvestUnitReadQueryMysql) findOne(sql string, params ...interface{}) query.QueryResult {
+	queryResult := s.findAll(sql, params...)
+	if queryResult.Error != nil {
+		return queryResult
+	}
+
+	harvestUnit := storage.HarvestUnitRead{}
+	if harvestUnits := queryResult.Result.([]storage.HarvestUnitRead); len(harvestUnits) > 0 {
+		harvestUnit = harvestUnits[0]
+	}
+
+	return query.QueryResult{Result: harvestUnit}
+}
+
+func (s HarvestUnitReadQueryMysql) findAll(sql string, params ...interface{}) query.QueryResult {
+	harvestUnits := []storage.HarvestUnitRead{}
+	rowsData := harvestUnitReadResult{}
+
+	rows, err := s.DB.Query(sql, params...)
+	if err != nil {
+		return query.QueryResult{Error: err}
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		err = rows.Scan(
+			&rowsData.UID,
+			&rowsData.InventoryUID,
+			&rowsData.InventoryName,
+			&rowsData.Code,
+			&rowsData.Label,
+			&rowsData.AverageGramWeight,
+			&rowsData.CreatedDate,
+		)
+		if err != nil {
+			return query.QueryResult{Error: err}
+		}
+
+		uid, err := uuid.FromBytes(rowsData.UID)
+		if err != nil {
+			return query.QueryResult{Error: err}
+		}
+
+		inventoryUID, err := uuid.FromBytes(rowsData.InventoryUID)
+		if err != nil {
+			return query.QueryResult{Error: err}
+		}
+
+		harvestUnits = append(harvestUnits, storage.HarvestUnitRead{
+			UID: uid,
+			Inventory: storage.HarvestUnitInventory{
+				UID:  inventoryUID,
+				Name: rowsData.InventoryName,
+			},
+			Code:              rowsData.Code,
+			Label:             rowsData.Label,
+			AverageGramWeight: rowsData.AverageGramWeight,
+			CreatedDate:       rowsData.CreatedDate,
+		})
+	}
+
+	return query.QueryResult{Result: harvestUnits}
+}
@@ -0,0 +1,89 @@
+package sqlite
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/growth/query"
+	"github.com/Tanibox/tania-core/src/growth/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type PlantingHistoryQueryMysql struct {
+	DB *sql.DB
+}
+
+func NewPlantingHistoryQueryMysql(db *sql.DB) query.PlantingHistoryQuery {
+	return PlantingHistoryQueryMysql{DB: db}
+}
+
+type plantingHistoryResult struct {
+	AreaUID      []byte
+	CropUID      []byte
+	BatchID      string
+	InventoryUID []byte
+	VarietyName  string
+	PlantFamily  string
+	PlantedDate  time.Time
+}
+
+// FindAllByArea returns the planting history of the area, the most recent first
+func (s PlantingHistoryQueryMysql) FindAllByArea(areaUID uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		histories := []storage.PlantingHistory{}
+		rowsData := plantingHistoryResult{}
+
+		rows, err := s.DB.Query(`SELECT AREA_UID, CROP_UID, BATCH_ID, INVENTORY_UID, VARIETY_NAME, PLANT_FAMILY, PLANTED_DATE
+			FROM PLANTING_HISTORY_READ WHERE AREA_UID = ? ORDER BY PLANTED_DATE DESC`, areaUID.Bytes())
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+		}
+
+		for rows.Next() {
+			err = rows.Scan(
+				&rowsData.AreaUID,
+				&rowsData.CropUID,
+				&rowsData.BatchID,
+				&rowsData.InventoryUID,
+				&rowsData.VarietyName,
+				&rowsData.PlantFamily,
+				&rowsData.PlantedDate,
+			)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			areaUID, err := uuid.FromBytes(rowsData.AreaUID)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			cropUID, err := uuid.FromBytes(rowsData.CropUID)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			inventoryUID, err := uuid.FromBytes(rowsData.InventoryUID)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			histories = append(histories, storage.PlantingHistory{
+				AreaUID:      areaUID,
+				CropUID:      cropUID,
+				BatchID:      rowsData.BatchID,
+				InventoryUID: inventoryUID,
+				VarietyName:  rowsData.VarietyName,
+				PlantFamily:  rowsData.PlantFamily,
+				PlantedDate:  rowsData.PlantedDate,
+			})
+		}
+
+		result <- query.QueryResult{Result: histories}
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,76 @@
+package sqlite
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/growth/decoder"
+	"github.com/Tanibox/tania-core/src/growth/query"
+	"github.com/Tanibox/tania-core/src/growth/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type SuccessionPlanEventQueryMysql struct {
+	DB *sql.DB
+}
+
+func NewSuccessionPlanEventQueryMysql(db *sql.DB) query.SuccessionPlanEventQuery {
+	return &SuccessionPlanEventQueryMysql{DB: db}
+}
+
+func (f *SuccessionPlanEventQueryMysql) FindAllByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		result <- f.findAll(uid)
+		close(result)
+	}()
+
+	return result
+}
+
+func (f *SuccessionPlanEventQueryMysql) findAll(uid uuid.UUID) query.QueryResult {
+	events := []storage.SuccessionPlanEvent{}
+
+	rows, err := f.DB.Query(`SELECT * FROM SUCCESSION_PLAN_EVENT WHERE SUCCESSION_PLAN_UID = ? ORDER BY VERSION ASC`, uid.Bytes())
+	if err != nil {
+		return query.QueryResult{Error: err}
+	}
+	defer rows.Close()
+
+	rowsData := struct {
+		ID                int
+		SuccessionPlanUID []byte
+		Version           int
+		CreatedDate       time.Time
+		Event             []byte
+	}{}
+
+	for rows.Next() {
+		err = rows.Scan(&rowsData.ID, &rowsData.SuccessionPlanUID, &rowsData.Version, &rowsData.CreatedDate, &rowsData.Event)
+		if err != nil {
+			return query.QueryResult{Error: err}
+		}
+
+		wrapper := decoder.SuccessionPlanEventWrapper{}
+		err = json.Unmarshal(rowsData.Event, &wrapper)
+		if err != nil {
+			return query.QueryResult{Error: err}
+		}
+
+		successionPlanUID, err := uuid.FromBytes(rowsData.SuccessionPlanUID)
+		if err != nil {
+			return query.QueryResult{Error: err}
+		}
+
+		events = append(events, storage.SuccessionPlanEvent{
+			SuccessionPlanUID: successionPlanUID,
+			Version:           rowsData.Version,
+			CreatedDate:       rowsData.CreatedDate,
+			Event:             wrapper.Data,
+		})
+	}
+
+	return query.QueryResult{Result: events}
+}
@@ -0,0 +1,156 @@
+package sqlite
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/growth/query"
+	"github.com/Tanibox/tania-core/src/growth/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type SuccessionPlanReadQueryMysql struct {
+	DB *sql.DB
+}
+
+func NewSuccessionPlanReadQueryMysql(db *sql.DB) query.SuccessionPlanReadQuery {
+	return SuccessionPlanReadQueryMysql{DB: db}
+}
+
+type successionPlanReadResult struct {
+	UID            []byte
+	Name           string
+	InventoryUID   []byte
+	InventoryName  string
+	Areas          []byte
+	CropType       string
+	ContainerType  string
+	ContainerCell  int
+	Quantity       int
+	IntervalDays   int
+	StartDate      time.Time
+	EndDate        time.Time
+	PlannedBatches []byte
+	CreatedDate    time.Time
+}
+
+// FindAll returns the succession plans sorted by name.
+// If inventoryUID is not nil, only the succession plans of that inventory are returned.
+func (s SuccessionPlanReadQueryMysql) FindAll(inventoryUID *uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		sql := `SELECT * FROM SUCCESSION_PLAN_READ`
+		params := []interface{}{}
+
+		if inventoryUID != nil {
+			sql += ` WHERE INVENTORY_UID = ?`
+			params = append(params, inventoryUID.Bytes())
+		}
+
+		sql += ` ORDER BY NAME ASC`
+
+		result <- s.findAll(sql, params...)
+		close(result)
+	}()
+
+	return result
+}
+
+func (s SuccessionPlanReadQueryMysql) FindByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		queryResult := s.findAll(`SELECT * FROM SUCCESSION_PLAN_READ WHERE UID = ?`, uid.Bytes())
+		if queryResult.Error != nil {
+			result <- queryResult
+			close(result)
+			return
+		}
+
+		successionPlan := storage.SuccessionPlanRead{}
+		if successionPlans := queryResult.Result.([]storage.SuccessionPlanRead); len(successionPlans) > 0 {
+			successionPlan = successionPlans[0]
+		}
+
+		result <- query.QueryResult{Result: successionPlan}
+		close(result)
+	}()
+
+	return result
+}
+
+func (s SuccessionPlanReadQueryMysql) findAll(sql string, params ...interface{}) query.QueryResult {
+	successionPlans := []storage.SuccessionPlanRead{}
+	rowsData := successionPlanReadResult{}
+
+	rows, err := s.DB.Query(sql, params...)
+	if err != nil {
+		return query.QueryResult{Error: err}
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		err = rows.Scan(
+			&rowsData.UID,
+			&rowsData.Name,
+			&rowsData.InventoryUID,
+			&rowsData.InventoryName,
+			&rowsData.Areas,
+			&rowsData.CropType,
+			&rowsData.ContainerType,
+			&rowsData.ContainerCell,
+			&rowsData.Quantity,
+			&rowsData.IntervalDays,
+			&rowsData.StartDate,
+			&rowsData.EndDate,
+			&rowsData.PlannedBatches,
+			&rowsData.CreatedDate,
+		)
+		if err != nil {
+			return query.QueryResult{Error: err}
+		}
+
+		uid, err := uuid.FromBytes(rowsData.UID)
+		if err != nil {
+			return query.QueryResult{Error: err}
+		}
+
+		inventoryUID, err := uuid.FromBytes(rowsData.InventoryUID)
+		if err != nil {
+			return query.QueryResult{Error: err}
+		}
+
+		successionPlan := storage.SuccessionPlanRead{
+			UID:  uid,
+			Name: rowsData.Name,
+			Inventory: storage.SuccessionPlanInventory{
+				UID:  inventoryUID,
+				Name: rowsData.InventoryName,
+			},
+			CropType:      rowsData.CropType,
+			ContainerType: rowsData.ContainerType,
+			ContainerCell: rowsData.ContainerCell,
+			Quantity:      rowsData.Quantity,
+			IntervalDays:  rowsData.IntervalDays,
+			StartDate:     rowsData.StartDate,
+			EndDate:       rowsData.EndDate,
+			CreatedDate:   rowsData.CreatedDate,
+		}
+
+		err = json.Unmarshal(rowsData.Areas, &successionPlan.Areas)
+		if err != nil {
+			return query.QueryResult{Error: err}
+		}
+
+		err = json.Unmarshal(rowsData.PlannedBatches, &successionPlan.PlannedBatches)
+		if err != nil {
+			return query.QueryResult{Error: err}
+		}
+
+		successionPlans = append(successionPlans, successionPlan)
+	}
+
+	return query.QueryResult{Result: successionPlans}
+}
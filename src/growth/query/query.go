@@ -26,15 +26,89 @@ type CropReadQuery interface {
 	FindAllCropsByFarm(farmUID uuid.UUID, status string, page, limit int) <-chan QueryResult
 	CountAllCropsByFarm(farmUID uuid.UUID, status string) <-chan QueryResult
 	FindAllCropsByArea(areaUID uuid.UUID) <-chan QueryResult
-	FindAllCropsArchives(farmUID uuid.UUID, page, limit int) <-chan QueryResult
-	CountAllArchivedCropsByFarm(farmUID uuid.UUID) <-chan QueryResult
+	FindAllCropsArchives(farmUID uuid.UUID, filter CropArchiveFilter, page, limit int) <-chan QueryResult
+	CountAllArchivedCropsByFarm(farmUID uuid.UUID, filter CropArchiveFilter) <-chan QueryResult
 	FindCropsInformation(farmUID uuid.UUID) <-chan QueryResult
 	CountTotalBatch(farmUID uuid.UUID) <-chan QueryResult
+	FindAllCropsByInventory(inventoryUID uuid.UUID) <-chan QueryResult
+	FindAllCropsByFarmWithArchives(farmUID uuid.UUID) <-chan QueryResult
+}
+
+// CropArchiveFilter narrows down the archived crop batches of a farm.
+// Zero value fields don't filter anything.
+type CropArchiveFilter struct {
+	// BatchID and Variety match a part of the batch ID and of the inventory name
+	BatchID string
+	Variety string
+
+	// StartDate is inclusive and EndDate is exclusive. They are compared to the seeding date.
+	StartDate *time.Time
+	EndDate   *time.Time
 }
 
 type CropActivityQuery interface {
 	FindAllByCropID(uid uuid.UUID) <-chan QueryResult
 	FindByCropIDAndActivityType(uid uuid.UUID, activityType interface{}) <-chan QueryResult
+	FindAllByFilter(filter CropActivityFilter, page, limit int) <-chan QueryResult
+	CountAllByFilter(filter CropActivityFilter) <-chan QueryResult
+}
+
+// CropActivityFilter narrows down a crop activity timeline.
+// Zero value fields don't filter anything.
+type CropActivityFilter struct {
+	CropUID uuid.UUID
+	FarmUID uuid.UUID
+
+	// AreaUID keeps the activities that took place in the area.
+	// Task activities only keep the area name so they never match.
+	AreaUID uuid.UUID
+
+	ActivityTypeCodes []string
+
+	// StartDate is inclusive and EndDate is exclusive
+	StartDate *time.Time
+	EndDate   *time.Time
+
+	// Ascending sorts the oldest activity first. The default is the most recent first.
+	Ascending bool
+}
+
+type PlantingHistoryQuery interface {
+	FindAllByArea(areaUID uuid.UUID) <-chan QueryResult
+}
+
+type HarvestLotQuery interface {
+	FindAllByFarm(farmUID uuid.UUID, harvestDate *time.Time) <-chan QueryResult
+	FindAllByCrop(cropUID uuid.UUID) <-chan QueryResult
+	FindByID(uid uuid.UUID) <-chan QueryResult
+}
+
+type CropTemplateEventQuery interface {
+	FindAllByID(uid uuid.UUID) <-chan QueryResult
+}
+
+type CropTemplateReadQuery interface {
+	FindAll(inventoryUID *uuid.UUID) <-chan QueryResult
+	FindByID(uid uuid.UUID) <-chan QueryResult
+}
+
+type HarvestUnitEventQuery interface {
+	FindAllByID(uid uuid.UUID) <-chan QueryResult
+}
+
+type HarvestUnitReadQuery interface {
+	FindAll(inventoryUID *uuid.UUID) <-chan QueryResult
+	FindByID(uid uuid.UUID) <-chan QueryResult
+	FindByInventoryAndCode(inventoryUID uuid.UUID, code string) <-chan QueryResult
+}
+
+type SuccessionPlanEventQuery interface {
+	FindAllByID(uid uuid.UUID) <-chan QueryResult
+}
+
+type SuccessionPlanReadQuery interface {
+	FindAll(inventoryUID *uuid.UUID) <-chan QueryResult
+	FindByID(uid uuid.UUID) <-chan QueryResult
 }
 
 type MaterialReadQuery interface {
@@ -60,6 +134,9 @@ type CropMaterialQueryResult struct {
 	TypeCode      string    `json:"type"`
 	PlantTypeCode string    `json:"plant_type"`
 	Name          string    `json:"name"`
+	Quantity      float32   `json:"quantity"`
+	QuantityUnit  string    `json:"quantity_unit"`
+	PlantFamily   string    `json:"plant_family"`
 }
 
 type CropAreaQueryResult struct {
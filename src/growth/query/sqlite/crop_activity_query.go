@@ -4,11 +4,13 @@ import (
 	"database/sql"
 	"encoding/json"
 	"errors"
+	"strings"
 	"time"
 
 	"github.com/Tanibox/tania-core/src/growth/decoder"
 	"github.com/Tanibox/tania-core/src/growth/query"
 	"github.com/Tanibox/tania-core/src/growth/storage"
+	"github.com/Tanibox/tania-core/src/helper/paginationhelper"
 	uuid "github.com/satori/go.uuid"
 )
 
@@ -154,3 +156,154 @@ func (s CropActivityQuerySqlite) FindByCropIDAndActivityType(uid uuid.UUID, acti
 
 	return result
 }
+
+// FindAllByFilter returns a page of the crop activities matching the filter.
+// All the matching activities are returned when limit is zero.
+func (s CropActivityQuerySqlite) FindAllByFilter(filter query.CropActivityFilter, page, limit int) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		where, params := s.filterToSQL(filter)
+
+		sql := `SELECT * FROM CROP_ACTIVITY` + where
+
+		if filter.Ascending {
+			sql += ` ORDER BY CREATED_DATE ASC, ID ASC`
+		} else {
+			sql += ` ORDER BY CREATED_DATE DESC, ID DESC`
+		}
+
+		if limit > 0 {
+			sql += ` LIMIT ? OFFSET ?`
+			params = append(params, limit, paginationhelper.CalculatePageToOffset(page, limit))
+		}
+
+		result <- s.findAll(sql, params...)
+		close(result)
+	}()
+
+	return result
+}
+
+func (s CropActivityQuerySqlite) CountAllByFilter(filter query.CropActivityFilter) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		total := 0
+
+		where, params := s.filterToSQL(filter)
+
+		err := s.DB.QueryRow(`SELECT COUNT(ID) FROM CROP_ACTIVITY`+where, params...).Scan(&total)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+		}
+
+		result <- query.QueryResult{Result: total}
+		close(result)
+	}()
+
+	return result
+}
+
+func (s CropActivityQuerySqlite) filterToSQL(filter query.CropActivityFilter) (string, []interface{}) {
+	conditions := []string{}
+	params := []interface{}{}
+
+	if filter.CropUID != (uuid.UUID{}) {
+		conditions = append(conditions, `CROP_UID = ?`)
+		params = append(params, filter.CropUID)
+	}
+
+	if filter.FarmUID != (uuid.UUID{}) {
+		conditions = append(conditions, `CROP_UID IN (SELECT UID FROM CROP_READ WHERE FARM_UID = ?)`)
+		params = append(params, filter.FarmUID)
+	}
+
+	// The area UIDs are only stored inside the activity type JSON
+	if filter.AreaUID != (uuid.UUID{}) {
+		conditions = append(conditions, `ACTIVITY_TYPE LIKE ?`)
+		params = append(params, "%"+filter.AreaUID.String()+"%")
+	}
+
+	if len(filter.ActivityTypeCodes) > 0 {
+		placeholders := []string{}
+		for _, v := range filter.ActivityTypeCodes {
+			placeholders = append(placeholders, "?")
+			params = append(params, v)
+		}
+
+		conditions = append(conditions, `ACTIVITY_TYPE_CODE IN (`+strings.Join(placeholders, ", ")+`)`)
+	}
+
+	if filter.StartDate != nil {
+		conditions = append(conditions, `CREATED_DATE >= ?`)
+		params = append(params, filter.StartDate.Format(time.RFC3339))
+	}
+
+	if filter.EndDate != nil {
+		conditions = append(conditions, `CREATED_DATE < ?`)
+		params = append(params, filter.EndDate.Format(time.RFC3339))
+	}
+
+	if len(conditions) == 0 {
+		return "", params
+	}
+
+	return ` WHERE ` + strings.Join(conditions, ` AND `), params
+}
+
+func (s CropActivityQuerySqlite) findAll(sql string, params ...interface{}) query.QueryResult {
+	cropActivities := []storage.CropActivity{}
+	rowsData := cropActivityResult{}
+
+	rows, err := s.DB.Query(sql, params...)
+	if err != nil {
+		return query.QueryResult{Error: err}
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		err = rows.Scan(
+			&rowsData.ID,
+			&rowsData.CropUID,
+			&rowsData.BatchID,
+			&rowsData.ContainerType,
+			&rowsData.ActivityType,
+			&rowsData.ActivityTypeCode,
+			&rowsData.CreatedDate,
+			&rowsData.Description,
+		)
+		if err != nil {
+			return query.QueryResult{Error: err}
+		}
+
+		wrapper := decoder.CropActivityTypeWrapper{}
+		json.Unmarshal(rowsData.ActivityType, &wrapper)
+
+		activityType, ok := wrapper.Data.(storage.ActivityType)
+		if !ok {
+			return query.QueryResult{Error: errors.New("Error type assertion")}
+		}
+
+		cropUID, err := uuid.FromString(rowsData.CropUID)
+		if err != nil {
+			return query.QueryResult{Error: err}
+		}
+
+		createdDate, err := time.Parse(time.RFC3339, rowsData.CreatedDate)
+		if err != nil {
+			return query.QueryResult{Error: err}
+		}
+
+		cropActivities = append(cropActivities, storage.CropActivity{
+			UID:           cropUID,
+			BatchID:       rowsData.BatchID,
+			ContainerType: rowsData.ContainerType,
+			ActivityType:  activityType,
+			CreatedDate:   createdDate,
+			Description:   rowsData.Description,
+		})
+	}
+
+	return query.QueryResult{Result: cropActivities}
+}
@@ -2,6 +2,8 @@ package sqlite
 
 import (
 	"database/sql"
+	"encoding/json"
+	"strings"
 	"time"
 
 	"github.com/Tanibox/tania-core/src/growth/domain"
@@ -92,6 +94,7 @@ type cropReadTrashResult struct {
 	SourceAreaName string
 	CreatedDate    string
 	LastUpdated    string
+	Reasons        []byte
 }
 
 type cropReadNotesResult struct {
@@ -288,7 +291,7 @@ func (s CropReadQuerySqlite) CountAllCropsByFarm(farmUID uuid.UUID, status strin
 	return result
 }
 
-func (s CropReadQuerySqlite) FindAllCropsArchives(farmUID uuid.UUID, page, limit int) <-chan query.QueryResult {
+func (s CropReadQuerySqlite) FindAllCropsArchives(farmUID uuid.UUID, filter query.CropArchiveFilter, page, limit int) <-chan query.QueryResult {
 	result := make(chan query.QueryResult)
 
 	go func() {
@@ -298,9 +301,11 @@ func (s CropReadQuerySqlite) FindAllCropsArchives(farmUID uuid.UUID, page, limit
 
 		offset := paginationhelper.CalculatePageToOffset(page, limit)
 
-		rows, err := s.DB.Query(`SELECT UID FROM CROP_READ
-			WHERE FARM_UID = ? AND STATUS = ? ORDER BY INITIAL_AREA_CREATED_DATE DESC LIMIT ? OFFSET ?`,
-			farmUID, domain.CropArchived, limit, offset)
+		where, params := s.archiveFilterToSQL(farmUID, filter)
+		params = append(params, limit, offset)
+
+		rows, err := s.DB.Query(`SELECT UID FROM CROP_READ`+where+`
+			ORDER BY INITIAL_AREA_CREATED_DATE DESC LIMIT ? OFFSET ?`, params...)
 
 		if err != nil {
 			result <- query.QueryResult{Error: err}
@@ -360,14 +365,15 @@ func (s CropReadQuerySqlite) FindAllCropsArchives(farmUID uuid.UUID, page, limit
 	return result
 }
 
-func (s CropReadQuerySqlite) CountAllArchivedCropsByFarm(farmUID uuid.UUID) <-chan query.QueryResult {
+func (s CropReadQuerySqlite) CountAllArchivedCropsByFarm(farmUID uuid.UUID, filter query.CropArchiveFilter) <-chan query.QueryResult {
 	result := make(chan query.QueryResult)
 
 	go func() {
 		total := 0
 
-		err := s.DB.QueryRow(`SELECT COUNT(UID) FROM CROP_READ
-			WHERE FARM_UID = ? AND STATUS = ?`, farmUID, domain.CropArchived).Scan(&total)
+		where, params := s.archiveFilterToSQL(farmUID, filter)
+
+		err := s.DB.QueryRow(`SELECT COUNT(UID) FROM CROP_READ`+where, params...).Scan(&total)
 		if err != nil {
 			result <- query.QueryResult{Error: err}
 		}
@@ -379,6 +385,33 @@ func (s CropReadQuerySqlite) CountAllArchivedCropsByFarm(farmUID uuid.UUID) <-ch
 	return result
 }
 
+func (s CropReadQuerySqlite) archiveFilterToSQL(farmUID uuid.UUID, filter query.CropArchiveFilter) (string, []interface{}) {
+	conditions := []string{`FARM_UID = ?`, `STATUS = ?`}
+	params := []interface{}{farmUID, domain.CropArchived}
+
+	if filter.BatchID != "" {
+		conditions = append(conditions, `BATCH_ID LIKE ?`)
+		params = append(params, "%"+filter.BatchID+"%")
+	}
+
+	if filter.Variety != "" {
+		conditions = append(conditions, `INVENTORY_NAME LIKE ?`)
+		params = append(params, "%"+filter.Variety+"%")
+	}
+
+	if filter.StartDate != nil {
+		conditions = append(conditions, `INITIAL_AREA_CREATED_DATE >= ?`)
+		params = append(params, filter.StartDate.Format(time.RFC3339))
+	}
+
+	if filter.EndDate != nil {
+		conditions = append(conditions, `INITIAL_AREA_CREATED_DATE < ?`)
+		params = append(params, filter.EndDate.Format(time.RFC3339))
+	}
+
+	return ` WHERE ` + strings.Join(conditions, ` AND `), params
+}
+
 func (s CropReadQuerySqlite) FindAllCropsByArea(areaUID uuid.UUID) <-chan query.QueryResult {
 	result := make(chan query.QueryResult)
 
@@ -619,6 +652,107 @@ func (s CropReadQuerySqlite) CountTotalBatch(farmUID uuid.UUID) <-chan query.Que
 	return result
 }
 
+// FindAllCropsByInventory returns all the crop batches, including the archived ones,
+// that are planted from the inventory
+func (s CropReadQuerySqlite) FindAllCropsByInventory(inventoryUID uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		cropUIDs := []uuid.UUID{}
+
+		rows, err := s.DB.Query(`SELECT UID FROM CROP_READ WHERE INVENTORY_UID = ?
+			ORDER BY INITIAL_AREA_CREATED_DATE DESC`, inventoryUID)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		for rows.Next() {
+			uid := ""
+			err := rows.Scan(&uid)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			cropUID, err := uuid.FromString(uid)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			cropUIDs = append(cropUIDs, cropUID)
+		}
+
+		cropReads := []storage.CropRead{}
+		for _, v := range cropUIDs {
+			queryResult := <-s.FindByID(v)
+			if queryResult.Error != nil {
+				result <- query.QueryResult{Error: queryResult.Error}
+			}
+
+			cropRead, ok := queryResult.Result.(storage.CropRead)
+			if ok {
+				cropReads = append(cropReads, cropRead)
+			}
+		}
+
+		result <- query.QueryResult{Result: cropReads}
+		close(result)
+	}()
+
+	return result
+}
+
+// FindAllCropsByFarmWithArchives returns every crop batch of the farm, the archived ones included, without pagination
+func (s CropReadQuerySqlite) FindAllCropsByFarmWithArchives(farmUID uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		cropUIDs := []uuid.UUID{}
+
+		rows, err := s.DB.Query(`SELECT UID FROM CROP_READ WHERE FARM_UID = ?
+			ORDER BY INITIAL_AREA_CREATED_DATE DESC`, farmUID)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		for rows.Next() {
+			uid := ""
+			err := rows.Scan(&uid)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			cropUID, err := uuid.FromString(uid)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			cropUIDs = append(cropUIDs, cropUID)
+		}
+
+		cropReads := []storage.CropRead{}
+		for _, v := range cropUIDs {
+			queryResult := <-s.FindByID(v)
+			if queryResult.Error != nil {
+				result <- query.QueryResult{Error: queryResult.Error}
+			}
+
+			cropRead, ok := queryResult.Result.(storage.CropRead)
+			if ok {
+				cropReads = append(cropReads, cropRead)
+			}
+		}
+
+		result <- query.QueryResult{Result: cropReads}
+		close(result)
+	}()
+
+	return result
+}
+
 func (s CropReadQuerySqlite) populateCrop(cropUID uuid.UUID, cropRead *storage.CropRead) error {
 	rowsData := cropReadResult{}
 
@@ -976,7 +1110,19 @@ func (s CropReadQuerySqlite) populateCropTrash(uid uuid.UUID, cropRead *storage.
 			&trashRowsData.SourceAreaUID,
 			&trashRowsData.SourceAreaName,
 			&trashRowsData.CreatedDate,
-			&trashRowsData.LastUpdated)
+			&trashRowsData.LastUpdated,
+			&trashRowsData.Reasons)
+		if err != nil {
+			return err
+		}
+
+		var reasons map[string]int
+		if len(trashRowsData.Reasons) > 0 {
+			err = json.Unmarshal(trashRowsData.Reasons, &reasons)
+			if err != nil {
+				return err
+			}
+		}
 
 		sourceAreaUID, err := uuid.FromString(trashRowsData.SourceAreaUID)
 		if err != nil {
@@ -999,6 +1145,7 @@ func (s CropReadQuerySqlite) populateCropTrash(uid uuid.UUID, cropRead *storage.
 			SourceAreaName: trashRowsData.SourceAreaName,
 			CreatedDate:    createdDate,
 			LastUpdated:    lastUpdated,
+			Reasons:        reasons,
 		})
 	}
 
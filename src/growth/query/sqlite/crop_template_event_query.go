@@ -0,0 +1,81 @@
+package sqlite
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/growth/decoder"
+	"github.com/Tanibox/tania-core/src/growth/query"
+	"github.com/Tanibox/tania-core/src/growth/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type CropTemplateEventQuerySqlite struct {
+	DB *sql.DB
+}
+
+func NewCropTemplateEventQuerySqlite(db *sql.DB) query.CropTemplateEventQuery {
+	return &CropTemplateEventQuerySqlite{DB: db}
+}
+
+func (f *CropTemplateEventQuerySqlite) FindAllByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		result <- f.findAll(uid)
+		close(result)
+	}()
+
+	return result
+}
+
+func (f *CropTemplateEventQuerySqlite) findAll(uid uuid.UUID) query.QueryResult {
+	events := []storage.CropTemplateEvent{}
+
+	rows, err := f.DB.Query(`SELECT * FROM CROP_TEMPLATE_EVENT WHERE CROP_TEMPLATE_UID = ? ORDER BY VERSION ASC`, uid)
+	if err != nil {
+		return query.QueryResult{Error: err}
+	}
+	defer rows.Close()
+
+	rowsData := struct {
+		ID              int
+		CropTemplateUID string
+		Version         int
+		CreatedDate     string
+		Event           []byte
+	}{}
+
+	for rows.Next() {
+		err = rows.Scan(&rowsData.ID, &rowsData.CropTemplateUID, &rowsData.Version, &rowsData.CreatedDate, &rowsData.Event)
+		if err != nil {
+			return query.QueryResult{Error: err}
+		}
+
+		wrapper := decoder.CropTemplateEventWrapper{}
+		err = json.Unmarshal(rowsData.Event, &wrapper)
+		if err != nil {
+			return query.QueryResult{Error: err}
+		}
+
+		cropTemplateUID, err := uuid.FromString(rowsData.CropTemplateUID)
+		if err != nil {
+			return query.QueryResult{Error: err}
+		}
+
+		createdDate, err := time.Parse(time.RFC3339, rowsData.CreatedDate)
+		if err != nil {
+			return query.QueryResult{Error: err}
+		}
+
+		events = append(events, storage.CropTemplateEvent{
+			CropTemplateUID: cropTemplateUID,
+			Version:         rowsData.Version,
+			CreatedDate:     createdDate,
+			Event:           wrapper.Data,
+		})
+	}
+
+	return query.QueryResult{Result: events}
+}
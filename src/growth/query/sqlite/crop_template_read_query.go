@@ -0,0 +1,133 @@
+package sqlite
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/growth/query"
+	"github.com/Tanibox/tania-core/src/growth/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type CropTemplateReadQuerySqlite struct {
+	DB *sql.DB
+}
+
+func NewCropTemplateReadQuerySqlite(db *sql.DB) query.CropTemplateReadQuery {
+	return CropTemplateReadQuerySqlite{DB: db}
+}
+
+type cropTemplateReadResult struct {
+	UID           string
+	Name          string
+	InventoryUID  string
+	InventoryName string
+	Tasks         []byte
+	CreatedDate   string
+}
+
+// FindAll returns the crop templates sorted by name.
+// If inventoryUID is not nil, only the crop templates of that inventory are returned.
+func (s CropTemplateReadQuerySqlite) FindAll(inventoryUID *uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		sql := `SELECT * FROM CROP_TEMPLATE_READ`
+		params := []interface{}{}
+
+		if inventoryUID != nil {
+			sql += ` WHERE INVENTORY_UID = ?`
+			params = append(params, *inventoryUID)
+		}
+
+		sql += ` ORDER BY NAME ASC`
+
+		result <- s.findAll(sql, params...)
+		close(result)
+	}()
+
+	return result
+}
+
+func (s CropTemplateReadQuerySqlite) FindByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		queryResult := s.findAll(`SELECT * FROM CROP_TEMPLATE_READ WHERE UID = ?`, uid)
+		if queryResult.Error != nil {
+			result <- queryResult
+			close(result)
+			return
+		}
+
+		cropTemplate := storage.CropTemplateRead{}
+		if cropTemplates := queryResult.Result.([]storage.CropTemplateRead); len(cropTemplates) > 0 {
+			cropTemplate = cropTemplates[0]
+		}
+
+		result <- query.QueryResult{Result: cropTemplate}
+		close(result)
+	}()
+
+	return result
+}
+
+func (s CropTemplateReadQuerySqlite) findAll(sql string, params ...interface{}) query.QueryResult {
+	cropTemplates := []storage.CropTemplateRead{}
+	rowsData := cropTemplateReadResult{}
+
+	rows, err := s.DB.Query(sql, params...)
+	if err != nil {
+		return query.QueryResult{Error: err}
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		err = rows.Scan(
+			&rowsData.UID,
+			&rowsData.Name,
+			&rowsData.InventoryUID,
+			&rowsData.InventoryName,
+			&rowsData.Tasks,
+			&rowsData.CreatedDate,
+		)
+		if err != nil {
+			return query.QueryResult{Error: err}
+		}
+
+		uid, err := uuid.FromString(rowsData.UID)
+		if err != nil {
+			return query.QueryResult{Error: err}
+		}
+
+		inventoryUID, err := uuid.FromString(rowsData.InventoryUID)
+		if err != nil {
+			return query.QueryResult{Error: err}
+		}
+
+		createdDate, err := time.Parse(time.RFC3339, rowsData.CreatedDate)
+		if err != nil {
+			return query.QueryResult{Error: err}
+		}
+
+		cropTemplate := storage.CropTemplateRead{
+			UID:  uid,
+			Name: rowsData.Name,
+			Inventory: storage.CropTemplateInventory{
+				UID:  inventoryUID,
+				Name: rowsData.InventoryName,
+			},
+			CreatedDate: createdDate,
+		}
+
+		err = json.Unmarshal(rowsData.Tasks, &cropTemplate.Tasks)
+		if err != nil {
+			return query.QueryResult{Error: err}
+		}
+
+		cropTemplates = append(cropTemplates, cropTemplate)
+	}
+
+	return query.QueryResult{Result: cropTemplates}
+}
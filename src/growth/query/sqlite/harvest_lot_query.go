@@ -0,0 +1,176 @@
+package sqlite
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/growth/domain"
+	"github.com/Tanibox/tania-core/src/growth/query"
+	"github.com/Tanibox/tania-core/src/growth/storage"
+	"github.com/Tanibox/tania-core/src/helper/datetimehelper"
+	uuid "github.com/satori/go.uuid"
+)
+
+type HarvestLotQuerySqlite struct {
+	DB *sql.DB
+}
+
+func NewHarvestLotQuerySqlite(db *sql.DB) query.HarvestLotQuery {
+	return HarvestLotQuerySqlite{DB: db}
+}
+
+type harvestLotResult struct {
+	UID                  string
+	LotNumber            string
+	CropUID              string
+	BatchID              string
+	FarmUID              string
+	SourceAreaUID        string
+	SourceAreaName       string
+	Grade                string
+	ProducedGramQuantity float32
+	ProducedQuantity     sql.NullFloat64
+	ProducedUnit         sql.NullString
+	HarvestDate          string
+}
+
+// FindAllByFarm returns the harvest lots of the farm, the most recent first.
+// If harvestDate is not nil, only the lots harvested on that day are returned.
+func (s HarvestLotQuerySqlite) FindAllByFarm(farmUID uuid.UUID, harvestDate *time.Time) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		sql := `SELECT * FROM HARVEST_LOT_READ WHERE FARM_UID = ?`
+		params := []interface{}{farmUID}
+
+		if harvestDate != nil {
+			start, end := datetimehelper.DayRange(*harvestDate)
+
+			sql += ` AND HARVEST_DATE >= ? AND HARVEST_DATE < ?`
+			params = append(params, start.Format(time.RFC3339), end.Format(time.RFC3339))
+		}
+
+		sql += ` ORDER BY HARVEST_DATE DESC`
+
+		result <- s.findAll(sql, params...)
+		close(result)
+	}()
+
+	return result
+}
+
+// FindAllByCrop returns the harvest lots of the crop batch, the most recent first
+func (s HarvestLotQuerySqlite) FindAllByCrop(cropUID uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		result <- s.findAll(`SELECT * FROM HARVEST_LOT_READ WHERE CROP_UID = ? ORDER BY HARVEST_DATE DESC`, cropUID)
+		close(result)
+	}()
+
+	return result
+}
+
+func (s HarvestLotQuerySqlite) FindByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		queryResult := s.findAll(`SELECT * FROM HARVEST_LOT_READ WHERE UID = ?`, uid)
+		if queryResult.Error != nil {
+			result <- queryResult
+			close(result)
+			return
+		}
+
+		harvestLot := storage.HarvestLot{}
+		if harvestLots := queryResult.Result.([]storage.HarvestLot); len(harvestLots) > 0 {
+			harvestLot = harvestLots[0]
+		}
+
+		result <- query.QueryResult{Result: harvestLot}
+		close(result)
+	}()
+
+	return result
+}
+
+func (s HarvestLotQuerySqlite) findAll(sql string, params ...interface{}) query.QueryResult {
+	harvestLots := []storage.HarvestLot{}
+	rowsData := harvestLotResult{}
+
+	rows, err := s.DB.Query(sql, params...)
+	if err != nil {
+		return query.QueryResult{Error: err}
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		err = rows.Scan(
+			&rowsData.UID,
+			&rowsData.LotNumber,
+			&rowsData.CropUID,
+			&rowsData.BatchID,
+			&rowsData.FarmUID,
+			&rowsData.SourceAreaUID,
+			&rowsData.SourceAreaName,
+			&rowsData.Grade,
+			&rowsData.ProducedGramQuantity,
+			&rowsData.HarvestDate,
+			&rowsData.ProducedQuantity,
+			&rowsData.ProducedUnit,
+		)
+		if err != nil {
+			return query.QueryResult{Error: err}
+		}
+
+		uid, err := uuid.FromString(rowsData.UID)
+		if err != nil {
+			return query.QueryResult{Error: err}
+		}
+
+		cropUID, err := uuid.FromString(rowsData.CropUID)
+		if err != nil {
+			return query.QueryResult{Error: err}
+		}
+
+		farmUID, err := uuid.FromString(rowsData.FarmUID)
+		if err != nil {
+			return query.QueryResult{Error: err}
+		}
+
+		sourceAreaUID, err := uuid.FromString(rowsData.SourceAreaUID)
+		if err != nil {
+			return query.QueryResult{Error: err}
+		}
+
+		harvestDate, err := time.Parse(time.RFC3339, rowsData.HarvestDate)
+		if err != nil {
+			return query.QueryResult{Error: err}
+		}
+
+		// The lots harvested before the harvest units were added are in gram
+		producedQuantity := rowsData.ProducedGramQuantity
+		producedUnit := domain.Gr
+		if rowsData.ProducedUnit.Valid && rowsData.ProducedUnit.String != "" {
+			producedQuantity = float32(rowsData.ProducedQuantity.Float64)
+			producedUnit = rowsData.ProducedUnit.String
+		}
+
+		harvestLots = append(harvestLots, storage.HarvestLot{
+			UID:                  uid,
+			LotNumber:            rowsData.LotNumber,
+			CropUID:              cropUID,
+			BatchID:              rowsData.BatchID,
+			FarmUID:              farmUID,
+			SourceAreaUID:        sourceAreaUID,
+			SourceAreaName:       rowsData.SourceAreaName,
+			Grade:                rowsData.Grade,
+			ProducedQuantity:     producedQuantity,
+			ProducedUnit:         producedUnit,
+			ProducedGramQuantity: rowsData.ProducedGramQuantity,
+			HarvestDate:          harvestDate,
+		})
+	}
+
+	return query.QueryResult{Result: harvestLots}
+}
@@ -16,10 +16,13 @@ func NewMaterialReadQuerySqlite(db *sql.DB) query.MaterialReadQuery {
 }
 
 type materialReadResult struct {
-	UID      string
-	Name     string
-	Type     string
-	TypeData string
+	UID          string
+	Name         string
+	Type         string
+	TypeData     string
+	Quantity     float32
+	QuantityUnit string
+	PlantFamily  sql.NullString
 }
 
 func (s MaterialReadQuerySqlite) FindByID(materialUID uuid.UUID) <-chan query.QueryResult {
@@ -29,12 +32,15 @@ func (s MaterialReadQuerySqlite) FindByID(materialUID uuid.UUID) <-chan query.Qu
 		materialQueryResult := query.CropMaterialQueryResult{}
 		rowsData := materialReadResult{}
 
-		err := s.DB.QueryRow(`SELECT UID, NAME, TYPE, TYPE_DATA FROM MATERIAL_READ
+		err := s.DB.QueryRow(`SELECT UID, NAME, TYPE, TYPE_DATA, QUANTITY, QUANTITY_UNIT, PLANT_FAMILY FROM MATERIAL_READ
 			WHERE UID = ?`, materialUID).Scan(
 			&rowsData.UID,
 			&rowsData.Name,
 			&rowsData.Type,
 			&rowsData.TypeData,
+			&rowsData.Quantity,
+			&rowsData.QuantityUnit,
+			&rowsData.PlantFamily,
 		)
 
 		if err != nil && err != sql.ErrNoRows {
@@ -54,6 +60,9 @@ func (s MaterialReadQuerySqlite) FindByID(materialUID uuid.UUID) <-chan query.Qu
 		materialQueryResult.Name = rowsData.Name
 		materialQueryResult.TypeCode = rowsData.Type
 		materialQueryResult.PlantTypeCode = rowsData.TypeData
+		materialQueryResult.Quantity = rowsData.Quantity
+		materialQueryResult.QuantityUnit = rowsData.QuantityUnit
+		materialQueryResult.PlantFamily = rowsData.PlantFamily.String
 
 		result <- query.QueryResult{Result: materialQueryResult}
 		close(result)
@@ -69,12 +78,15 @@ func (q MaterialReadQuerySqlite) FindMaterialByPlantTypeCodeAndName(plantTypeCod
 		materialQueryResult := query.CropMaterialQueryResult{}
 		rowsData := materialReadResult{}
 
-		err := q.DB.QueryRow(`SELECT UID, NAME, TYPE, TYPE_DATA FROM MATERIAL_READ
+		err := q.DB.QueryRow(`SELECT UID, NAME, TYPE, TYPE_DATA, QUANTITY, QUANTITY_UNIT, PLANT_FAMILY FROM MATERIAL_READ
 			WHERE TYPE_DATA = ? AND NAME = ?`, plantTypeCode, name).Scan(
 			&rowsData.UID,
 			&rowsData.Name,
 			&rowsData.Type,
 			&rowsData.TypeData,
+			&rowsData.Quantity,
+			&rowsData.QuantityUnit,
+			&rowsData.PlantFamily,
 		)
 
 		if err != nil && err != sql.ErrNoRows {
@@ -94,6 +106,9 @@ func (q MaterialReadQuerySqlite) FindMaterialByPlantTypeCodeAndName(plantTypeCod
 		materialQueryResult.Name = rowsData.Name
 		materialQueryResult.TypeCode = rowsData.Type
 		materialQueryResult.PlantTypeCode = rowsData.TypeData
+		materialQueryResult.Quantity = rowsData.Quantity
+		materialQueryResult.QuantityUnit = rowsData.QuantityUnit
+		materialQueryResult.PlantFamily = rowsData.PlantFamily.String
 
 		result <- query.QueryResult{Result: materialQueryResult}
 		close(result)
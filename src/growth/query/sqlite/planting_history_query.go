@@ -0,0 +1,94 @@
+package sqlite
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/growth/query"
+	"github.com/Tanibox/tania-core/src/growth/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type PlantingHistoryQuerySqlite struct {
+	DB *sql.DB
+}
+
+func NewPlantingHistoryQuerySqlite(db *sql.DB) query.PlantingHistoryQuery {
+	return PlantingHistoryQuerySqlite{DB: db}
+}
+
+type plantingHistoryResult struct {
+	AreaUID      string
+	CropUID      string
+	BatchID      string
+	InventoryUID string
+	VarietyName  string
+	PlantFamily  string
+	PlantedDate  string
+}
+
+// FindAllByArea returns the planting history of the area, the most recent first
+func (s PlantingHistoryQuerySqlite) FindAllByArea(areaUID uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		histories := []storage.PlantingHistory{}
+		rowsData := plantingHistoryResult{}
+
+		rows, err := s.DB.Query(`SELECT AREA_UID, CROP_UID, BATCH_ID, INVENTORY_UID, VARIETY_NAME, PLANT_FAMILY, PLANTED_DATE
+			FROM PLANTING_HISTORY_READ WHERE AREA_UID = ? ORDER BY PLANTED_DATE DESC`, areaUID)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+		}
+
+		for rows.Next() {
+			err = rows.Scan(
+				&rowsData.AreaUID,
+				&rowsData.CropUID,
+				&rowsData.BatchID,
+				&rowsData.InventoryUID,
+				&rowsData.VarietyName,
+				&rowsData.PlantFamily,
+				&rowsData.PlantedDate,
+			)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			areaUID, err := uuid.FromString(rowsData.AreaUID)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			cropUID, err := uuid.FromString(rowsData.CropUID)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			inventoryUID, err := uuid.FromString(rowsData.InventoryUID)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			plantedDate, err := time.Parse(time.RFC3339, rowsData.PlantedDate)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			histories = append(histories, storage.PlantingHistory{
+				AreaUID:      areaUID,
+				CropUID:      cropUID,
+				BatchID:      rowsData.BatchID,
+				InventoryUID: inventoryUID,
+				VarietyName:  rowsData.VarietyName,
+				PlantFamily:  rowsData.PlantFamily,
+				PlantedDate:  plantedDate,
+			})
+		}
+
+		result <- query.QueryResult{Result: histories}
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,81 @@
+package sqlite
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/growth/decoder"
+	"github.com/Tanibox/tania-core/src/growth/query"
+	"github.com/Tanibox/tania-core/src/growth/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type SuccessionPlanEventQuerySqlite struct {
+	DB *sql.DB
+}
+
+func NewSuccessionPlanEventQuerySqlite(db *sql.DB) query.SuccessionPlanEventQuery {
+	return &SuccessionPlanEventQuerySqlite{DB: db}
+}
+
+func (f *SuccessionPlanEventQuerySqlite) FindAllByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		result <- f.findAll(uid)
+		close(result)
+	}()
+
+	return result
+}
+
+func (f *SuccessionPlanEventQuerySqlite) findAll(uid uuid.UUID) query.QueryResult {
+	events := []storage.SuccessionPlanEvent{}
+
+	rows, err := f.DB.Query(`SELECT * FROM SUCCESSION_PLAN_EVENT WHERE SUCCESSION_PLAN_UID = ? ORDER BY VERSION ASC`, uid)
+	if err != nil {
+		return query.QueryResult{Error: err}
+	}
+	defer rows.Close()
+
+	rowsData := struct {
+		ID                int
+		SuccessionPlanUID string
+		Version           int
+		CreatedDate       string
+		Event             []byte
+	}{}
+
+	for rows.Next() {
+		err = rows.Scan(&rowsData.ID, &rowsData.SuccessionPlanUID, &rowsData.Version, &rowsData.CreatedDate, &rowsData.Event)
+		if err != nil {
+			return query.QueryResult{Error: err}
+		}
+
+		wrapper := decoder.SuccessionPlanEventWrapper{}
+		err = json.Unmarshal(rowsData.Event, &wrapper)
+		if err != nil {
+			return query.QueryResult{Error: err}
+		}
+
+		successionPlanUID, err := uuid.FromString(rowsData.SuccessionPlanUID)
+		if err != nil {
+			return query.QueryResult{Error: err}
+		}
+
+		createdDate, err := time.Parse(time.RFC3339, rowsData.CreatedDate)
+		if err != nil {
+			return query.QueryResult{Error: err}
+		}
+
+		events = append(events, storage.SuccessionPlanEvent{
+			SuccessionPlanUID: successionPlanUID,
+			Version:           rowsData.Version,
+			CreatedDate:       createdDate,
+			Event:             wrapper.Data,
+		})
+	}
+
+	return query.QueryResult{Result: events}
+}
@@ -0,0 +1,40 @@
+package inmemory
+
+import (
+	"github.com/Tanibox/tania-core/src/growth/repository"
+	"github.com/Tanibox/tania-core/src/growth/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type CropTemplateEventRepositoryInMemory struct {
+	Storage *storage.CropTemplateEventStorage
+}
+
+func NewCropTemplateEventRepositoryInMemory(s *storage.CropTemplateEventStorage) repository.CropTemplateEventRepository {
+	return &CropTemplateEventRepositoryInMemory{Storage: s}
+}
+
+// Save is to save
+func (f *CropTemplateEventRepositoryInMemory) Save(uid uuid.UUID, latestVersion int, events []interface{}) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		f.Storage.Lock.Lock()
+		defer f.Storage.Lock.Unlock()
+
+		for _, v := range events {
+			latestVersion++
+			f.Storage.CropTemplateEvents = append(f.Storage.CropTemplateEvents, storage.CropTemplateEvent{
+				CropTemplateUID: uid,
+				Version:         latestVersion,
+				Event:           v,
+			})
+		}
+
+		result <- nil
+
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,50 @@
+package inmemory
+
+import (
+	"github.com/Tanibox/tania-core/src/growth/repository"
+	"github.com/Tanibox/tania-core/src/growth/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type CropTemplateReadRepositoryInMemory struct {
+	Storage *storage.CropTemplateReadStorage
+}
+
+func NewCropTemplateReadRepositoryInMemory(s *storage.CropTemplateReadStorage) repository.CropTemplateReadRepository {
+	return &CropTemplateReadRepositoryInMemory{Storage: s}
+}
+
+// Save is to save
+func (f *CropTemplateReadRepositoryInMemory) Save(cropTemplateRead *storage.CropTemplateRead) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		f.Storage.Lock.Lock()
+		defer f.Storage.Lock.Unlock()
+
+		f.Storage.CropTemplateReadMap[cropTemplateRead.UID] = *cropTemplateRead
+
+		result <- nil
+
+		close(result)
+	}()
+
+	return result
+}
+
+func (f *CropTemplateReadRepositoryInMemory) Remove(uid uuid.UUID) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		f.Storage.Lock.Lock()
+		defer f.Storage.Lock.Unlock()
+
+		delete(f.Storage.CropTemplateReadMap, uid)
+
+		result <- nil
+
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,50 @@
+package inmemory
+
+import (
+	"github.com/Tanibox/tania-core/src/growth/repository"
+	"github.com/Tanibox/tania-core/src/growth/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type HarvestLotRepositoryInMemory struct {
+	Storage *storage.HarvestLotStorage
+}
+
+func NewHarvestLotRepositoryInMemory(s *storage.HarvestLotStorage) repository.HarvestLotRepository {
+	return &HarvestLotRepositoryInMemory{Storage: s}
+}
+
+// Save is to save
+func (f *HarvestLotRepositoryInMemory) Save(harvestLot *storage.HarvestLot) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		f.Storage.Lock.Lock()
+		defer f.Storage.Lock.Unlock()
+
+		f.Storage.HarvestLotMap[harvestLot.UID] = *harvestLot
+
+		result <- nil
+
+		close(result)
+	}()
+
+	return result
+}
+
+func (f *HarvestLotRepositoryInMemory) Remove(uid uuid.UUID) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		f.Storage.Lock.Lock()
+		defer f.Storage.Lock.Unlock()
+
+		delete(f.Storage.HarvestLotMap, uid)
+
+		result <- nil
+
+		close(result)
+	}()
+
+	return result
+}
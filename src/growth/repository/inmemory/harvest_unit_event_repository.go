@@ -0,0 +1,40 @@
+package inmemory
+
+import (
+	"github.com/Tanibox/tania-core/src/growth/repository"
+	"github.com/Tanibox/tania-core/src/growth/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type HarvestUnitEventRepositoryInMemory struct {
+	Storage *storage.HarvestUnitEventStorage
+}
+
+func NewHarvestUnitEventRepositoryInMemory(s *storage.HarvestUnitEventStorage) repository.HarvestUnitEventRepository {
+	return &HarvestUnitEventRepositoryInMemory{Storage: s}
+}
+
+// Save is to save
+func (f *HarvestUnitEventRepositoryInMemory) Save(uid uuid.UUID, latestVersion int, events []interface{}) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		f.Storage.Lock.Lock()
+		defer f.Storage.Lock.Unlock()
+
+		for _, v := range events {
+			latestVersion++
+			f.Storage.HarvestUnitEvents = append(f.Storage.HarvestUnitEvents, storage.HarvestUnitEvent{
+				HarvestUnitUID: uid,
+				Version:        latestVersion,
+				Event:          v,
+			})
+		}
+
+		result <- nil
+
+		close(result)
+	}()
+
+	return result
+}
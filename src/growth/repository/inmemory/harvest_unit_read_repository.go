@@ -0,0 +1,50 @@
+package inmemory
+
+import (
+	"github.com/Tanibox/tania-core/src/growth/repository"
+	"github.com/Tanibox/tania-core/src/growth/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type HarvestUnitReadRepositoryInMemory struct {
+	Storage *storage.HarvestUnitReadStorage
+}
+
+func NewHarvestUnitReadRepositoryInMemory(s *storage.HarvestUnitReadStorage) repository.HarvestUnitReadRepository {
+	return &HarvestUnitReadRepositoryInMemory{Storage: s}
+}
+
+// Save is to save
+func (f *HarvestUnitReadRepositoryInMemory) Save(harvestUnitRead *storage.HarvestUnitRead) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		f.Storage.Lock.Lock()
+		defer f.Storage.Lock.Unlock()
+
+		f.Storage.HarvestUnitReadMap[harvestUnitRead.UID] = *harvestUnitRead
+
+		result <- nil
+
+		close(result)
+	}()
+
+	return result
+}
+
+func (f *HarvestUnitReadRepositoryInMemory) Remove(uid uuid.UUID) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		f.Storage.Lock.Lock()
+		defer f.Storage.Lock.Unlock()
+
+		delete(f.Storage.HarvestUnitReadMap, uid)
+
+		result <- nil
+
+		close(result)
+	}()
+
+	return result
+}
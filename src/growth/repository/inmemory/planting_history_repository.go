@@ -0,0 +1,42 @@
+package inmemory
+
+import (
+	"github.com/Tanibox/tania-core/src/growth/repository"
+	"github.com/Tanibox/tania-core/src/growth/storage"
+)
+
+type PlantingHistoryRepositoryInMemory struct {
+	Storage *storage.PlantingHistoryStorage
+}
+
+func NewPlantingHistoryRepositoryInMemory(s *storage.PlantingHistoryStorage) repository.PlantingHistoryRepository {
+	return &PlantingHistoryRepositoryInMemory{Storage: s}
+}
+
+// Save is to save.
+// A crop batch is only recorded once per area, even if it is moved back to the same area.
+func (f *PlantingHistoryRepositoryInMemory) Save(plantingHistory *storage.PlantingHistory) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		f.Storage.Lock.Lock()
+		defer f.Storage.Lock.Unlock()
+
+		for _, v := range f.Storage.PlantingHistoryMap {
+			if v.AreaUID == plantingHistory.AreaUID && v.CropUID == plantingHistory.CropUID {
+				result <- nil
+
+				close(result)
+				return
+			}
+		}
+
+		f.Storage.PlantingHistoryMap = append(f.Storage.PlantingHistoryMap, *plantingHistory)
+
+		result <- nil
+
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,40 @@
+package inmemory
+
+import (
+	"github.com/Tanibox/tania-core/src/growth/repository"
+	"github.com/Tanibox/tania-core/src/growth/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type SuccessionPlanEventRepositoryInMemory struct {
+	Storage *storage.SuccessionPlanEventStorage
+}
+
+func NewSuccessionPlanEventRepositoryInMemory(s *storage.SuccessionPlanEventStorage) repository.SuccessionPlanEventRepository {
+	return &SuccessionPlanEventRepositoryInMemory{Storage: s}
+}
+
+// Save is to save
+func (f *SuccessionPlanEventRepositoryInMemory) Save(uid uuid.UUID, latestVersion int, events []interface{}) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		f.Storage.Lock.Lock()
+		defer f.Storage.Lock.Unlock()
+
+		for _, v := range events {
+			latestVersion++
+			f.Storage.SuccessionPlanEvents = append(f.Storage.SuccessionPlanEvents, storage.SuccessionPlanEvent{
+				SuccessionPlanUID: uid,
+				Version:           latestVersion,
+				Event:             v,
+			})
+		}
+
+		result <- nil
+
+		close(result)
+	}()
+
+	return result
+}
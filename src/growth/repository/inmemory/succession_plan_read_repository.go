@@ -0,0 +1,50 @@
+package inmemory
+
+import (
+	"github.com/Tanibox/tania-core/src/growth/repository"
+	"github.com/Tanibox/tania-core/src/growth/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type SuccessionPlanReadRepositoryInMemory struct {
+	Storage *storage.SuccessionPlanReadStorage
+}
+
+func NewSuccessionPlanReadRepositoryInMemory(s *storage.SuccessionPlanReadStorage) repository.SuccessionPlanReadRepository {
+	return &SuccessionPlanReadRepositoryInMemory{Storage: s}
+}
+
+// Save is to save
+func (f *SuccessionPlanReadRepositoryInMemory) Save(successionPlanRead *storage.SuccessionPlanRead) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		f.Storage.Lock.Lock()
+		defer f.Storage.Lock.Unlock()
+
+		f.Storage.SuccessionPlanReadMap[successionPlanRead.UID] = *successionPlanRead
+
+		result <- nil
+
+		close(result)
+	}()
+
+	return result
+}
+
+func (f *SuccessionPlanReadRepositoryInMemory) Remove(uid uuid.UUID) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		f.Storage.Lock.Lock()
+		defer f.Storage.Lock.Unlock()
+
+		delete(f.Storage.SuccessionPlanReadMap, uid)
+
+		result <- nil
+
+		close(result)
+	}()
+
+	return result
+}
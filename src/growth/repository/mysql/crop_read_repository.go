@@ -2,6 +2,7 @@ package sqlite
 
 import (
 	"database/sql"
+	"encoding/json"
 
 	"github.com/Tanibox/tania-core/src/growth/repository"
 	"github.com/Tanibox/tania-core/src/growth/storage"
@@ -68,6 +69,12 @@ func (f *CropReadRepositoryMysql) Save(cropRead *storage.CropRead) <-chan error
 				result <- err
 			}
 
+			// Photos can be removed, so clear them first and let the upsert below insert them again.
+			_, err = f.DB.Exec(`DELETE FROM CROP_READ_PHOTO WHERE CROP_UID = ?`, cropRead.UID.Bytes())
+			if err != nil {
+				result <- err
+			}
+
 			if len(cropRead.Photos) > 0 {
 				for _, v := range cropRead.Photos {
 					res, err := f.DB.Exec(`UPDATE CROP_READ_PHOTO
@@ -175,11 +182,16 @@ func (f *CropReadRepositoryMysql) Save(cropRead *storage.CropRead) <-chan error
 
 			if len(cropRead.Trash) > 0 {
 				for _, v := range cropRead.Trash {
+					reasons, err := json.Marshal(v.Reasons)
+					if err != nil {
+						result <- err
+					}
+
 					res, err := f.DB.Exec(`UPDATE CROP_READ_TRASH
 						SET QUANTITY = ?, SOURCE_AREA_NAME = ?,
-						CREATED_DATE = ?, LAST_UPDATED = ?
+						CREATED_DATE = ?, LAST_UPDATED = ?, REASONS = ?
 						WHERE CROP_UID = ? AND SOURCE_AREA_UID = ?`,
-						v.Quantity, v.SourceAreaName, v.CreatedDate, v.LastUpdated,
+						v.Quantity, v.SourceAreaName, v.CreatedDate, v.LastUpdated, reasons,
 						cropRead.UID.Bytes(), v.SourceAreaUID.Bytes())
 
 					if err != nil {
@@ -194,10 +206,10 @@ func (f *CropReadRepositoryMysql) Save(cropRead *storage.CropRead) <-chan error
 					if rowsAffected == 0 {
 						_, err = f.DB.Exec(`INSERT INTO CROP_READ_TRASH (
 							CROP_UID, QUANTITY, SOURCE_AREA_UID, SOURCE_AREA_NAME,
-							CREATED_DATE, LAST_UPDATED)
-							VALUES (?, ?, ?, ?, ?, ?)`,
+							CREATED_DATE, LAST_UPDATED, REASONS)
+							VALUES (?, ?, ?, ?, ?, ?, ?)`,
 							cropRead.UID.Bytes(), v.Quantity,
-							v.SourceAreaUID.Bytes(), v.SourceAreaName, v.CreatedDate, v.LastUpdated)
+							v.SourceAreaUID.Bytes(), v.SourceAreaName, v.CreatedDate, v.LastUpdated, reasons)
 
 						if err != nil {
 							result <- err
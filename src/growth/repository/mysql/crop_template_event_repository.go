@@ -0,0 +1,53 @@
+package sqlite
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/growth/decoder"
+	"github.com/Tanibox/tania-core/src/growth/repository"
+	"github.com/Tanibox/tania-core/src/helper/structhelper"
+	uuid "github.com/satori/go.uuid"
+)
+
+type CropTemplateEventRepositoryMysql struct {
+	DB *sql.DB
+}
+
+func NewCropTemplateEventRepositoryMysql(db *sql.DB) repository.CropTemplateEventRepository {
+	return &CropTemplateEventRepositoryMysql{DB: db}
+}
+
+func (f *CropTemplateEventRepositoryMysql) Save(uid uuid.UUID, latestVersion int, events []interface{}) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		for _, v := range events {
+			latestVersion++
+
+			e, err := json.Marshal(decoder.InterfaceWrapper{
+				Name: structhelper.GetName(v),
+				Data: v,
+			})
+			if err != nil {
+				result <- err
+				close(result)
+				return
+			}
+
+			_, err = f.DB.Exec(`INSERT INTO CROP_TEMPLATE_EVENT (CROP_TEMPLATE_UID, VERSION, CREATED_DATE, EVENT) VALUES (?, ?, ?, ?)`,
+				uid.Bytes(), latestVersion, time.Now(), e)
+			if err != nil {
+				result <- err
+				close(result)
+				return
+			}
+		}
+
+		result <- nil
+		close(result)
+	}()
+
+	return result
+}
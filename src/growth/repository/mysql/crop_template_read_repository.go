@@ -0,0 +1,79 @@
+package sqlite
+
+import (
+	"database/sql"
+	"encoding/json"
+
+	"github.com/Tanibox/tania-core/src/growth/repository"
+	"github.com/Tanibox/tania-core/src/growth/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type CropTemplateReadRepositoryMysql struct {
+	DB *sql.DB
+}
+
+func NewCropTemplateReadRepositoryMysql(db *sql.DB) repository.CropTemplateReadRepository {
+	return &CropTemplateReadRepositoryMysql{DB: db}
+}
+
+func (f *CropTemplateReadRepositoryMysql) Save(cropTemplateRead *storage.CropTemplateRead) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		tasks, err := json.Marshal(cropTemplateRead.Tasks)
+		if err != nil {
+			result <- err
+			close(result)
+			return
+		}
+
+		count := 0
+		err = f.DB.QueryRow(`SELECT COUNT(*) FROM CROP_TEMPLATE_READ WHERE UID = ?`, cropTemplateRead.UID.Bytes()).Scan(&count)
+		if err != nil {
+			result <- err
+			close(result)
+			return
+		}
+
+		if count > 0 {
+			_, err = f.DB.Exec(`UPDATE CROP_TEMPLATE_READ SET
+				NAME = ?, INVENTORY_UID = ?, INVENTORY_NAME = ?, TASKS = ?, CREATED_DATE = ?
+				WHERE UID = ?`,
+				cropTemplateRead.Name,
+				cropTemplateRead.Inventory.UID.Bytes(),
+				cropTemplateRead.Inventory.Name,
+				string(tasks),
+				cropTemplateRead.CreatedDate,
+				cropTemplateRead.UID.Bytes())
+		} else {
+			_, err = f.DB.Exec(`INSERT INTO CROP_TEMPLATE_READ
+				(UID, NAME, INVENTORY_UID, INVENTORY_NAME, TASKS, CREATED_DATE)
+				VALUES (?, ?, ?, ?, ?, ?)`,
+				cropTemplateRead.UID.Bytes(),
+				cropTemplateRead.Name,
+				cropTemplateRead.Inventory.UID.Bytes(),
+				cropTemplateRead.Inventory.Name,
+				string(tasks),
+				cropTemplateRead.CreatedDate)
+		}
+
+		result <- err
+		close(result)
+	}()
+
+	return result
+}
+
+func (f *CropTemplateReadRepositoryMysql) Remove(uid uuid.UUID) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		_, err := f.DB.Exec(`DELETE FROM CROP_TEMPLATE_READ WHERE UID = ?`, uid.Bytes())
+
+		result <- err
+		close(result)
+	}()
+
+	return result
+}
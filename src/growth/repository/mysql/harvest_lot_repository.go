@@ -0,0 +1,85 @@
+package sqlite
+
+import (
+	"database/sql"
+
+	"github.com/Tanibox/tania-core/src/growth/repository"
+	"github.com/Tanibox/tania-core/src/growth/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type HarvestLotRepositoryMysql struct {
+	DB *sql.DB
+}
+
+func NewHarvestLotRepositoryMysql(db *sql.DB) repository.HarvestLotRepository {
+	return &HarvestLotRepositoryMysql{DB: db}
+}
+
+func (f *HarvestLotRepositoryMysql) Save(harvestLot *storage.HarvestLot) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		count := 0
+		err := f.DB.QueryRow(`SELECT COUNT(*) FROM HARVEST_LOT_READ WHERE UID = ?`, harvestLot.UID.Bytes()).Scan(&count)
+		if err != nil {
+			result <- err
+			close(result)
+			return
+		}
+
+		if count > 0 {
+			_, err = f.DB.Exec(`UPDATE HARVEST_LOT_READ SET
+				LOT_NUMBER = ?, CROP_UID = ?, BATCH_ID = ?, FARM_UID = ?, SOURCE_AREA_UID = ?, SOURCE_AREA_NAME = ?,
+				GRADE = ?, PRODUCED_GRAM_QUANTITY = ?, HARVEST_DATE = ?, PRODUCED_QUANTITY = ?, PRODUCED_UNIT = ?
+				WHERE UID = ?`,
+				harvestLot.LotNumber,
+				harvestLot.CropUID.Bytes(),
+				harvestLot.BatchID,
+				harvestLot.FarmUID.Bytes(),
+				harvestLot.SourceAreaUID.Bytes(),
+				harvestLot.SourceAreaName,
+				harvestLot.Grade,
+				harvestLot.ProducedGramQuantity,
+				harvestLot.HarvestDate,
+				harvestLot.ProducedQuantity,
+				harvestLot.ProducedUnit,
+				harvestLot.UID.Bytes())
+		} else {
+			_, err = f.DB.Exec(`INSERT INTO HARVEST_LOT_READ
+				(UID, LOT_NUMBER, CROP_UID, BATCH_ID, FARM_UID, SOURCE_AREA_UID, SOURCE_AREA_NAME,
+				GRADE, PRODUCED_GRAM_QUANTITY, HARVEST_DATE, PRODUCED_QUANTITY, PRODUCED_UNIT)
+				VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+				harvestLot.UID.Bytes(),
+				harvestLot.LotNumber,
+				harvestLot.CropUID.Bytes(),
+				harvestLot.BatchID,
+				harvestLot.FarmUID.Bytes(),
+				harvestLot.SourceAreaUID.Bytes(),
+				harvestLot.SourceAreaName,
+				harvestLot.Grade,
+				harvestLot.ProducedGramQuantity,
+				harvestLot.HarvestDate,
+				harvestLot.ProducedQuantity,
+				harvestLot.ProducedUnit)
+		}
+
+		result <- err
+		close(result)
+	}()
+
+	return result
+}
+
+func (f *HarvestLotRepositoryMysql) Remove(uid uuid.UUID) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		_, err := f.DB.Exec(`DELETE FROM HARVEST_LOT_READ WHERE UID = ?`, uid.Bytes())
+
+		result <- err
+		close(result)
+	}()
+
+	return result
+}
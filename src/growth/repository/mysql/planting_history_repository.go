@@ -0,0 +1,44 @@
+package sqlite
+
+import (
+	"database/sql"
+
+	"github.com/Tanibox/tania-core/src/growth/repository"
+	"github.com/Tanibox/tania-core/src/growth/storage"
+)
+
+type PlantingHistoryRepositoryMysql struct {
+	DB *sql.DB
+}
+
+func NewPlantingHistoryRepositoryMysql(db *sql.DB) repository.PlantingHistoryRepository {
+	return &PlantingHistoryRepositoryMysql{DB: db}
+}
+
+// Save is to save.
+// A crop batch is only recorded once per area, even if it is moved back to the same area.
+func (f *PlantingHistoryRepositoryMysql) Save(plantingHistory *storage.PlantingHistory) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		_, err := f.DB.Exec(`INSERT IGNORE INTO PLANTING_HISTORY_READ
+			(AREA_UID, CROP_UID, BATCH_ID, INVENTORY_UID, VARIETY_NAME, PLANT_FAMILY, PLANTED_DATE)
+			VALUES (?, ?, ?, ?, ?, ?, ?)`,
+			plantingHistory.AreaUID.Bytes(),
+			plantingHistory.CropUID.Bytes(),
+			plantingHistory.BatchID,
+			plantingHistory.InventoryUID.Bytes(),
+			plantingHistory.VarietyName,
+			plantingHistory.PlantFamily,
+			plantingHistory.PlantedDate)
+
+		if err != nil {
+			result <- err
+		}
+
+		result <- nil
+		close(result)
+	}()
+
+	return result
+}
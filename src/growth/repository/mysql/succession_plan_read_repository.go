@@ -0,0 +1,106 @@
+package sqlite
+
+import (
+	"database/sql"
+	"encoding/json"
+
+	"github.com/Tanibox/tania-core/src/growth/repository"
+	"github.com/Tanibox/tania-core/src/growth/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type SuccessionPlanReadRepositoryMysql struct {
+	DB *sql.DB
+}
+
+func NewSuccessionPlanReadRepositoryMysql(db *sql.DB) repository.SuccessionPlanReadRepository {
+	return &SuccessionPlanReadRepositoryMysql{DB: db}
+}
+
+func (f *SuccessionPlanReadRepositoryMysql) Save(successionPlanRead *storage.SuccessionPlanRead) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		areas, err := json.Marshal(successionPlanRead.Areas)
+		if err != nil {
+			result <- err
+			close(result)
+			return
+		}
+
+		plannedBatches, err := json.Marshal(successionPlanRead.PlannedBatches)
+		if err != nil {
+			result <- err
+			close(result)
+			return
+		}
+
+		count := 0
+		err = f.DB.QueryRow(`SELECT COUNT(*) FROM SUCCESSION_PLAN_READ WHERE UID = ?`, successionPlanRead.UID.Bytes()).Scan(&count)
+		if err != nil {
+			result <- err
+			close(result)
+			return
+		}
+
+		if count > 0 {
+			_, err = f.DB.Exec(`UPDATE SUCCESSION_PLAN_READ SET
+				NAME = ?, INVENTORY_UID = ?, INVENTORY_NAME = ?, AREAS = ?, CROP_TYPE = ?,
+				CONTAINER_TYPE = ?, CONTAINER_CELL = ?, QUANTITY = ?, INTERVAL_DAYS = ?,
+				START_DATE = ?, END_DATE = ?, PLANNED_BATCHES = ?, CREATED_DATE = ?
+				WHERE UID = ?`,
+				successionPlanRead.Name,
+				successionPlanRead.Inventory.UID.Bytes(),
+				successionPlanRead.Inventory.Name,
+				string(areas),
+				successionPlanRead.CropType,
+				successionPlanRead.ContainerType,
+				successionPlanRead.ContainerCell,
+				successionPlanRead.Quantity,
+				successionPlanRead.IntervalDays,
+				successionPlanRead.StartDate,
+				successionPlanRead.EndDate,
+				string(plannedBatches),
+				successionPlanRead.CreatedDate,
+				successionPlanRead.UID.Bytes())
+		} else {
+			_, err = f.DB.Exec(`INSERT INTO SUCCESSION_PLAN_READ
+				(UID, NAME, INVENTORY_UID, INVENTORY_NAME, AREAS, CROP_TYPE,
+				CONTAINER_TYPE, CONTAINER_CELL, QUANTITY, INTERVAL_DAYS,
+				START_DATE, END_DATE, PLANNED_BATCHES, CREATED_DATE)
+				VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+				successionPlanRead.UID.Bytes(),
+				successionPlanRead.Name,
+				successionPlanRead.Inventory.UID.Bytes(),
+				successionPlanRead.Inventory.Name,
+				string(areas),
+				successionPlanRead.CropType,
+				successionPlanRead.ContainerType,
+				successionPlanRead.ContainerCell,
+				successionPlanRead.Quantity,
+				successionPlanRead.IntervalDays,
+				successionPlanRead.StartDate,
+				successionPlanRead.EndDate,
+				string(plannedBatches),
+				successionPlanRead.CreatedDate)
+		}
+
+		result <- err
+		close(result)
+	}()
+
+	return result
+}
+
+func (f *SuccessionPlanReadRepositoryMysql) Remove(uid uuid.UUID) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		_, err := f.DB.Exec(`DELETE FROM SUCCESSION_PLAN_READ WHERE UID = ?`, uid.Bytes())
+
+		result <- err
+		close(result)
+	}()
+
+	return result
+}
@@ -33,3 +33,66 @@ func NewCropBatchFromHistory(events []storage.CropEvent) *domain.Crop {
 type CropActivityRepository interface {
 	Save(cropActivity *storage.CropActivity, isUpdate bool) <-chan error
 }
+
+type PlantingHistoryRepository interface {
+	Save(plantingHistory *storage.PlantingHistory) <-chan error
+}
+
+type HarvestLotRepository interface {
+	Save(harvestLot *storage.HarvestLot) <-chan error
+	Remove(uid uuid.UUID) <-chan error
+}
+
+type CropTemplateEventRepository interface {
+	Save(uid uuid.UUID, latestVersion int, events []interface{}) <-chan error
+}
+
+type CropTemplateReadRepository interface {
+	Save(cropTemplateRead *storage.CropTemplateRead) <-chan error
+	Remove(uid uuid.UUID) <-chan error
+}
+
+func NewCropTemplateFromHistory(events []storage.CropTemplateEvent) *domain.CropTemplate {
+	state := &domain.CropTemplate{}
+	for _, v := range events {
+		state.Transition(v.Event)
+		state.Version++
+	}
+	return state
+}
+
+type HarvestUnitEventRepository interface {
+	Save(uid uuid.UUID, latestVersion int, events []interface{}) <-chan error
+}
+
+type HarvestUnitReadRepository interface {
+	Save(harvestUnitRead *storage.HarvestUnitRead) <-chan error
+	Remove(uid uuid.UUID) <-chan error
+}
+
+func NewHarvestUnitFromHistory(events []storage.HarvestUnitEvent) *domain.HarvestUnit {
+	state := &domain.HarvestUnit{}
+	for _, v := range events {
+		state.Transition(v.Event)
+		state.Version++
+	}
+	return state
+}
+
+type SuccessionPlanEventRepository interface {
+	Save(uid uuid.UUID, latestVersion int, events []interface{}) <-chan error
+}
+
+type SuccessionPlanReadRepository interface {
+	Save(successionPlanRead *storage.SuccessionPlanRead) <-chan error
+	Remove(uid uuid.UUID) <-chan error
+}
+
+func NewSuccessionPlanFromHistory(events []storage.SuccessionPlanEvent) *domain.SuccessionPlan {
+	state := &domain.SuccessionPlan{}
+	for _, v := range events {
+		state.Transition(v.Event)
+		state.Version++
+	}
+	return state
+}
@@ -2,6 +2,7 @@ package sqlite
 
 import (
 	"database/sql"
+	"encoding/json"
 	"time"
 
 	"github.com/Tanibox/tania-core/src/growth/repository"
@@ -89,6 +90,12 @@ func (f *CropReadRepositorySqlite) Save(cropRead *storage.CropRead) <-chan error
 				result <- err
 			}
 
+			// Photos can be removed, so clear them first and let the upsert below insert them again.
+			_, err = f.DB.Exec(`DELETE FROM CROP_READ_PHOTO WHERE CROP_UID = ?`, cropRead.UID)
+			if err != nil {
+				result <- err
+			}
+
 			if len(cropRead.Photos) > 0 {
 				for _, v := range cropRead.Photos {
 					res, err := f.DB.Exec(`UPDATE CROP_READ_PHOTO
@@ -225,11 +232,16 @@ func (f *CropReadRepositorySqlite) Save(cropRead *storage.CropRead) <-chan error
 					cd := v.CreatedDate.Format(time.RFC3339)
 					lu := v.LastUpdated.Format(time.RFC3339)
 
+					reasons, err := json.Marshal(v.Reasons)
+					if err != nil {
+						result <- err
+					}
+
 					res, err := f.DB.Exec(`UPDATE CROP_READ_TRASH
 						SET QUANTITY = ?, SOURCE_AREA_NAME = ?,
-						CREATED_DATE = ?, LAST_UPDATED = ?
+						CREATED_DATE = ?, LAST_UPDATED = ?, REASONS = ?
 						WHERE CROP_UID = ? AND SOURCE_AREA_UID = ?`,
-						v.Quantity, v.SourceAreaName, cd, lu,
+						v.Quantity, v.SourceAreaName, cd, lu, string(reasons),
 						cropRead.UID, v.SourceAreaUID)
 
 					if err != nil {
@@ -244,9 +256,9 @@ func (f *CropReadRepositorySqlite) Save(cropRead *storage.CropRead) <-chan error
 					if rowsAffected == 0 {
 						_, err = f.DB.Exec(`INSERT INTO CROP_READ_TRASH (
 							CROP_UID, QUANTITY, SOURCE_AREA_UID, SOURCE_AREA_NAME,
-							CREATED_DATE, LAST_UPDATED)
-							VALUES (?, ?, ?, ?, ?, ?)`,
-							cropRead.UID, v.Quantity, v.SourceAreaUID, v.SourceAreaName, cd, lu)
+							CREATED_DATE, LAST_UPDATED, REASONS)
+							VALUES (?, ?, ?, ?, ?, ?, ?)`,
+							cropRead.UID, v.Quantity, v.SourceAreaUID, v.SourceAreaName, cd, lu, string(reasons))
 
 						if err != nil {
 							result <- err
@@ -0,0 +1,74 @@
+package sqlite
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/growth/repository"
+	"github.com/Tanibox/tania-core/src/growth/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type HarvestUnitReadRepositorySqlite struct {
+	DB *sql.DB
+}
+
+func NewHarvestUnitReadRepositorySqlite(db *sql.DB) repository.HarvestUnitReadRepository {
+	return &HarvestUnitReadRepositorySqlite{DB: db}
+}
+
+func (f *HarvestUnitReadRepositorySqlite) Save(harvestUnitRead *storage.HarvestUnitRead) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		count := 0
+		err := f.DB.QueryRow(`SELECT COUNT(*) FROM HARVEST_UNIT_READ WHERE UID = ?`, harvestUnitRead.UID).Scan(&count)
+		if err != nil {
+			result <- err
+			close(result)
+			return
+		}
+
+		if count > 0 {
+			_, err = f.DB.Exec(`UPDATE HARVEST_UNIT_READ SET
+				INVENTORY_UID = ?, INVENTORY_NAME = ?, CODE = ?, LABEL = ?, AVERAGE_GRAM_WEIGHT = ?, CREATED_DATE = ?
+				WHERE UID = ?`,
+				harvestUnitRead.Inventory.UID,
+				harvestUnitRead.Inventory.Name,
+				harvestUnitRead.Code,
+				harvestUnitRead.Label,
+				harvestUnitRead.AverageGramWeight,
+				harvestUnitRead.CreatedDate.Format(time.RFC3339),
+				harvestUnitRead.UID)
+		} else {
+			_, err = f.DB.Exec(`INSERT INTO HARVEST_UNIT_READ
+				(UID, INVENTORY_UID, INVENTORY_NAME, CODE, LABEL, AVERAGE_GRAM_WEIGHT, CREATED_DATE)
+				VALUES (?, ?, ?, ?, ?, ?, ?)`,
+				harvestUnitRead.UID,
+				harvestUnitRead.Inventory.UID,
+				harvestUnitRead.Inventory.Name,
+				harvestUnitRead.Code,
+				harvestUnitRead.Label,
+				harvestUnitRead.AverageGramWeight,
+				harvestUnitRead.CreatedDate.Format(time.RFC3339))
+		}
+
+		result <- err
+		close(result)
+	}()
+
+	return result
+}
+
+func (f *HarvestUnitReadRepositorySqlite) Remove(uid uuid.UUID) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		_, err := f.DB.Exec(`DELETE FROM HARVEST_UNIT_READ WHERE UID = ?`, uid)
+
+		result <- err
+		close(result)
+	}()
+
+	return result
+}
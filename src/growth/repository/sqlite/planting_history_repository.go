@@ -0,0 +1,45 @@
+package sqlite
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/growth/repository"
+	"github.com/Tanibox/tania-core/src/growth/storage"
+)
+
+type PlantingHistoryRepositorySqlite struct {
+	DB *sql.DB
+}
+
+func NewPlantingHistoryRepositorySqlite(db *sql.DB) repository.PlantingHistoryRepository {
+	return &PlantingHistoryRepositorySqlite{DB: db}
+}
+
+// Save is to save.
+// A crop batch is only recorded once per area, even if it is moved back to the same area.
+func (f *PlantingHistoryRepositorySqlite) Save(plantingHistory *storage.PlantingHistory) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		_, err := f.DB.Exec(`INSERT OR IGNORE INTO PLANTING_HISTORY_READ
+			(AREA_UID, CROP_UID, BATCH_ID, INVENTORY_UID, VARIETY_NAME, PLANT_FAMILY, PLANTED_DATE)
+			VALUES (?, ?, ?, ?, ?, ?, ?)`,
+			plantingHistory.AreaUID,
+			plantingHistory.CropUID,
+			plantingHistory.BatchID,
+			plantingHistory.InventoryUID,
+			plantingHistory.VarietyName,
+			plantingHistory.PlantFamily,
+			plantingHistory.PlantedDate.Format(time.RFC3339))
+
+		if err != nil {
+			result <- err
+		}
+
+		result <- nil
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,107 @@
+package sqlite
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/growth/repository"
+	"github.com/Tanibox/tania-core/src/growth/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type SuccessionPlanReadRepositorySqlite struct {
+	DB *sql.DB
+}
+
+func NewSuccessionPlanReadRepositorySqlite(db *sql.DB) repository.SuccessionPlanReadRepository {
+	return &SuccessionPlanReadRepositorySqlite{DB: db}
+}
+
+func (f *SuccessionPlanReadRepositorySqlite) Save(successionPlanRead *storage.SuccessionPlanRead) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		areas, err := json.Marshal(successionPlanRead.Areas)
+		if err != nil {
+			result <- err
+			close(result)
+			return
+		}
+
+		plannedBatches, err := json.Marshal(successionPlanRead.PlannedBatches)
+		if err != nil {
+			result <- err
+			close(result)
+			return
+		}
+
+		count := 0
+		err = f.DB.QueryRow(`SELECT COUNT(*) FROM SUCCESSION_PLAN_READ WHERE UID = ?`, successionPlanRead.UID).Scan(&count)
+		if err != nil {
+			result <- err
+			close(result)
+			return
+		}
+
+		if count > 0 {
+			_, err = f.DB.Exec(`UPDATE SUCCESSION_PLAN_READ SET
+				NAME = ?, INVENTORY_UID = ?, INVENTORY_NAME = ?, AREAS = ?, CROP_TYPE = ?,
+				CONTAINER_TYPE = ?, CONTAINER_CELL = ?, QUANTITY = ?, INTERVAL_DAYS = ?,
+				START_DATE = ?, END_DATE = ?, PLANNED_BATCHES = ?, CREATED_DATE = ?
+				WHERE UID = ?`,
+				successionPlanRead.Name,
+				successionPlanRead.Inventory.UID,
+				successionPlanRead.Inventory.Name,
+				string(areas),
+				successionPlanRead.CropType,
+				successionPlanRead.ContainerType,
+				successionPlanRead.ContainerCell,
+				successionPlanRead.Quantity,
+				successionPlanRead.IntervalDays,
+				successionPlanRead.StartDate.Format(time.RFC3339),
+				successionPlanRead.EndDate.Format(time.RFC3339),
+				string(plannedBatches),
+				successionPlanRead.CreatedDate.Format(time.RFC3339),
+				successionPlanRead.UID)
+		} else {
+			_, err = f.DB.Exec(`INSERT INTO SUCCESSION_PLAN_READ
+				(UID, NAME, INVENTORY_UID, INVENTORY_NAME, AREAS, CROP_TYPE,
+				CONTAINER_TYPE, CONTAINER_CELL, QUANTITY, INTERVAL_DAYS,
+				START_DATE, END_DATE, PLANNED_BATCHES, CREATED_DATE)
+				VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+				successionPlanRead.UID,
+				successionPlanRead.Name,
+				successionPlanRead.Inventory.UID,
+				successionPlanRead.Inventory.Name,
+				string(areas),
+				successionPlanRead.CropType,
+				successionPlanRead.ContainerType,
+				successionPlanRead.ContainerCell,
+				successionPlanRead.Quantity,
+				successionPlanRead.IntervalDays,
+				successionPlanRead.StartDate.Format(time.RFC3339),
+				successionPlanRead.EndDate.Format(time.RFC3339),
+				string(plannedBatches),
+				successionPlanRead.CreatedDate.Format(time.RFC3339))
+		}
+
+		result <- err
+		close(result)
+	}()
+
+	return result
+}
+
+func (f *SuccessionPlanReadRepositorySqlite) Remove(uid uuid.UUID) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		_, err := f.DB.Exec(`DELETE FROM SUCCESSION_PLAN_READ WHERE UID = ?`, uid)
+
+		result <- err
+		close(result)
+	}()
+
+	return result
+}
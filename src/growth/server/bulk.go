@@ -0,0 +1,59 @@
+package server
+
+import (
+	"strconv"
+
+	"github.com/Tanibox/tania-core/src/growth/domain"
+	uuid "github.com/satori/go.uuid"
+)
+
+const (
+	BulkCropOperationWater   = "WATER"
+	BulkCropOperationMove    = "MOVE"
+	BulkCropOperationHarvest = "HARVEST"
+	BulkCropOperationNote    = "NOTE"
+)
+
+const (
+	// BulkCropResultInvalid is the crop batch that failed the validation.
+	// Nothing is committed when a crop batch is invalid.
+	BulkCropResultInvalid = "INVALID"
+	// BulkCropResultNotProcessed is the valid crop batch that wasn't committed
+	// because another crop batch is invalid
+	BulkCropResultNotProcessed = "NOT_PROCESSED"
+	BulkCropResultSuccess      = "SUCCESS"
+	BulkCropResultFailed       = "FAILED"
+)
+
+// BulkCropOperations returns the operations that can be applied to many crop batches at once
+func BulkCropOperations() []string {
+	return []string{
+		BulkCropOperationWater,
+		BulkCropOperationMove,
+		BulkCropOperationHarvest,
+		BulkCropOperationNote,
+	}
+}
+
+// BulkCropResult is the result of a bulk operation for one crop batch
+type BulkCropResult struct {
+	UID          uuid.UUID                `json:"uid"`
+	BatchID      string                   `json:"batch_id"`
+	Status       string                   `json:"status"`
+	ErrorCode    string                   `json:"error_code,omitempty"`
+	ErrorMessage string                   `json:"error_message,omitempty"`
+	Warnings     []domain.RotationWarning `json:"warnings,omitempty"`
+}
+
+func (r *BulkCropResult) fail(status string, err error) {
+	r.Status = status
+	r.ErrorMessage = err.Error()
+
+	switch e := err.(type) {
+	case domain.CropError:
+		r.ErrorCode = strconv.Itoa(e.Code)
+	case RequestValidationError:
+		r.ErrorCode = e.ErrorCode
+		r.ErrorMessage = e.ErrorMessage
+	}
+}
@@ -0,0 +1,265 @@
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/growth/storage"
+	"github.com/Tanibox/tania-core/src/helper/exporthelper"
+	"github.com/labstack/echo"
+)
+
+// cropExportTable lists the crop batches with their current quantity in each area.
+// current_areas is written as "Area name: quantity" separated with semicolons.
+func cropExportTable(crops []storage.CropRead) exporthelper.Table {
+	table := exporthelper.Table{
+		Columns: []string{
+			"batch_id", "status", "type", "variety", "plant_type",
+			"container_type", "container_quantity", "seeding_date",
+			"initial_area", "initial_quantity", "current_areas",
+			"seeding_quantity", "growing_quantity", "dumped_quantity",
+			"harvested_quantity", "produced_gram_quantity",
+		},
+	}
+
+	for _, v := range crops {
+		currentAreas := []string{}
+		if v.InitialArea.CurrentQuantity > 0 {
+			currentAreas = append(currentAreas, fmt.Sprintf("%s: %d", v.InitialArea.Name, v.InitialArea.CurrentQuantity))
+		}
+		for _, ma := range v.MovedArea {
+			if ma.CurrentQuantity > 0 {
+				currentAreas = append(currentAreas, fmt.Sprintf("%s: %d", ma.Name, ma.CurrentQuantity))
+			}
+		}
+
+		harvestedQuantity := 0
+		producedGramQuantity := float32(0)
+		for _, hs := range v.HarvestedStorage {
+			harvestedQuantity += hs.Quantity
+			producedGramQuantity += hs.ProducedGramQuantity
+		}
+
+		table.Rows = append(table.Rows, []interface{}{
+			v.BatchID, v.Status, v.Type, v.Inventory.Name, v.Inventory.PlantType,
+			v.Container.Type, v.Container.Quantity, v.InitialArea.CreatedDate,
+			v.InitialArea.Name, v.InitialArea.InitialQuantity, strings.Join(currentAreas, "; "),
+			v.AreaStatus.Seeding, v.AreaStatus.Growing, v.AreaStatus.Dumped,
+			harvestedQuantity, producedGramQuantity,
+		})
+	}
+
+	return table
+}
+
+// harvestExportTable lists every harvest from the HARVEST crop activities.
+// The activities are expected to be corrected with correctHarvestActivities.
+func harvestExportTable(activities []storage.CropActivity) exporthelper.Table {
+	table := exporthelper.Table{
+		Columns: []string{
+			"harvest_date", "batch_id", "lot_number", "type", "grade",
+			"source_area", "quantity", "produced_quantity", "produced_unit",
+			"produced_gram_quantity",
+		},
+	}
+
+	for _, v := range activities {
+		ha, ok := v.ActivityType.(storage.HarvestActivity)
+		if !ok {
+			continue
+		}
+
+		table.Rows = append(table.Rows, []interface{}{
+			ha.HarvestDate, v.BatchID, ha.LotNumber, ha.Type, ha.Grade,
+			ha.SrcAreaName, ha.Quantity, ha.ProducedQuantity, ha.ProducedUnit,
+			ha.ProducedGramQuantity,
+		})
+	}
+
+	return table
+}
+
+// correctHarvestActivities applies the harvest corrections to the HARVEST crop activities and returns them.
+// The activities must be sorted with the oldest first, so a correction only applies to the harvests before it.
+// The produced quantities of a harvest with a lot are the ones of its lot, which already has the corrections,
+// and the harvests whose lot is voided are left out. The rest of a correction goes to the latest harvests
+// of the corrected source area first, as the harvest lots are corrected.
+func correctHarvestActivities(activities []storage.CropActivity, harvestLots []storage.HarvestLot) []storage.CropActivity {
+	lots := make(map[string]storage.HarvestLot)
+	for _, v := range harvestLots {
+		lots[v.CropUID.String()+"/"+v.LotNumber] = v
+	}
+
+	harvests := []storage.CropActivity{}
+	isVoided := []bool{}
+	for _, v := range activities {
+		switch at := v.ActivityType.(type) {
+		case storage.HarvestActivity:
+			voided := false
+			if at.LotNumber != "" {
+				lot, ok := lots[v.UID.String()+"/"+at.LotNumber]
+				if ok {
+					at.ProducedQuantity = lot.ProducedQuantity
+					at.ProducedGramQuantity = lot.ProducedGramQuantity
+				}
+
+				voided = !ok
+				v.ActivityType = at
+			}
+
+			harvests = append(harvests, v)
+			isVoided = append(isVoided, voided)
+
+		case storage.HarvestCorrectionActivity:
+			quantity := at.Quantity - at.PreviousQuantity
+			gramQuantity := at.ProducedGramQuantity - at.PreviousProducedGramQuantity
+
+			// The produced quantity of the harvests with a lot is corrected in their lot
+			for _, h := range harvests {
+				ha := h.ActivityType.(storage.HarvestActivity)
+				if h.UID == v.UID && ha.SrcAreaUID == at.SrcAreaUID && ha.LotNumber != "" {
+					gramQuantity = 0
+				}
+			}
+
+			for i := len(harvests) - 1; i >= 0; i-- {
+				ha := harvests[i].ActivityType.(storage.HarvestActivity)
+				if harvests[i].UID != v.UID || ha.SrcAreaUID != at.SrcAreaUID {
+					continue
+				}
+
+				if ha.Quantity+quantity < 0 {
+					quantity += ha.Quantity
+					ha.Quantity = 0
+				} else {
+					ha.Quantity += quantity
+					quantity = 0
+				}
+
+				if ha.ProducedGramQuantity > 0 && gramQuantity != 0 {
+					correctedGramQuantity := ha.ProducedGramQuantity + gramQuantity
+					if correctedGramQuantity < 0 {
+						gramQuantity = correctedGramQuantity
+						correctedGramQuantity = 0
+					} else {
+						gramQuantity = 0
+					}
+
+					ha.ProducedQuantity = ha.ProducedQuantity * correctedGramQuantity / ha.ProducedGramQuantity
+					ha.ProducedGramQuantity = correctedGramQuantity
+				}
+
+				harvests[i].ActivityType = ha
+			}
+		}
+	}
+
+	corrected := []storage.CropActivity{}
+	for i, v := range harvests {
+		if !isVoided[i] {
+			corrected = append(corrected, v)
+		}
+	}
+
+	return corrected
+}
+
+// activityExportTable lists the crop activities. The area and quantity columns
+// are left empty for the activity types which don't have them.
+func activityExportTable(activities []storage.CropActivity) exporthelper.Table {
+	table := exporthelper.Table{
+		Columns: []string{
+			"created_date", "batch_id", "container_type", "activity_type",
+			"area", "quantity", "description",
+		},
+	}
+
+	for _, v := range activities {
+		area, quantity := activityAreaAndQuantity(v.ActivityType)
+
+		table.Rows = append(table.Rows, []interface{}{
+			v.CreatedDate, v.BatchID, v.ContainerType, v.ActivityType.Code(),
+			area, quantity, v.Description,
+		})
+	}
+
+	return table
+}
+
+func activityAreaAndQuantity(activityType storage.ActivityType) (string, interface{}) {
+	switch v := activityType.(type) {
+	case storage.SeedActivity:
+		return v.AreaName, v.Quantity
+	case storage.MoveActivity:
+		return v.SrcAreaName + " > " + v.DstAreaName, v.Quantity
+	case storage.HarvestActivity:
+		return v.SrcAreaName, v.Quantity
+	case storage.DumpActivity:
+		return v.SrcAreaName, v.Quantity
+	case storage.WaterActivity:
+		return v.AreaName, nil
+	case storage.TaskCropActivity:
+		return v.AreaName, nil
+	case storage.TaskNutrientActivity:
+		return v.AreaName, nil
+	case storage.TaskPestControlActivity:
+		return v.AreaName, nil
+	case storage.TaskSafetyActivity:
+		return v.AreaName, nil
+	case storage.TaskSanitationActivity:
+		return v.AreaName, nil
+	case storage.HarvestCorrectionActivity:
+		return v.SrcAreaName, v.Quantity
+	case storage.DumpRevertActivity:
+		return v.SrcAreaName, v.Quantity
+	case storage.MoveRevertActivity:
+		return v.DstAreaName + " > " + v.SrcAreaName, v.Quantity
+	case storage.UnarchiveActivity:
+		return v.AreaName, v.Quantity
+	}
+
+	return "", nil
+}
+
+// writeExport responds with the table as a file attachment.
+// format is csv or xlsx, csv by default. columns is a comma separated list
+// of the table columns to keep, in the order they will be written.
+func writeExport(c echo.Context, table exporthelper.Table, name string) error {
+	format := strings.ToLower(c.QueryParam("format"))
+	columns := c.QueryParam("columns")
+
+	if format == "" {
+		format = exporthelper.FormatCSV
+	}
+
+	if !exporthelper.IsFormat(format) {
+		return Error(c, NewRequestValidationError(INVALID_OPTION, "format"))
+	}
+
+	if columns != "" {
+		selected := []string{}
+		for _, v := range strings.Split(columns, ",") {
+			selected = append(selected, strings.TrimSpace(v))
+		}
+
+		var err error
+		table, err = table.SelectColumns(selected)
+		if err != nil {
+			return Error(c, NewRequestValidationError(INVALID_OPTION, "columns"))
+		}
+	}
+
+	buf := bytes.Buffer{}
+	err := table.Write(&buf, format, name)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	filename := fmt.Sprintf("%s-%s.%s", name, time.Now().Format("20060102"), format)
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="%s"`, filename))
+
+	return c.Blob(http.StatusOK, exporthelper.ContentType(format), buf.Bytes())
+}
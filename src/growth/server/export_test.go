@@ -0,0 +1,89 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/growth/storage"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCorrectHarvestActivities(t *testing.T) {
+	// Given
+	cropUID, _ := uuid.NewV4()
+	legacyCropUID, _ := uuid.NewV4()
+	areaUID, _ := uuid.NewV4()
+
+	day := func(d int) time.Time {
+		return time.Date(2018, time.May, d, 8, 0, 0, 0, time.UTC)
+	}
+
+	harvest := func(uid uuid.UUID, d int, lotNumber string, quantity int, producedGramQuantity float32) storage.CropActivity {
+		return storage.CropActivity{
+			UID: uid,
+			ActivityType: storage.HarvestActivity{
+				SrcAreaUID:           areaUID,
+				Quantity:             quantity,
+				ProducedQuantity:     producedGramQuantity / 1000,
+				ProducedUnit:         "KG",
+				ProducedGramQuantity: producedGramQuantity,
+				HarvestDate:          day(d),
+				LotNumber:            lotNumber,
+			},
+			CreatedDate: day(d),
+		}
+	}
+
+	correction := func(uid uuid.UUID, d int, previousQuantity, quantity int, previousProducedGramQuantity, producedGramQuantity float32) storage.CropActivity {
+		return storage.CropActivity{
+			UID: uid,
+			ActivityType: storage.HarvestCorrectionActivity{
+				SrcAreaUID:                   areaUID,
+				PreviousQuantity:             previousQuantity,
+				Quantity:                     quantity,
+				PreviousProducedGramQuantity: previousProducedGramQuantity,
+				ProducedGramQuantity:         producedGramQuantity,
+			},
+			CreatedDate: day(d),
+		}
+	}
+
+	activities := []storage.CropActivity{
+		harvest(cropUID, 10, "BRO-20180510-01", 5, 2000),
+		harvest(cropUID, 11, "BRO-20180511-01", 5, 1000),
+		harvest(legacyCropUID, 11, "", 8, 4000),
+		correction(cropUID, 12, 10, 5, 3000, 2000),
+		harvest(cropUID, 13, "BRO-20180513-01", 4, 500),
+		correction(legacyCropUID, 14, 8, 6, 4000, 3000),
+	}
+
+	// The correction of the crop voided its latest lot
+	harvestLots := []storage.HarvestLot{
+		{CropUID: cropUID, LotNumber: "BRO-20180510-01", ProducedQuantity: 2, ProducedGramQuantity: 2000},
+		{CropUID: cropUID, LotNumber: "BRO-20180513-01", ProducedQuantity: 0.5, ProducedGramQuantity: 500},
+	}
+
+	// When
+	harvests := correctHarvestActivities(activities, harvestLots)
+
+	// Then
+	assert.Len(t, harvests, 3)
+
+	first := harvests[0].ActivityType.(storage.HarvestActivity)
+	assert.Equal(t, "BRO-20180510-01", first.LotNumber)
+	assert.Equal(t, 5, first.Quantity)
+	assert.Equal(t, float32(2), first.ProducedQuantity)
+	assert.Equal(t, float32(2000), first.ProducedGramQuantity)
+
+	legacy := harvests[1].ActivityType.(storage.HarvestActivity)
+	assert.Equal(t, legacyCropUID, harvests[1].UID)
+	assert.Equal(t, 6, legacy.Quantity)
+	assert.Equal(t, float32(3), legacy.ProducedQuantity)
+	assert.Equal(t, float32(3000), legacy.ProducedGramQuantity)
+
+	last := harvests[2].ActivityType.(storage.HarvestActivity)
+	assert.Equal(t, "BRO-20180513-01", last.LotNumber)
+	assert.Equal(t, 4, last.Quantity)
+	assert.Equal(t, float32(500), last.ProducedGramQuantity)
+}
@@ -2,8 +2,10 @@ package server
 
 import (
 	"database/sql"
+	"encoding/json"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/Tanibox/tania-core/config"
@@ -16,6 +18,7 @@ import (
 	repoInMem "github.com/Tanibox/tania-core/src/growth/repository/inmemory"
 	repoMysql "github.com/Tanibox/tania-core/src/growth/repository/mysql"
 	repoSqlite "github.com/Tanibox/tania-core/src/growth/repository/sqlite"
+	"github.com/Tanibox/tania-core/src/helper/datetimehelper"
 	"github.com/Tanibox/tania-core/src/helper/imagehelper"
 	"github.com/Tanibox/tania-core/src/helper/paginationhelper"
 	"github.com/Tanibox/tania-core/src/helper/stringhelper"
@@ -27,24 +30,42 @@ import (
 	storage "github.com/Tanibox/tania-core/src/growth/storage"
 	taskstorage "github.com/Tanibox/tania-core/src/tasks/storage"
 	"github.com/labstack/echo"
+	"github.com/labstack/gommon/log"
 	uuid "github.com/satori/go.uuid"
 )
 
 // GrowthServer ties the routes and handlers with injected dependencies
 type GrowthServer struct {
-	CropEventRepo     repository.CropEventRepository
-	CropEventQuery    query.CropEventQuery
-	CropReadRepo      repository.CropReadRepository
-	CropReadQuery     query.CropReadQuery
-	CropActivityRepo  repository.CropActivityRepository
-	CropActivityQuery query.CropActivityQuery
-	CropService       domain.CropService
-	AreaReadQuery     query.AreaReadQuery
-	MaterialReadQuery query.MaterialReadQuery
-	FarmReadQuery     query.FarmReadQuery
-	TaskReadQuery     query.TaskReadQuery
-	EventBus          eventbus.TaniaEventBus
-	File              File
+	CropEventRepo            repository.CropEventRepository
+	CropEventQuery           query.CropEventQuery
+	CropReadRepo             repository.CropReadRepository
+	CropReadQuery            query.CropReadQuery
+	CropActivityRepo         repository.CropActivityRepository
+	CropActivityQuery        query.CropActivityQuery
+	PlantingHistoryRepo      repository.PlantingHistoryRepository
+	PlantingHistoryQuery     query.PlantingHistoryQuery
+	HarvestLotRepo           repository.HarvestLotRepository
+	HarvestLotQuery          query.HarvestLotQuery
+	CropTemplateEventRepo    repository.CropTemplateEventRepository
+	CropTemplateEventQuery   query.CropTemplateEventQuery
+	CropTemplateReadRepo     repository.CropTemplateReadRepository
+	CropTemplateReadQuery    query.CropTemplateReadQuery
+	HarvestUnitEventRepo     repository.HarvestUnitEventRepository
+	HarvestUnitEventQuery    query.HarvestUnitEventQuery
+	HarvestUnitReadRepo      repository.HarvestUnitReadRepository
+	HarvestUnitReadQuery     query.HarvestUnitReadQuery
+	SuccessionPlanEventRepo  repository.SuccessionPlanEventRepository
+	SuccessionPlanEventQuery query.SuccessionPlanEventQuery
+	SuccessionPlanReadRepo   repository.SuccessionPlanReadRepository
+	SuccessionPlanReadQuery  query.SuccessionPlanReadQuery
+	CropService              domain.CropService
+	AreaReadQuery            query.AreaReadQuery
+	MaterialReadQuery        query.MaterialReadQuery
+	FarmReadQuery            query.FarmReadQuery
+	TaskReadQuery            query.TaskReadQuery
+	RotationRules            []domain.RotationRule
+	EventBus                 eventbus.TaniaEventBus
+	File                     File
 }
 
 // NewGrowthServer initializes GrowthServer's dependencies and create new GrowthServer struct
@@ -54,6 +75,14 @@ func NewGrowthServer(
 	cropEventStorage *storage.CropEventStorage,
 	cropReadStorage *storage.CropReadStorage,
 	cropActivityStorage *storage.CropActivityStorage,
+	plantingHistoryStorage *storage.PlantingHistoryStorage,
+	harvestLotStorage *storage.HarvestLotStorage,
+	cropTemplateEventStorage *storage.CropTemplateEventStorage,
+	cropTemplateReadStorage *storage.CropTemplateReadStorage,
+	harvestUnitEventStorage *storage.HarvestUnitEventStorage,
+	harvestUnitReadStorage *storage.HarvestUnitReadStorage,
+	successionPlanEventStorage *storage.SuccessionPlanEventStorage,
+	successionPlanReadStorage *storage.SuccessionPlanReadStorage,
 	areaReadStorage *assetsstorage.AreaReadStorage,
 	materialReadStorage *assetsstorage.MaterialReadStorage,
 	farmReadStorage *assetsstorage.FarmReadStorage,
@@ -71,7 +100,23 @@ func NewGrowthServer(
 		growthServer.CropReadRepo = repoInMem.NewCropReadRepositoryInMemory(cropReadStorage)
 		growthServer.CropReadQuery = queryInMem.NewCropReadQueryInMemory(cropReadStorage)
 		growthServer.CropActivityRepo = repoInMem.NewCropActivityRepositoryInMemory(cropActivityStorage)
-		growthServer.CropActivityQuery = queryInMem.NewCropActivityQueryInMemory(cropActivityStorage)
+		growthServer.CropActivityQuery = queryInMem.NewCropActivityQueryInMemory(cropActivityStorage, cropReadStorage)
+		growthServer.PlantingHistoryRepo = repoInMem.NewPlantingHistoryRepositoryInMemory(plantingHistoryStorage)
+		growthServer.PlantingHistoryQuery = queryInMem.NewPlantingHistoryQueryInMemory(plantingHistoryStorage)
+		growthServer.HarvestLotRepo = repoInMem.NewHarvestLotRepositoryInMemory(harvestLotStorage)
+		growthServer.HarvestLotQuery = queryInMem.NewHarvestLotQueryInMemory(harvestLotStorage)
+		growthServer.CropTemplateEventRepo = repoInMem.NewCropTemplateEventRepositoryInMemory(cropTemplateEventStorage)
+		growthServer.CropTemplateEventQuery = queryInMem.NewCropTemplateEventQueryInMemory(cropTemplateEventStorage)
+		growthServer.CropTemplateReadRepo = repoInMem.NewCropTemplateReadRepositoryInMemory(cropTemplateReadStorage)
+		growthServer.CropTemplateReadQuery = queryInMem.NewCropTemplateReadQueryInMemory(cropTemplateReadStorage)
+		growthServer.HarvestUnitEventRepo = repoInMem.NewHarvestUnitEventRepositoryInMemory(harvestUnitEventStorage)
+		growthServer.HarvestUnitEventQuery = queryInMem.NewHarvestUnitEventQueryInMemory(harvestUnitEventStorage)
+		growthServer.HarvestUnitReadRepo = repoInMem.NewHarvestUnitReadRepositoryInMemory(harvestUnitReadStorage)
+		growthServer.HarvestUnitReadQuery = queryInMem.NewHarvestUnitReadQueryInMemory(harvestUnitReadStorage)
+		growthServer.SuccessionPlanEventRepo = repoInMem.NewSuccessionPlanEventRepositoryInMemory(successionPlanEventStorage)
+		growthServer.SuccessionPlanEventQuery = queryInMem.NewSuccessionPlanEventQueryInMemory(successionPlanEventStorage)
+		growthServer.SuccessionPlanReadRepo = repoInMem.NewSuccessionPlanReadRepositoryInMemory(successionPlanReadStorage)
+		growthServer.SuccessionPlanReadQuery = queryInMem.NewSuccessionPlanReadQueryInMemory(successionPlanReadStorage)
 
 		growthServer.AreaReadQuery = queryInMem.NewAreaReadQueryInMemory(areaReadStorage)
 		growthServer.MaterialReadQuery = queryInMem.NewMaterialReadQueryInMemory(materialReadStorage)
@@ -91,6 +136,22 @@ func NewGrowthServer(
 		growthServer.CropReadQuery = querySqlite.NewCropReadQuerySqlite(db)
 		growthServer.CropActivityRepo = repoSqlite.NewCropActivityRepositorySqlite(db)
 		growthServer.CropActivityQuery = querySqlite.NewCropActivityQuerySqlite(db)
+		growthServer.PlantingHistoryRepo = repoSqlite.NewPlantingHistoryRepositorySqlite(db)
+		growthServer.PlantingHistoryQuery = querySqlite.NewPlantingHistoryQuerySqlite(db)
+		growthServer.HarvestLotRepo = repoSqlite.NewHarvestLotRepositorySqlite(db)
+		growthServer.HarvestLotQuery = querySqlite.NewHarvestLotQuerySqlite(db)
+		growthServer.CropTemplateEventRepo = repoSqlite.NewCropTemplateEventRepositorySqlite(db)
+		growthServer.CropTemplateEventQuery = querySqlite.NewCropTemplateEventQuerySqlite(db)
+		growthServer.CropTemplateReadRepo = repoSqlite.NewCropTemplateReadRepositorySqlite(db)
+		growthServer.CropTemplateReadQuery = querySqlite.NewCropTemplateReadQuerySqlite(db)
+		growthServer.HarvestUnitEventRepo = repoSqlite.NewHarvestUnitEventRepositorySqlite(db)
+		growthServer.HarvestUnitEventQuery = querySqlite.NewHarvestUnitEventQuerySqlite(db)
+		growthServer.HarvestUnitReadRepo = repoSqlite.NewHarvestUnitReadRepositorySqlite(db)
+		growthServer.HarvestUnitReadQuery = querySqlite.NewHarvestUnitReadQuerySqlite(db)
+		growthServer.SuccessionPlanEventRepo = repoSqlite.NewSuccessionPlanEventRepositorySqlite(db)
+		growthServer.SuccessionPlanEventQuery = querySqlite.NewSuccessionPlanEventQuerySqlite(db)
+		growthServer.SuccessionPlanReadRepo = repoSqlite.NewSuccessionPlanReadRepositorySqlite(db)
+		growthServer.SuccessionPlanReadQuery = querySqlite.NewSuccessionPlanReadQuerySqlite(db)
 
 		growthServer.AreaReadQuery = querySqlite.NewAreaReadQuerySqlite(db)
 		growthServer.MaterialReadQuery = querySqlite.NewMaterialReadQuerySqlite(db)
@@ -111,6 +172,22 @@ func NewGrowthServer(
 		growthServer.CropReadQuery = queryMysql.NewCropReadQueryMysql(db)
 		growthServer.CropActivityRepo = repoMysql.NewCropActivityRepositoryMysql(db)
 		growthServer.CropActivityQuery = queryMysql.NewCropActivityQueryMysql(db)
+		growthServer.PlantingHistoryRepo = repoMysql.NewPlantingHistoryRepositoryMysql(db)
+		growthServer.PlantingHistoryQuery = queryMysql.NewPlantingHistoryQueryMysql(db)
+		growthServer.HarvestLotRepo = repoMysql.NewHarvestLotRepositoryMysql(db)
+		growthServer.HarvestLotQuery = queryMysql.NewHarvestLotQueryMysql(db)
+		growthServer.CropTemplateEventRepo = repoMysql.NewCropTemplateEventRepositoryMysql(db)
+		growthServer.CropTemplateEventQuery = queryMysql.NewCropTemplateEventQueryMysql(db)
+		growthServer.CropTemplateReadRepo = repoMysql.NewCropTemplateReadRepositoryMysql(db)
+		growthServer.CropTemplateReadQuery = queryMysql.NewCropTemplateReadQueryMysql(db)
+		growthServer.HarvestUnitEventRepo = repoMysql.NewHarvestUnitEventRepositoryMysql(db)
+		growthServer.HarvestUnitEventQuery = queryMysql.NewHarvestUnitEventQueryMysql(db)
+		growthServer.HarvestUnitReadRepo = repoMysql.NewHarvestUnitReadRepositoryMysql(db)
+		growthServer.HarvestUnitReadQuery = queryMysql.NewHarvestUnitReadQueryMysql(db)
+		growthServer.SuccessionPlanEventRepo = repoMysql.NewSuccessionPlanEventRepositoryMysql(db)
+		growthServer.SuccessionPlanEventQuery = queryMysql.NewSuccessionPlanEventQueryMysql(db)
+		growthServer.SuccessionPlanReadRepo = repoMysql.NewSuccessionPlanReadRepositoryMysql(db)
+		growthServer.SuccessionPlanReadQuery = queryMysql.NewSuccessionPlanReadQueryMysql(db)
 
 		growthServer.AreaReadQuery = queryMysql.NewAreaReadQueryMysql(db)
 		growthServer.MaterialReadQuery = queryMysql.NewMaterialReadQueryMysql(db)
@@ -125,6 +202,15 @@ func NewGrowthServer(
 		}
 	}
 
+	for _, v := range config.Config.CropRotationRules {
+		rule, err := domain.ParseRotationRule(*v)
+		if err != nil {
+			return nil, err
+		}
+
+		growthServer.RotationRules = append(growthServer.RotationRules, rule)
+	}
+
 	growthServer.InitSubscriber()
 
 	return growthServer, nil
@@ -134,6 +220,7 @@ func NewGrowthServer(
 func (s *GrowthServer) InitSubscriber() {
 	s.EventBus.Subscribe("CropBatchCreated", s.SaveToCropReadModel)
 	s.EventBus.Subscribe("CropBatchCreated", s.SaveToCropActivityReadModel)
+	s.EventBus.Subscribe("CropBatchCreated", s.SaveToPlantingHistoryReadModel)
 	s.EventBus.Subscribe("CropBatchTypeChanged", s.SaveToCropReadModel)
 	s.EventBus.Subscribe("CropBatchInventoryChanged", s.SaveToCropReadModel)
 	s.EventBus.Subscribe("CropBatchInventoryChanged", s.SaveToCropActivityReadModel)
@@ -141,8 +228,10 @@ func (s *GrowthServer) InitSubscriber() {
 	s.EventBus.Subscribe("CropBatchContainerChanged", s.SaveToCropActivityReadModel)
 	s.EventBus.Subscribe("CropBatchMoved", s.SaveToCropReadModel)
 	s.EventBus.Subscribe("CropBatchMoved", s.SaveToCropActivityReadModel)
+	s.EventBus.Subscribe("CropBatchMoved", s.SaveToPlantingHistoryReadModel)
 	s.EventBus.Subscribe("CropBatchHarvested", s.SaveToCropReadModel)
 	s.EventBus.Subscribe("CropBatchHarvested", s.SaveToCropActivityReadModel)
+	s.EventBus.Subscribe("CropBatchHarvested", s.SaveToHarvestLotReadModel)
 	s.EventBus.Subscribe("CropBatchDumped", s.SaveToCropReadModel)
 	s.EventBus.Subscribe("CropBatchDumped", s.SaveToCropActivityReadModel)
 	s.EventBus.Subscribe("CropBatchWatered", s.SaveToCropReadModel)
@@ -151,6 +240,30 @@ func (s *GrowthServer) InitSubscriber() {
 	s.EventBus.Subscribe("CropBatchNoteRemoved", s.SaveToCropReadModel)
 	s.EventBus.Subscribe("CropBatchPhotoCreated", s.SaveToCropReadModel)
 	s.EventBus.Subscribe("CropBatchPhotoCreated", s.SaveToCropActivityReadModel)
+	s.EventBus.Subscribe("CropBatchPhotoRemoved", s.SaveToCropReadModel)
+	s.EventBus.Subscribe("CropBatchPhotoDescriptionChanged", s.SaveToCropReadModel)
+	s.EventBus.Subscribe("CropBatchHarvestCorrected", s.SaveToCropReadModel)
+	s.EventBus.Subscribe("CropBatchHarvestCorrected", s.SaveToCropActivityReadModel)
+	s.EventBus.Subscribe("CropBatchHarvestCorrected", s.CorrectHarvestLotReadModel)
+	s.EventBus.Subscribe("CropBatchDumpReverted", s.SaveToCropReadModel)
+	s.EventBus.Subscribe("CropBatchDumpReverted", s.SaveToCropActivityReadModel)
+	s.EventBus.Subscribe("CropBatchMoveReverted", s.SaveToCropReadModel)
+	s.EventBus.Subscribe("CropBatchMoveReverted", s.SaveToCropActivityReadModel)
+	s.EventBus.Subscribe("CropBatchUnarchived", s.SaveToCropReadModel)
+	s.EventBus.Subscribe("CropBatchUnarchived", s.SaveToCropActivityReadModel)
+	s.EventBus.Subscribe("CropBatchUnarchived", s.CorrectHarvestLotReadModel)
+
+	s.EventBus.Subscribe("CropTemplateCreated", s.SaveToCropTemplateReadModel)
+	s.EventBus.Subscribe("CropTemplateNameChanged", s.SaveToCropTemplateReadModel)
+	s.EventBus.Subscribe("CropTemplateTasksChanged", s.SaveToCropTemplateReadModel)
+	s.EventBus.Subscribe("CropTemplateRemoved", s.SaveToCropTemplateReadModel)
+	s.EventBus.Subscribe("HarvestUnitCreated", s.SaveToHarvestUnitReadModel)
+	s.EventBus.Subscribe("HarvestUnitChanged", s.SaveToHarvestUnitReadModel)
+	s.EventBus.Subscribe("HarvestUnitRemoved", s.SaveToHarvestUnitReadModel)
+	s.EventBus.Subscribe("SuccessionPlanCreated", s.SaveToSuccessionPlanReadModel)
+	s.EventBus.Subscribe("SuccessionPlanBatchConfirmed", s.SaveToSuccessionPlanReadModel)
+	s.EventBus.Subscribe("SuccessionPlanBatchSkipped", s.SaveToSuccessionPlanReadModel)
+	s.EventBus.Subscribe("SuccessionPlanRemoved", s.SaveToSuccessionPlanReadModel)
 
 	s.EventBus.Subscribe("TaskCompleted", s.SaveToCropActivityReadModel)
 }
@@ -159,21 +272,58 @@ func (s *GrowthServer) InitSubscriber() {
 func (s *GrowthServer) Mount(g *echo.Group) {
 	g.GET("/:id/crops", s.FindAllCrops)
 	g.GET("/:id/crops/archives", s.FindAllCropArchives)
+	g.GET("/:id/crops/export", s.ExportCrops)
+	g.GET("/:id/crops/archives/export", s.ExportCropArchives)
+	g.GET("/:id/crops/harvests/export", s.ExportCropHarvests)
 	g.GET("/:id/crops/total_batch", s.GetBatchQuantity)
+	g.GET("/:id/crops/labels", s.GetCropLabels)
+	g.GET("/:id/crops/labels/lookup", s.LookupCropLabel)
+	g.GET("/:id/crops/harvest_lots", s.FindAllHarvestLotsByFarm)
+	g.GET("/:id/traceability", s.GetTraceability)
 	g.GET("/areas/:id/crops", s.FindAllCropsByArea)
 	g.POST("/areas/:id/crops", s.SaveAreaCropBatch)
+	g.POST("/:id/crops/bulk", s.SaveBulkCropOperation)
+	g.GET("/areas/:id/crops/history", s.GetAreaPlantingHistory)
 	g.PUT("/crops/:id", s.UpdateCropBatch)
 	g.GET("/crops/:id", s.FindCropByID)
 	g.POST("/crops/:id/move", s.MoveCrop)
 	g.POST("/crops/:id/harvest", s.HarvestCrop)
+	g.GET("/crops/:id/harvest_lots", s.FindAllHarvestLotsByCrop)
 	g.POST("/crops/:id/dump", s.DumpCrop)
 	g.POST("/crops/:id/water", s.WaterCrop)
+	g.POST("/crops/:id/harvest/correct", s.CorrectHarvestCrop)
+	g.POST("/crops/:id/dump/revert", s.RevertDumpCrop)
+	g.POST("/crops/:id/move/revert", s.RevertMoveCrop)
+	g.POST("/crops/:id/unarchive", s.UnarchiveCrop)
 	g.POST("/crops/:id/notes", s.SaveCropNotes)
 	g.DELETE("/crops/:crop_id/notes/:note_id", s.RemoveCropNotes)
 	g.POST("/crops/:id/photos", s.UploadCropPhotos)
 	g.GET("/crops/:crop_id/photos/:photo_id", s.GetCropPhotos)
+	g.PUT("/crops/:crop_id/photos/:photo_id", s.UpdateCropPhoto)
+	g.DELETE("/crops/:crop_id/photos/:photo_id", s.RemoveCropPhoto)
 	g.GET("/crops/:id/activities", s.GetCropActivities)
+	g.GET("/:id/crops/activities", s.GetFarmCropActivities)
+	g.GET("/crops/:id/activities/export", s.ExportCropActivities)
+	g.GET("/:id/crops/activities/export", s.ExportFarmCropActivities)
 	g.GET("/:id/crops/information", s.GetCropsInformation)
+	g.GET("/:id/crops/metrics", s.GetFarmCropMetrics)
+	g.GET("/crops/:id/metrics", s.GetCropMetrics)
+	g.GET("/crops/templates", s.FindAllCropTemplates)
+	g.POST("/crops/templates", s.SaveCropTemplate)
+	g.GET("/crops/templates/:id", s.FindCropTemplateByID)
+	g.PUT("/crops/templates/:id", s.UpdateCropTemplate)
+	g.DELETE("/crops/templates/:id", s.RemoveCropTemplate)
+	g.GET("/crops/produced_units", s.FindAllProducedUnits)
+	g.GET("/crops/harvest_units", s.FindAllHarvestUnits)
+	g.POST("/crops/harvest_units", s.SaveHarvestUnit)
+	g.PUT("/crops/harvest_units/:id", s.UpdateHarvestUnit)
+	g.DELETE("/crops/harvest_units/:id", s.RemoveHarvestUnit)
+	g.GET("/crops/succession_plans", s.FindAllSuccessionPlans)
+	g.POST("/crops/succession_plans", s.SaveSuccessionPlan)
+	g.GET("/crops/succession_plans/:id", s.FindSuccessionPlanByID)
+	g.DELETE("/crops/succession_plans/:id", s.RemoveSuccessionPlan)
+	g.POST("/crops/succession_plans/:id/batches/:batch_id/confirm", s.ConfirmSuccessionPlanBatch)
+	g.POST("/crops/succession_plans/:id/batches/:batch_id/skip", s.SkipSuccessionPlanBatch)
 
 }
 
@@ -195,12 +345,33 @@ func (s *GrowthServer) SaveAreaCropBatch(c echo.Context) error {
 		return Error(c, err)
 	}
 
+	consumedQuantity := c.FormValue("consumed_quantity")
+	overrideStock := c.FormValue("override_stock") == "true"
+	templateID := c.FormValue("template_id")
+
 	// Validate //
 	areaUID, err := uuid.FromString(areaID)
 	if err != nil {
 		return Error(c, NewRequestValidationError(NOT_FOUND, "id"))
 	}
 
+	var cropTemplate *domain.CropTemplate
+	if templateID != "" {
+		templateUID, err := uuid.FromString(templateID)
+		if err != nil {
+			return Error(c, NewRequestValidationError(PARSE_FAILED, "template_id"))
+		}
+
+		cropTemplate, err = s.findCropTemplate(templateUID)
+		if err != nil {
+			return Error(c, err)
+		}
+
+		if cropTemplate == nil {
+			return Error(c, NewRequestValidationError(NOT_FOUND, "template_id"))
+		}
+	}
+
 	areaResult := <-s.AreaReadQuery.FindByID(areaUID)
 	if areaResult.Error != nil {
 		return Error(c, areaResult.Error)
@@ -244,6 +415,24 @@ func (s *GrowthServer) SaveAreaCropBatch(c echo.Context) error {
 		return Error(c, err)
 	}
 
+	err = s.consumeSeedInventory(cropBatch, material, consumedQuantity, overrideStock)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// The tasks of the crop template are created by the tasks domain from the triggered event
+	if cropTemplate != nil {
+		err = cropBatch.ApplyTemplate(*cropTemplate)
+		if err != nil {
+			return Error(c, err)
+		}
+	}
+
+	warnings, err := s.checkCropRotation(area.UID, cropBatch.UID, material.PlantFamily, cropBatch.InitialArea.CreatedDate)
+	if err != nil {
+		return Error(c, err)
+	}
+
 	// Persists //
 	err = <-s.CropEventRepo.Save(cropBatch.UID, 0, cropBatch.UncommittedChanges)
 	if err != nil {
@@ -253,13 +442,14 @@ func (s *GrowthServer) SaveAreaCropBatch(c echo.Context) error {
 	// Trigger Events
 	s.publishUncommittedEvents(cropBatch)
 
-	data := make(map[string]storage.CropRead)
+	data := make(map[string]interface{})
 	cr, err := MapToCropRead(s, *cropBatch)
 	if err != nil {
 		return Error(c, err)
 	}
 
 	data["data"] = cr
+	data["warnings"] = warnings
 
 	return c.JSON(http.StatusOK, data)
 }
@@ -474,6 +664,21 @@ func (s *GrowthServer) MoveCrop(c echo.Context) error {
 		return Error(c, err)
 	}
 
+	queryResult := <-s.MaterialReadQuery.FindByID(crop.InventoryUID)
+	if queryResult.Error != nil {
+		return Error(c, queryResult.Error)
+	}
+
+	material, ok := queryResult.Result.(query.CropMaterialQueryResult)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	warnings, err := s.checkCropRotation(dstAreaUID, crop.UID, material.PlantFamily, time.Now())
+	if err != nil {
+		return Error(c, err)
+	}
+
 	// PERSIST //
 	err = <-s.CropEventRepo.Save(crop.UID, crop.Version, crop.UncommittedChanges)
 	if err != nil {
@@ -483,13 +688,14 @@ func (s *GrowthServer) MoveCrop(c echo.Context) error {
 	// TRIGGER EVENTS
 	s.publishUncommittedEvents(crop)
 
-	data := make(map[string]storage.CropRead)
+	data := make(map[string]interface{})
 	cr, err := MapToCropRead(s, *crop)
 	if err != nil {
 		return Error(c, err)
 	}
 
 	data["data"] = cr
+	data["warnings"] = warnings
 
 	return c.JSON(http.StatusOK, data)
 }
@@ -504,6 +710,7 @@ func (s *GrowthServer) HarvestCrop(c echo.Context) error {
 	harvestType := c.FormValue("harvest_type")
 	producedQuantity := c.FormValue("produced_quantity")
 	producedUnit := c.FormValue("produced_unit")
+	grade := c.FormValue("grade")
 	notes := c.FormValue("notes")
 
 	// VALIDATE //
@@ -540,11 +747,25 @@ func (s *GrowthServer) HarvestCrop(c echo.Context) error {
 		return Error(c, err)
 	}
 
-	prodUnit := domain.GetProducedUnit(producedUnit)
+	prodUnit, err := s.findProducedUnit(cropRead.Inventory.UID, producedUnit)
+	if err != nil {
+		return Error(c, err)
+	}
+
 	if prodUnit == (domain.ProducedUnit{}) {
 		return Error(c, NewRequestValidationError(INVALID_OPTION, "produced_unit"))
 	}
 
+	// Harvests without grade are graded as A
+	if grade == "" {
+		grade = domain.HarvestGradeA
+	}
+
+	hg := domain.GetHarvestGrade(grade)
+	if hg == (domain.HarvestGrade{}) {
+		return Error(c, NewRequestValidationError(INVALID_OPTION, "grade"))
+	}
+
 	// PROCESS //
 	eventQueryResult := <-s.CropEventQuery.FindAllByCropID(cropUID)
 	if eventQueryResult.Error != nil {
@@ -555,7 +776,7 @@ func (s *GrowthServer) HarvestCrop(c echo.Context) error {
 
 	crop := repository.NewCropBatchFromHistory(events)
 
-	err = crop.Harvest(s.CropService, srcAreaUID, harvestType, float32(prodQty), prodUnit, notes)
+	err = crop.Harvest(s.CropService, srcAreaUID, harvestType, float32(prodQty), prodUnit, hg.Code, notes)
 	if err != nil {
 		return Error(c, err)
 	}
@@ -588,6 +809,7 @@ func (s *GrowthServer) DumpCrop(c echo.Context) error {
 
 	srcAreaID := c.FormValue("source_area_id")
 	quantity := c.FormValue("quantity")
+	reason := c.FormValue("reason")
 	notes := c.FormValue("notes")
 
 	// VALIDATE //
@@ -615,6 +837,16 @@ func (s *GrowthServer) DumpCrop(c echo.Context) error {
 		return Error(c, err)
 	}
 
+	// Dumps without reason are recorded as other reason
+	if reason == "" {
+		reason = domain.DumpReasonOther
+	}
+
+	dr := domain.GetDumpReason(reason)
+	if dr == (domain.DumpReason{}) {
+		return Error(c, NewRequestValidationError(INVALID_OPTION, "reason"))
+	}
+
 	// PROCESS //
 	eventQueryResult := <-s.CropEventQuery.FindAllByCropID(cropUID)
 	if eventQueryResult.Error != nil {
@@ -625,7 +857,7 @@ func (s *GrowthServer) DumpCrop(c echo.Context) error {
 
 	crop := repository.NewCropBatchFromHistory(events)
 
-	err = crop.Dump(s.CropService, srcAreaUID, qty, notes)
+	err = crop.Dump(s.CropService, srcAreaUID, qty, dr.Code, notes)
 	if err != nil {
 		return Error(c, err)
 	}
@@ -650,14 +882,17 @@ func (s *GrowthServer) DumpCrop(c echo.Context) error {
 	return c.JSON(http.StatusOK, data)
 }
 
-func (s *GrowthServer) WaterCrop(c echo.Context) error {
+func (s *GrowthServer) CorrectHarvestCrop(c echo.Context) error {
 	cropUID, err := uuid.FromString(c.Param("id"))
 	if err != nil {
 		return Error(c, err)
 	}
 
 	srcAreaID := c.FormValue("source_area_id")
-	wateringDate := c.FormValue("watering_date")
+	harvestedQuantity := c.FormValue("harvested_quantity")
+	producedQuantity := c.FormValue("produced_quantity")
+	producedUnit := c.FormValue("produced_unit")
+	notes := c.FormValue("notes")
 
 	// VALIDATE //
 	result := <-s.CropReadQuery.FindByID(cropUID)
@@ -679,9 +914,31 @@ func (s *GrowthServer) WaterCrop(c echo.Context) error {
 		return Error(c, err)
 	}
 
-	wDate, err := time.Parse("2006-01-02 15:04", wateringDate)
+	if harvestedQuantity == "" {
+		return Error(c, NewRequestValidationError(REQUIRED, "harvested_quantity"))
+	}
+
+	harvestedQty, err := strconv.Atoi(harvestedQuantity)
 	if err != nil {
-		return Error(c, NewRequestValidationError(PARSE_FAILED, "watering_date"))
+		return Error(c, NewRequestValidationError(NUMERIC, "harvested_quantity"))
+	}
+
+	if producedQuantity == "" {
+		return Error(c, NewRequestValidationError(REQUIRED, "produced_quantity"))
+	}
+
+	prodQty, err := strconv.ParseFloat(producedQuantity, 32)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	prodUnit, err := s.findProducedUnit(cropRead.Inventory.UID, producedUnit)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	if prodUnit == (domain.ProducedUnit{}) {
+		return Error(c, NewRequestValidationError(INVALID_OPTION, "produced_unit"))
 	}
 
 	// PROCESS //
@@ -694,7 +951,7 @@ func (s *GrowthServer) WaterCrop(c echo.Context) error {
 
 	crop := repository.NewCropBatchFromHistory(events)
 
-	err = crop.Water(s.CropService, srcAreaUID, wDate)
+	err = crop.CorrectHarvest(s.CropService, srcAreaUID, harvestedQty, float32(prodQty), prodUnit, notes, getUserUID(c))
 	if err != nil {
 		return Error(c, err)
 	}
@@ -705,7 +962,7 @@ func (s *GrowthServer) WaterCrop(c echo.Context) error {
 		return Error(c, err)
 	}
 
-	// TRIGGER EVENTS //
+	// TRIGGER EVENTS
 	s.publishUncommittedEvents(crop)
 
 	data := make(map[string]storage.CropRead)
@@ -719,15 +976,17 @@ func (s *GrowthServer) WaterCrop(c echo.Context) error {
 	return c.JSON(http.StatusOK, data)
 }
 
-func (s *GrowthServer) SaveCropNotes(c echo.Context) error {
+func (s *GrowthServer) RevertDumpCrop(c echo.Context) error {
 	cropUID, err := uuid.FromString(c.Param("id"))
 	if err != nil {
 		return Error(c, err)
 	}
 
-	content := c.FormValue("content")
+	srcAreaID := c.FormValue("source_area_id")
+	quantity := c.FormValue("quantity")
+	notes := c.FormValue("notes")
 
-	// Validate //
+	// VALIDATE //
 	result := <-s.CropReadQuery.FindByID(cropUID)
 	if result.Error != nil {
 		return Error(c, result.Error)
@@ -735,18 +994,24 @@ func (s *GrowthServer) SaveCropNotes(c echo.Context) error {
 
 	cropRead, ok := result.Result.(storage.CropRead)
 	if !ok {
-		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+		return Error(c, echo.NewHTTPError(http.StatusBadRequest, "Internal server error"))
 	}
 
 	if cropRead.UID == (uuid.UUID{}) {
 		return Error(c, NewRequestValidationError(NOT_FOUND, "id"))
 	}
 
-	if content == "" {
-		return Error(c, NewRequestValidationError(REQUIRED, "content"))
+	srcAreaUID, err := uuid.FromString(srcAreaID)
+	if err != nil {
+		return Error(c, err)
 	}
 
-	// Process //
+	qty, err := strconv.Atoi(quantity)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// PROCESS //
 	eventQueryResult := <-s.CropEventQuery.FindAllByCropID(cropUID)
 	if eventQueryResult.Error != nil {
 		return Error(c, eventQueryResult.Error)
@@ -756,18 +1021,18 @@ func (s *GrowthServer) SaveCropNotes(c echo.Context) error {
 
 	crop := repository.NewCropBatchFromHistory(events)
 
-	err = crop.AddNewNote(content)
+	err = crop.RevertDump(s.CropService, srcAreaUID, qty, notes, getUserUID(c))
 	if err != nil {
 		return Error(c, err)
 	}
 
-	// Persists //
-	resultSave := <-s.CropEventRepo.Save(crop.UID, crop.Version, crop.UncommittedChanges)
-	if resultSave != nil {
-		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	// PERSIST //
+	err = <-s.CropEventRepo.Save(crop.UID, crop.Version, crop.UncommittedChanges)
+	if err != nil {
+		return Error(c, err)
 	}
 
-	// TRIGGER EVENTS //
+	// TRIGGER EVENTS
 	s.publishUncommittedEvents(crop)
 
 	data := make(map[string]storage.CropRead)
@@ -781,18 +1046,17 @@ func (s *GrowthServer) SaveCropNotes(c echo.Context) error {
 	return c.JSON(http.StatusOK, data)
 }
 
-func (s *GrowthServer) RemoveCropNotes(c echo.Context) error {
-	cropUID, err := uuid.FromString(c.Param("crop_id"))
+func (s *GrowthServer) UnarchiveCrop(c echo.Context) error {
+	cropUID, err := uuid.FromString(c.Param("id"))
 	if err != nil {
 		return Error(c, err)
 	}
 
-	noteUID, err := uuid.FromString(c.Param("note_id"))
-	if err != nil {
-		return Error(c, err)
-	}
+	areaID := c.FormValue("area_id")
+	quantity := c.FormValue("quantity")
+	notes := c.FormValue("notes")
 
-	// Validate //
+	// VALIDATE //
 	result := <-s.CropReadQuery.FindByID(cropUID)
 	if result.Error != nil {
 		return Error(c, result.Error)
@@ -800,14 +1064,28 @@ func (s *GrowthServer) RemoveCropNotes(c echo.Context) error {
 
 	cropRead, ok := result.Result.(storage.CropRead)
 	if !ok {
-		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+		return Error(c, echo.NewHTTPError(http.StatusBadRequest, "Internal server error"))
 	}
 
 	if cropRead.UID == (uuid.UUID{}) {
 		return Error(c, NewRequestValidationError(NOT_FOUND, "id"))
 	}
 
-	// Process //
+	if areaID == "" {
+		return Error(c, NewRequestValidationError(REQUIRED, "area_id"))
+	}
+
+	areaUID, err := uuid.FromString(areaID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	qty, err := strconv.Atoi(quantity)
+	if err != nil {
+		return Error(c, NewRequestValidationError(NUMERIC, "quantity"))
+	}
+
+	// PROCESS //
 	eventQueryResult := <-s.CropEventQuery.FindAllByCropID(cropUID)
 	if eventQueryResult.Error != nil {
 		return Error(c, eventQueryResult.Error)
@@ -817,18 +1095,18 @@ func (s *GrowthServer) RemoveCropNotes(c echo.Context) error {
 
 	crop := repository.NewCropBatchFromHistory(events)
 
-	err = crop.RemoveNote(noteUID)
+	err = crop.Unarchive(s.CropService, areaUID, qty, notes, getUserUID(c))
 	if err != nil {
 		return Error(c, err)
 	}
 
-	// Persists //
-	resultSave := <-s.CropEventRepo.Save(crop.UID, crop.Version, crop.UncommittedChanges)
-	if resultSave != nil {
-		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	// PERSIST //
+	err = <-s.CropEventRepo.Save(crop.UID, crop.Version, crop.UncommittedChanges)
+	if err != nil {
+		return Error(c, err)
 	}
 
-	// TRIGGER EVENTS //
+	// TRIGGER EVENTS
 	s.publishUncommittedEvents(crop)
 
 	data := make(map[string]storage.CropRead)
@@ -842,184 +1120,181 @@ func (s *GrowthServer) RemoveCropNotes(c echo.Context) error {
 	return c.JSON(http.StatusOK, data)
 }
 
-func (s *GrowthServer) FindAllCrops(c echo.Context) error {
-	data := make(map[string]interface{})
-
-	// Params //
-	farmID := c.Param("id")
-
-	status := c.QueryParam("status")
-	page := c.QueryParam("page")
-	limit := c.QueryParam("limit")
-
-	// Validate //
-	farmUID, err := uuid.FromString(farmID)
+func (s *GrowthServer) RevertMoveCrop(c echo.Context) error {
+	cropUID, err := uuid.FromString(c.Param("id"))
 	if err != nil {
 		return Error(c, err)
 	}
 
-	result := <-s.FarmReadQuery.FindByID(farmUID)
+	srcAreaID := c.FormValue("source_area_id")
+	dstAreaID := c.FormValue("destination_area_id")
+	quantity := c.FormValue("quantity")
+	notes := c.FormValue("notes")
+
+	// VALIDATE //
+	result := <-s.CropReadQuery.FindByID(cropUID)
 	if result.Error != nil {
 		return Error(c, result.Error)
 	}
 
-	farm, ok := result.Result.(query.CropFarmQueryResult)
+	cropRead, ok := result.Result.(storage.CropRead)
 	if !ok {
-		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+		return Error(c, echo.NewHTTPError(http.StatusBadRequest, "Internal server error"))
 	}
 
-	pageInt, limitInt, err := paginationhelper.ParsePagination(page, limit)
+	if cropRead.UID == (uuid.UUID{}) {
+		return Error(c, NewRequestValidationError(NOT_FOUND, "id"))
+	}
+
+	srcAreaUID, err := uuid.FromString(srcAreaID)
 	if err != nil {
 		return Error(c, err)
 	}
 
-	// Process //
-	resultQuery := <-s.CropReadQuery.FindAllCropsByFarm(farm.UID, status, pageInt, limitInt)
-	if resultQuery.Error != nil {
-		return Error(c, resultQuery.Error)
+	dstAreaUID, err := uuid.FromString(dstAreaID)
+	if err != nil {
+		return Error(c, err)
 	}
 
-	crops, ok := resultQuery.Result.([]storage.CropRead)
-	if !ok {
-		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	qty, err := strconv.Atoi(quantity)
+	if err != nil {
+		return Error(c, err)
 	}
 
-	resultQuery = <-s.CropReadQuery.CountAllCropsByFarm(farm.UID, status)
-	if resultQuery.Error != nil {
-		return Error(c, resultQuery.Error)
+	// PROCESS //
+	eventQueryResult := <-s.CropEventQuery.FindAllByCropID(cropUID)
+	if eventQueryResult.Error != nil {
+		return Error(c, eventQueryResult.Error)
 	}
 
-	total, ok := resultQuery.Result.(int)
-	if !ok {
-		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
-	}
+	events := eventQueryResult.Result.([]storage.CropEvent)
 
-	temp := []storage.CropRead{}
-	for _, v := range crops {
-		temp = append(temp, v)
-	}
+	crop := repository.NewCropBatchFromHistory(events)
 
-	data["data"] = temp
-	data["total_rows"] = total
-	data["page"] = pageInt
+	err = crop.RevertMove(s.CropService, srcAreaUID, dstAreaUID, qty, notes, getUserUID(c))
+	if err != nil {
+		return Error(c, err)
+	}
 
-	return c.JSON(http.StatusOK, data)
-}
+	// PERSIST //
+	err = <-s.CropEventRepo.Save(crop.UID, crop.Version, crop.UncommittedChanges)
+	if err != nil {
+		return Error(c, err)
+	}
 
-func (s *GrowthServer) FindAllCropArchives(c echo.Context) error {
-	// Params //
-	farmID := c.Param("id")
-	page := c.QueryParam("page")
-	limit := c.QueryParam("limit")
+	// TRIGGER EVENTS
+	s.publishUncommittedEvents(crop)
 
-	pageInt, limitInt, err := paginationhelper.ParsePagination(page, limit)
+	data := make(map[string]storage.CropRead)
+	cr, err := MapToCropRead(s, *crop)
 	if err != nil {
 		return Error(c, err)
 	}
 
-	// Validate //
-	farmUID, err := uuid.FromString(farmID)
+	data["data"] = cr
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *GrowthServer) WaterCrop(c echo.Context) error {
+	cropUID, err := uuid.FromString(c.Param("id"))
 	if err != nil {
 		return Error(c, err)
 	}
 
-	result := <-s.FarmReadQuery.FindByID(farmUID)
+	srcAreaID := c.FormValue("source_area_id")
+	wateringDate := c.FormValue("watering_date")
+
+	// VALIDATE //
+	result := <-s.CropReadQuery.FindByID(cropUID)
 	if result.Error != nil {
 		return Error(c, result.Error)
 	}
 
-	farm, ok := result.Result.(query.CropFarmQueryResult)
+	cropRead, ok := result.Result.(storage.CropRead)
 	if !ok {
-		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+		return Error(c, echo.NewHTTPError(http.StatusBadRequest, "Internal server error"))
 	}
 
-	// Process //
-	resultQuery := <-s.CropReadQuery.FindAllCropsArchives(farm.UID, pageInt, limitInt)
-	if resultQuery.Error != nil {
-		return Error(c, resultQuery.Error)
+	if cropRead.UID == (uuid.UUID{}) {
+		return Error(c, NewRequestValidationError(NOT_FOUND, "id"))
 	}
 
-	crops, ok := resultQuery.Result.([]storage.CropRead)
-	if !ok {
-		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	srcAreaUID, err := uuid.FromString(srcAreaID)
+	if err != nil {
+		return Error(c, err)
 	}
 
-	resultQuery = <-s.CropReadQuery.CountAllArchivedCropsByFarm(farm.UID)
-	if resultQuery.Error != nil {
-		return Error(c, resultQuery.Error)
+	wDate, err := time.Parse("2006-01-02 15:04", wateringDate)
+	if err != nil {
+		return Error(c, NewRequestValidationError(PARSE_FAILED, "watering_date"))
 	}
 
-	total, ok := resultQuery.Result.(int)
-	if !ok {
-		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	// PROCESS //
+	eventQueryResult := <-s.CropEventQuery.FindAllByCropID(cropUID)
+	if eventQueryResult.Error != nil {
+		return Error(c, eventQueryResult.Error)
 	}
 
-	data := make(map[string]interface{})
+	events := eventQueryResult.Result.([]storage.CropEvent)
 
-	temp := []storage.CropRead{}
-	for _, v := range crops {
-		temp = append(temp, v)
-	}
-	data["data"] = temp
-	data["total"] = total
-	data["page"] = pageInt
+	crop := repository.NewCropBatchFromHistory(events)
 
-	return c.JSON(http.StatusOK, data)
-}
+	err = crop.Water(s.CropService, srcAreaUID, wDate)
+	if err != nil {
+		return Error(c, err)
+	}
 
-func (s *GrowthServer) FindAllCropsByArea(c echo.Context) error {
-	data := make(map[string][]CropListInArea)
+	// PERSIST //
+	err = <-s.CropEventRepo.Save(crop.UID, crop.Version, crop.UncommittedChanges)
+	if err != nil {
+		return Error(c, err)
+	}
 
-	// Params //
-	areaID := c.Param("id")
+	// TRIGGER EVENTS //
+	s.publishUncommittedEvents(crop)
 
-	// Validate //
-	areaUID, err := uuid.FromString(areaID)
+	data := make(map[string]storage.CropRead)
+	cr, err := MapToCropRead(s, *crop)
 	if err != nil {
 		return Error(c, err)
 	}
 
-	result := <-s.AreaReadQuery.FindByID(areaUID)
-	if result.Error != nil {
-		return Error(c, result.Error)
-	}
+	data["data"] = cr
 
-	area, ok := result.Result.(query.CropAreaQueryResult)
-	if !ok {
-		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	return c.JSON(http.StatusOK, data)
+}
+
+// SaveBulkCropOperation applies one operation to many crop batches of the farm at once.
+// Every crop batch is validated first and nothing is committed if one of them is invalid.
+// Then each crop batch is committed on its own and the result is reported per crop batch.
+func (s *GrowthServer) SaveBulkCropOperation(c echo.Context) error {
+	farmUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
 	}
 
-	// Process //
-	resultQuery := <-s.CropReadQuery.FindAllCropsByArea(area.UID)
-	if resultQuery.Error != nil {
-		return Error(c, resultQuery.Error)
+	ids := c.FormValue("ids")
+	operation := c.FormValue("operation")
+
+	// Validate //
+	if ids == "" {
+		return Error(c, NewRequestValidationError(REQUIRED, "ids"))
 	}
 
-	crops, ok := resultQuery.Result.([]query.CropAreaByAreaQueryResult)
-	if !ok {
-		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	if operation == "" {
+		return Error(c, NewRequestValidationError(REQUIRED, "operation"))
 	}
 
-	data["data"] = []CropListInArea{}
-	for _, v := range crops {
-		cl, err := MapToCropListInArea(v)
-		if err != nil {
-			return Error(c, err)
+	isValidOperation := false
+	for _, v := range BulkCropOperations() {
+		if v == operation {
+			isValidOperation = true
 		}
-		data["data"] = append(data["data"], cl)
 	}
 
-	return c.JSON(http.StatusOK, data)
-}
-
-func (s *GrowthServer) GetBatchQuantity(c echo.Context) error {
-	// Params //
-	farmID := c.Param("id")
-
-	// Validate //
-	farmUID, err := uuid.FromString(farmID)
-	if err != nil {
-		return Error(c, err)
+	if !isValidOperation {
+		return Error(c, NewRequestValidationError(INVALID_OPTION, "operation"))
 	}
 
 	result := <-s.FarmReadQuery.FindByID(farmUID)
@@ -1036,39 +1311,217 @@ func (s *GrowthServer) GetBatchQuantity(c echo.Context) error {
 		return Error(c, NewRequestValidationError(NOT_FOUND, "id"))
 	}
 
+	cropUIDs := []uuid.UUID{}
+	isDuplicate := make(map[uuid.UUID]bool)
+	for _, v := range strings.Split(ids, ",") {
+		cropUID, err := uuid.FromString(strings.TrimSpace(v))
+		if err != nil {
+			return Error(c, NewRequestValidationError(PARSE_FAILED, "ids"))
+		}
+
+		if !isDuplicate[cropUID] {
+			cropUIDs = append(cropUIDs, cropUID)
+			isDuplicate[cropUID] = true
+		}
+	}
+
+	// apply runs the operation on a crop batch and returns the rotation warnings of the move operation
+	var apply func(crop *domain.Crop) ([]domain.RotationWarning, error)
+
+	switch operation {
+	case BulkCropOperationWater:
+		areaUID, err := uuid.FromString(c.FormValue("source_area_id"))
+		if err != nil {
+			return Error(c, NewRequestValidationError(PARSE_FAILED, "source_area_id"))
+		}
+
+		wDate, err := time.Parse("2006-01-02 15:04", c.FormValue("watering_date"))
+		if err != nil {
+			return Error(c, NewRequestValidationError(PARSE_FAILED, "watering_date"))
+		}
+
+		apply = func(crop *domain.Crop) ([]domain.RotationWarning, error) {
+			if crop.CurrentQuantityInArea(areaUID) <= 0 {
+				return nil, domain.CropError{Code: domain.CropWaterErrorSourceAreaNotFound}
+			}
+
+			return nil, crop.Water(s.CropService, areaUID, wDate)
+		}
+
+	case BulkCropOperationMove:
+		srcAreaUID, err := uuid.FromString(c.FormValue("source_area_id"))
+		if err != nil {
+			return Error(c, NewRequestValidationError(PARSE_FAILED, "source_area_id"))
+		}
+
+		dstAreaUID, err := uuid.FromString(c.FormValue("destination_area_id"))
+		if err != nil {
+			return Error(c, NewRequestValidationError(PARSE_FAILED, "destination_area_id"))
+		}
+
+		// Every crop batch is moved entirely from the source area
+		apply = func(crop *domain.Crop) ([]domain.RotationWarning, error) {
+			err := crop.MoveToArea(s.CropService, srcAreaUID, dstAreaUID, crop.CurrentQuantityInArea(srcAreaUID))
+			if err != nil {
+				return nil, err
+			}
+
+			queryResult := <-s.MaterialReadQuery.FindByID(crop.InventoryUID)
+			if queryResult.Error != nil {
+				return nil, queryResult.Error
+			}
+
+			material, ok := queryResult.Result.(query.CropMaterialQueryResult)
+			if !ok {
+				return nil, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error")
+			}
+
+			return s.checkCropRotation(dstAreaUID, crop.UID, material.PlantFamily, time.Now())
+		}
+
+	case BulkCropOperationHarvest:
+		srcAreaUID, err := uuid.FromString(c.FormValue("source_area_id"))
+		if err != nil {
+			return Error(c, NewRequestValidationError(PARSE_FAILED, "source_area_id"))
+		}
+
+		producedQuantity := c.FormValue("produced_quantity")
+		if producedQuantity == "" {
+			return Error(c, NewRequestValidationError(REQUIRED, "produced_quantity"))
+		}
+
+		prodQty, err := strconv.ParseFloat(producedQuantity, 32)
+		if err != nil {
+			return Error(c, NewRequestValidationError(NUMERIC, "produced_quantity"))
+		}
+
+		producedUnit := c.FormValue("produced_unit")
+		if producedUnit == "" {
+			return Error(c, NewRequestValidationError(REQUIRED, "produced_unit"))
+		}
+
+		// Harvests without grade are graded as A
+		grade := c.FormValue("grade")
+		if grade == "" {
+			grade = domain.HarvestGradeA
+		}
+
+		hg := domain.GetHarvestGrade(grade)
+		if hg == (domain.HarvestGrade{}) {
+			return Error(c, NewRequestValidationError(INVALID_OPTION, "grade"))
+		}
+
+		notes := c.FormValue("notes")
+
+		// Every crop batch is harvested entirely from the source area,
+		// and the produced quantity is the one of each crop batch
+		// The unit is found for each crop batch because a count unit can be of its inventory only
+		apply = func(crop *domain.Crop) ([]domain.RotationWarning, error) {
+			prodUnit, err := s.findProducedUnit(crop.InventoryUID, producedUnit)
+			if err != nil {
+				return nil, err
+			}
+
+			return nil, crop.Harvest(s.CropService, srcAreaUID, domain.HarvestTypeAll, float32(prodQty), prodUnit, hg.Code, notes)
+		}
+
+	case BulkCropOperationNote:
+		content := c.FormValue("content")
+		if content == "" {
+			return Error(c, NewRequestValidationError(REQUIRED, "content"))
+		}
+
+		apply = func(crop *domain.Crop) ([]domain.RotationWarning, error) {
+			return nil, crop.AddNewNote(content)
+		}
+	}
+
 	// Process //
-	resultQuery := <-s.CropReadQuery.CountTotalBatch(farm.UID)
-	if resultQuery.Error != nil {
-		return Error(c, resultQuery.Error)
+	crops := []*domain.Crop{}
+	results := []BulkCropResult{}
+	isValid := true
+
+	for _, cropUID := range cropUIDs {
+		bulkResult := BulkCropResult{UID: cropUID, Status: BulkCropResultNotProcessed}
+
+		result := <-s.CropReadQuery.FindByID(cropUID)
+		if result.Error != nil {
+			return Error(c, result.Error)
+		}
+
+		cropRead, ok := result.Result.(storage.CropRead)
+		if !ok {
+			return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+		}
+
+		if cropRead.UID == (uuid.UUID{}) || cropRead.FarmUID != farm.UID {
+			bulkResult.fail(BulkCropResultInvalid, NewRequestValidationError(NOT_FOUND, "ids"))
+			results = append(results, bulkResult)
+			isValid = false
+
+			continue
+		}
+
+		bulkResult.BatchID = cropRead.BatchID
+
+		eventQueryResult := <-s.CropEventQuery.FindAllByCropID(cropUID)
+		if eventQueryResult.Error != nil {
+			return Error(c, eventQueryResult.Error)
+		}
+
+		events := eventQueryResult.Result.([]storage.CropEvent)
+
+		crop := repository.NewCropBatchFromHistory(events)
+
+		warnings, err := apply(crop)
+		if err != nil {
+			bulkResult.fail(BulkCropResultInvalid, err)
+			isValid = false
+		}
+
+		bulkResult.Warnings = warnings
+
+		crops = append(crops, crop)
+		results = append(results, bulkResult)
 	}
 
-	varQty, ok := resultQuery.Result.([]query.CountTotalBatchQueryResult)
-	if !ok {
-		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	data := make(map[string][]BulkCropResult)
+
+	if !isValid {
+		data["data"] = results
+
+		return c.JSON(http.StatusBadRequest, data)
 	}
 
-	data := make(map[string][]query.CountTotalBatchQueryResult)
-	data["data"] = []query.CountTotalBatchQueryResult{}
-	for _, v := range varQty {
-		data["data"] = append(data["data"], v)
+	// Persists //
+	for i, crop := range crops {
+		err := <-s.CropEventRepo.Save(crop.UID, crop.Version, crop.UncommittedChanges)
+		if err != nil {
+			results[i].fail(BulkCropResultFailed, err)
+
+			continue
+		}
+
+		// TRIGGER EVENTS //
+		s.publishUncommittedEvents(crop)
+
+		results[i].Status = BulkCropResultSuccess
 	}
 
+	data["data"] = results
+
 	return c.JSON(http.StatusOK, data)
 }
 
-func (s *GrowthServer) UploadCropPhotos(c echo.Context) error {
-	description := c.FormValue("description")
+func (s *GrowthServer) SaveCropNotes(c echo.Context) error {
 	cropUID, err := uuid.FromString(c.Param("id"))
 	if err != nil {
 		return Error(c, err)
 	}
 
-	// Validate //
-	photo, err := c.FormFile("photo")
-	if err != nil {
-		return Error(c, NewRequestValidationError(REQUIRED, "photo"))
-	}
+	content := c.FormValue("content")
 
+	// Validate //
 	result := <-s.CropReadQuery.FindByID(cropUID)
 	if result.Error != nil {
 		return Error(c, result.Error)
@@ -1076,14 +1529,18 @@ func (s *GrowthServer) UploadCropPhotos(c echo.Context) error {
 
 	cropRead, ok := result.Result.(storage.CropRead)
 	if !ok {
-		return Error(c, echo.NewHTTPError(http.StatusBadRequest, "Internal server error"))
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
 	}
 
 	if cropRead.UID == (uuid.UUID{}) {
 		return Error(c, NewRequestValidationError(NOT_FOUND, "id"))
 	}
 
-	// Process
+	if content == "" {
+		return Error(c, NewRequestValidationError(REQUIRED, "content"))
+	}
+
+	// Process //
 	eventQueryResult := <-s.CropEventQuery.FindAllByCropID(cropUID)
 	if eventQueryResult.Error != nil {
 		return Error(c, eventQueryResult.Error)
@@ -1093,25 +1550,7 @@ func (s *GrowthServer) UploadCropPhotos(c echo.Context) error {
 
 	crop := repository.NewCropBatchFromHistory(events)
 
-	destPath := stringhelper.Join(*config.Config.UploadPathCrop, "/", photo.Filename)
-	err = s.File.Upload(photo, destPath)
-	if err != nil {
-		return Error(c, err)
-	}
-
-	width, height, err := imagehelper.GetImageDimension(destPath)
-	if err != nil {
-		return Error(c, err)
-	}
-
-	err = crop.AddPhoto(
-		photo.Filename,
-		photo.Header["Content-Type"][0],
-		int(photo.Size),
-		width,
-		height,
-		description,
-	)
+	err = crop.AddNewNote(content)
 	if err != nil {
 		return Error(c, err)
 	}
@@ -1136,13 +1575,13 @@ func (s *GrowthServer) UploadCropPhotos(c echo.Context) error {
 	return c.JSON(http.StatusOK, data)
 }
 
-func (s *GrowthServer) GetCropPhotos(c echo.Context) error {
+func (s *GrowthServer) RemoveCropNotes(c echo.Context) error {
 	cropUID, err := uuid.FromString(c.Param("crop_id"))
 	if err != nil {
 		return Error(c, err)
 	}
 
-	photoUID, err := uuid.FromString(c.Param("photo_id"))
+	noteUID, err := uuid.FromString(c.Param("note_id"))
 	if err != nil {
 		return Error(c, err)
 	}
@@ -1155,86 +1594,2761 @@ func (s *GrowthServer) GetCropPhotos(c echo.Context) error {
 
 	cropRead, ok := result.Result.(storage.CropRead)
 	if !ok {
-		return Error(c, echo.NewHTTPError(http.StatusBadRequest, "Internal server error"))
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
 	}
 
 	if cropRead.UID == (uuid.UUID{}) {
-		return Error(c, NewRequestValidationError(NOT_FOUND, "crop_id"))
-	}
-
-	found := storage.CropPhoto{}
-	for _, v := range cropRead.Photos {
-		if v.UID == photoUID {
-			found = v
-		}
+		return Error(c, NewRequestValidationError(NOT_FOUND, "id"))
 	}
 
-	if found == (storage.CropPhoto{}) {
-		return Error(c, NewRequestValidationError(NOT_FOUND, "photo_id"))
+	// Process //
+	eventQueryResult := <-s.CropEventQuery.FindAllByCropID(cropUID)
+	if eventQueryResult.Error != nil {
+		return Error(c, eventQueryResult.Error)
 	}
 
-	// Process //
-	srcPath := stringhelper.Join(*config.Config.UploadPathCrop, "/", found.Filename)
+	events := eventQueryResult.Result.([]storage.CropEvent)
 
-	return c.File(srcPath)
-}
+	crop := repository.NewCropBatchFromHistory(events)
+
+	err = crop.RemoveNote(noteUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Persists //
+	resultSave := <-s.CropEventRepo.Save(crop.UID, crop.Version, crop.UncommittedChanges)
+	if resultSave != nil {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	// TRIGGER EVENTS //
+	s.publishUncommittedEvents(crop)
+
+	data := make(map[string]storage.CropRead)
+	cr, err := MapToCropRead(s, *crop)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	data["data"] = cr
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *GrowthServer) FindAllCrops(c echo.Context) error {
+	data := make(map[string]interface{})
+
+	// Params //
+	farmID := c.Param("id")
+
+	status := c.QueryParam("status")
+	page := c.QueryParam("page")
+	limit := c.QueryParam("limit")
+
+	// Validate //
+	farmUID, err := uuid.FromString(farmID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	result := <-s.FarmReadQuery.FindByID(farmUID)
+	if result.Error != nil {
+		return Error(c, result.Error)
+	}
+
+	farm, ok := result.Result.(query.CropFarmQueryResult)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	pageInt, limitInt, err := paginationhelper.ParsePagination(page, limit)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Process //
+	resultQuery := <-s.CropReadQuery.FindAllCropsByFarm(farm.UID, status, pageInt, limitInt)
+	if resultQuery.Error != nil {
+		return Error(c, resultQuery.Error)
+	}
+
+	crops, ok := resultQuery.Result.([]storage.CropRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	resultQuery = <-s.CropReadQuery.CountAllCropsByFarm(farm.UID, status)
+	if resultQuery.Error != nil {
+		return Error(c, resultQuery.Error)
+	}
+
+	total, ok := resultQuery.Result.(int)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	temp := []storage.CropRead{}
+	for _, v := range crops {
+		temp = append(temp, v)
+	}
+
+	data["data"] = temp
+	data["total_rows"] = total
+	data["page"] = pageInt
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *GrowthServer) FindAllCropArchives(c echo.Context) error {
+	// Params //
+	farmID := c.Param("id")
+	page := c.QueryParam("page")
+	limit := c.QueryParam("limit")
+
+	pageInt, limitInt, err := paginationhelper.ParsePagination(page, limit)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	filter, err := parseCropArchiveFilter(c)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Validate //
+	farmUID, err := uuid.FromString(farmID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	result := <-s.FarmReadQuery.FindByID(farmUID)
+	if result.Error != nil {
+		return Error(c, result.Error)
+	}
+
+	farm, ok := result.Result.(query.CropFarmQueryResult)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	// Process //
+	resultQuery := <-s.CropReadQuery.FindAllCropsArchives(farm.UID, filter, pageInt, limitInt)
+	if resultQuery.Error != nil {
+		return Error(c, resultQuery.Error)
+	}
+
+	crops, ok := resultQuery.Result.([]storage.CropRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	resultQuery = <-s.CropReadQuery.CountAllArchivedCropsByFarm(farm.UID, filter)
+	if resultQuery.Error != nil {
+		return Error(c, resultQuery.Error)
+	}
+
+	total, ok := resultQuery.Result.(int)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	data := make(map[string]interface{})
+
+	temp := []storage.CropRead{}
+	for _, v := range crops {
+		temp = append(temp, v)
+	}
+	data["data"] = temp
+	data["total"] = total
+	data["page"] = pageInt
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *GrowthServer) FindAllHarvestLotsByFarm(c echo.Context) error {
+	data := make(map[string][]storage.HarvestLot)
+
+	farmUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	harvestDate := c.QueryParam("date")
+
+	// Validate //
+	result := <-s.FarmReadQuery.FindByID(farmUID)
+	if result.Error != nil {
+		return Error(c, result.Error)
+	}
+
+	farm, ok := result.Result.(query.CropFarmQueryResult)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusBadRequest, "Internal server error"))
+	}
+
+	if farm.UID == (uuid.UUID{}) {
+		return Error(c, NewRequestValidationError(NOT_FOUND, "id"))
+	}
+
+	var date *time.Time
+	if harvestDate != "" {
+		d, err := time.ParseInLocation("2006-01-02", harvestDate, time.Local)
+		if err != nil {
+			return Error(c, NewRequestValidationError(PARSE_FAILED, "date"))
+		}
+
+		date = &d
+	}
+
+	// Process //
+	result = <-s.HarvestLotQuery.FindAllByFarm(farm.UID, date)
+	if result.Error != nil {
+		return Error(c, result.Error)
+	}
+
+	harvestLots, ok := result.Result.([]storage.HarvestLot)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	data["data"] = harvestLots
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *GrowthServer) FindAllHarvestLotsByCrop(c echo.Context) error {
+	data := make(map[string][]storage.HarvestLot)
 
-func (s *GrowthServer) GetCropActivities(c echo.Context) error {
 	cropUID, err := uuid.FromString(c.Param("id"))
 	if err != nil {
 		return Error(c, err)
 	}
 
-	result := <-s.CropReadQuery.FindByID(cropUID)
+	// Validate //
+	result := <-s.CropReadQuery.FindByID(cropUID)
+	if result.Error != nil {
+		return Error(c, result.Error)
+	}
+
+	cropRead, ok := result.Result.(storage.CropRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	if cropRead.UID == (uuid.UUID{}) {
+		return Error(c, NewRequestValidationError(NOT_FOUND, "id"))
+	}
+
+	// Process //
+	result = <-s.HarvestLotQuery.FindAllByCrop(cropRead.UID)
+	if result.Error != nil {
+		return Error(c, result.Error)
+	}
+
+	harvestLots, ok := result.Result.([]storage.HarvestLot)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	data["data"] = harvestLots
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// GetTraceability returns the lineage of the crop batches of a seed material, of a crop batch,
+// or of a harvest lot, from the seed material to the harvest lots
+func (s *GrowthServer) GetTraceability(c echo.Context) error {
+	farmUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	materialID := c.QueryParam("material_id")
+	cropID := c.QueryParam("crop_id")
+	harvestLotID := c.QueryParam("harvest_lot_id")
+	format := c.QueryParam("format")
+
+	// Validate //
+	total := 0
+	for _, v := range []string{materialID, cropID, harvestLotID} {
+		if v != "" {
+			total++
+		}
+	}
+
+	if total == 0 {
+		return Error(c, NewRequestValidationError(REQUIRED, "material_id"))
+	}
+
+	if total > 1 {
+		return Error(c, NewRequestValidationError(INVALID_OPTION, "material_id"))
+	}
+
+	if format == "" {
+		format = "json"
+	}
+
+	if format != "json" && format != "pdf" {
+		return Error(c, NewRequestValidationError(INVALID_OPTION, "format"))
+	}
+
+	result := <-s.FarmReadQuery.FindByID(farmUID)
+	if result.Error != nil {
+		return Error(c, result.Error)
+	}
+
+	farm, ok := result.Result.(query.CropFarmQueryResult)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	if farm.UID == (uuid.UUID{}) {
+		return Error(c, NewRequestValidationError(NOT_FOUND, "id"))
+	}
+
+	// Process //
+	traceability := Traceability{
+		Crops:         []TraceabilityCrop{},
+		GeneratedDate: time.Now(),
+	}
+
+	var materialUID uuid.UUID
+
+	switch {
+	case materialID != "":
+		materialUID, err = uuid.FromString(materialID)
+		if err != nil {
+			return Error(c, NewRequestValidationError(PARSE_FAILED, "material_id"))
+		}
+
+		result := <-s.CropReadQuery.FindAllCropsByInventory(materialUID)
+		if result.Error != nil {
+			return Error(c, result.Error)
+		}
+
+		crops, ok := result.Result.([]storage.CropRead)
+		if !ok {
+			return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+		}
+
+		for _, v := range crops {
+			if v.FarmUID != farm.UID {
+				continue
+			}
+
+			tc, err := s.traceCrop(v, nil)
+			if err != nil {
+				return Error(c, err)
+			}
+
+			traceability.Crops = append(traceability.Crops, tc)
+		}
+
+	case cropID != "":
+		cropUID, err := uuid.FromString(cropID)
+		if err != nil {
+			return Error(c, NewRequestValidationError(PARSE_FAILED, "crop_id"))
+		}
+
+		result := <-s.CropReadQuery.FindByID(cropUID)
+		if result.Error != nil {
+			return Error(c, result.Error)
+		}
+
+		cropRead, ok := result.Result.(storage.CropRead)
+		if !ok {
+			return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+		}
+
+		if cropRead.UID == (uuid.UUID{}) || cropRead.FarmUID != farm.UID {
+			return Error(c, NewRequestValidationError(NOT_FOUND, "crop_id"))
+		}
+
+		tc, err := s.traceCrop(cropRead, nil)
+		if err != nil {
+			return Error(c, err)
+		}
+
+		materialUID = cropRead.Inventory.UID
+		traceability.Crops = append(traceability.Crops, tc)
+
+	default:
+		harvestLotUID, err := uuid.FromString(harvestLotID)
+		if err != nil {
+			return Error(c, NewRequestValidationError(PARSE_FAILED, "harvest_lot_id"))
+		}
+
+		result := <-s.HarvestLotQuery.FindByID(harvestLotUID)
+		if result.Error != nil {
+			return Error(c, result.Error)
+		}
+
+		harvestLot, ok := result.Result.(storage.HarvestLot)
+		if !ok {
+			return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+		}
+
+		if harvestLot.UID == (uuid.UUID{}) || harvestLot.FarmUID != farm.UID {
+			return Error(c, NewRequestValidationError(NOT_FOUND, "harvest_lot_id"))
+		}
+
+		result = <-s.CropReadQuery.FindByID(harvestLot.CropUID)
+		if result.Error != nil {
+			return Error(c, result.Error)
+		}
+
+		cropRead, ok := result.Result.(storage.CropRead)
+		if !ok {
+			return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+		}
+
+		if cropRead.UID == (uuid.UUID{}) {
+			return Error(c, NewRequestValidationError(NOT_FOUND, "harvest_lot_id"))
+		}
+
+		tc, err := s.traceCrop(cropRead, &harvestLot)
+		if err != nil {
+			return Error(c, err)
+		}
+
+		materialUID = cropRead.Inventory.UID
+		traceability.Crops = append(traceability.Crops, tc)
+	}
+
+	result = <-s.MaterialReadQuery.FindByID(materialUID)
+	if result.Error != nil {
+		return Error(c, result.Error)
+	}
+
+	material, ok := result.Result.(query.CropMaterialQueryResult)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	if material.UID == (uuid.UUID{}) && materialID != "" {
+		return Error(c, NewRequestValidationError(NOT_FOUND, "material_id"))
+	}
+
+	if material.UID != (uuid.UUID{}) {
+		traceability.Material = NewTraceabilityMaterial(material)
+	}
+
+	if format == "pdf" {
+		report, err := RenderTraceabilityPDF(traceability)
+		if err != nil {
+			return Error(c, err)
+		}
+
+		c.Response().Header().Set(echo.HeaderContentDisposition, "inline; filename=\"traceability.pdf\"")
+
+		return c.Blob(http.StatusOK, "application/pdf", report)
+	}
+
+	data := make(map[string]Traceability)
+	data["data"] = traceability
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// traceCrop gathers the activities and harvest lots of a crop batch.
+// When harvestLot is not nil, the lineage stops at that harvest lot.
+func (s *GrowthServer) traceCrop(cropRead storage.CropRead, harvestLot *storage.HarvestLot) (TraceabilityCrop, error) {
+	result := <-s.CropActivityQuery.FindAllByCropID(cropRead.UID)
+	if result.Error != nil {
+		return TraceabilityCrop{}, result.Error
+	}
+
+	activities, ok := result.Result.([]storage.CropActivity)
+	if !ok {
+		return TraceabilityCrop{}, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error")
+	}
+
+	if harvestLot != nil {
+		return NewTraceabilityCrop(cropRead, activities, []storage.HarvestLot{*harvestLot}, &harvestLot.HarvestDate), nil
+	}
+
+	result = <-s.HarvestLotQuery.FindAllByCrop(cropRead.UID)
+	if result.Error != nil {
+		return TraceabilityCrop{}, result.Error
+	}
+
+	harvestLots, ok := result.Result.([]storage.HarvestLot)
+	if !ok {
+		return TraceabilityCrop{}, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error")
+	}
+
+	return NewTraceabilityCrop(cropRead, activities, harvestLots, nil), nil
+}
+
+// GetCropMetrics returns the germination and survival rates of a crop batch
+func (s *GrowthServer) GetCropMetrics(c echo.Context) error {
+	cropUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Validate //
+	result := <-s.CropReadQuery.FindByID(cropUID)
+	if result.Error != nil {
+		return Error(c, result.Error)
+	}
+
+	cropRead, ok := result.Result.(storage.CropRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	if cropRead.UID == (uuid.UUID{}) {
+		return Error(c, NewRequestValidationError(NOT_FOUND, "id"))
+	}
+
+	// Process //
+	areaTypes := make(map[uuid.UUID]string)
+
+	err = s.findCropAreaTypes(cropRead, areaTypes)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	data := make(map[string]CropMetrics)
+	data["data"] = NewCropMetrics(cropRead, areaTypes)
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// GetFarmCropMetrics returns the germination and survival rates of every crop batch of the farm,
+// the archived ones included, or aggregated by variety or by area with the group_by param
+func (s *GrowthServer) GetFarmCropMetrics(c echo.Context) error {
+	farmUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	groupBy := c.QueryParam("group_by")
+
+	// Validate //
+	if groupBy != "" && groupBy != "variety" && groupBy != "area" {
+		return Error(c, NewRequestValidationError(INVALID_OPTION, "group_by"))
+	}
+
+	result := <-s.FarmReadQuery.FindByID(farmUID)
+	if result.Error != nil {
+		return Error(c, result.Error)
+	}
+
+	farm, ok := result.Result.(query.CropFarmQueryResult)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	if farm.UID == (uuid.UUID{}) {
+		return Error(c, NewRequestValidationError(NOT_FOUND, "id"))
+	}
+
+	// Process //
+	result = <-s.CropReadQuery.FindAllCropsByFarmWithArchives(farm.UID)
+	if result.Error != nil {
+		return Error(c, result.Error)
+	}
+
+	crops, ok := result.Result.([]storage.CropRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	areaTypes := make(map[uuid.UUID]string)
+	cropMetrics := []CropMetrics{}
+
+	for _, v := range crops {
+		err = s.findCropAreaTypes(v, areaTypes)
+		if err != nil {
+			return Error(c, err)
+		}
+
+		cropMetrics = append(cropMetrics, NewCropMetrics(v, areaTypes))
+	}
+
+	data := make(map[string]interface{})
+
+	switch groupBy {
+	case "variety":
+		data["data"] = AggregateCropMetricsByVariety(cropMetrics)
+	case "area":
+		data["data"] = AggregateCropMetricsByArea(cropMetrics)
+	default:
+		data["data"] = cropMetrics
+	}
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// findCropAreaTypes adds the type of the areas the crop batch has lived in to areaTypes.
+// The areas already in areaTypes aren't queried again.
+func (s *GrowthServer) findCropAreaTypes(cropRead storage.CropRead, areaTypes map[uuid.UUID]string) error {
+	areaUIDs := []uuid.UUID{cropRead.InitialArea.AreaUID}
+	for _, v := range cropRead.MovedArea {
+		areaUIDs = append(areaUIDs, v.AreaUID)
+	}
+
+	for _, v := range areaUIDs {
+		if _, ok := areaTypes[v]; ok {
+			continue
+		}
+
+		result := <-s.AreaReadQuery.FindByID(v)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		area, ok := result.Result.(query.CropAreaQueryResult)
+		if !ok {
+			return echo.NewHTTPError(http.StatusInternalServerError, "Internal server error")
+		}
+
+		areaTypes[v] = area.Type
+	}
+
+	return nil
+}
+
+func (s *GrowthServer) FindAllCropsByArea(c echo.Context) error {
+	data := make(map[string][]CropListInArea)
+
+	// Params //
+	areaID := c.Param("id")
+
+	// Validate //
+	areaUID, err := uuid.FromString(areaID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	result := <-s.AreaReadQuery.FindByID(areaUID)
+	if result.Error != nil {
+		return Error(c, result.Error)
+	}
+
+	area, ok := result.Result.(query.CropAreaQueryResult)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	// Process //
+	resultQuery := <-s.CropReadQuery.FindAllCropsByArea(area.UID)
+	if resultQuery.Error != nil {
+		return Error(c, resultQuery.Error)
+	}
+
+	crops, ok := resultQuery.Result.([]query.CropAreaByAreaQueryResult)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	data["data"] = []CropListInArea{}
+	for _, v := range crops {
+		cl, err := MapToCropListInArea(v)
+		if err != nil {
+			return Error(c, err)
+		}
+		data["data"] = append(data["data"], cl)
+	}
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *GrowthServer) GetAreaPlantingHistory(c echo.Context) error {
+	data := make(map[string][]storage.PlantingHistory)
+
+	// Validate //
+	areaUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, NewRequestValidationError(NOT_FOUND, "id"))
+	}
+
+	result := <-s.AreaReadQuery.FindByID(areaUID)
+	if result.Error != nil {
+		return Error(c, result.Error)
+	}
+
+	area, ok := result.Result.(query.CropAreaQueryResult)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	if area.UID == (uuid.UUID{}) {
+		return Error(c, NewRequestValidationError(NOT_FOUND, "id"))
+	}
+
+	// Process //
+	histories, err := s.findAreaPlantingHistories(area.UID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	data["data"] = histories
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *GrowthServer) GetBatchQuantity(c echo.Context) error {
+	// Params //
+	farmID := c.Param("id")
+
+	// Validate //
+	farmUID, err := uuid.FromString(farmID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	result := <-s.FarmReadQuery.FindByID(farmUID)
+	if result.Error != nil {
+		return Error(c, result.Error)
+	}
+
+	farm, ok := result.Result.(query.CropFarmQueryResult)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	if farm.UID == (uuid.UUID{}) {
+		return Error(c, NewRequestValidationError(NOT_FOUND, "id"))
+	}
+
+	// Process //
+	resultQuery := <-s.CropReadQuery.CountTotalBatch(farm.UID)
+	if resultQuery.Error != nil {
+		return Error(c, resultQuery.Error)
+	}
+
+	varQty, ok := resultQuery.Result.([]query.CountTotalBatchQueryResult)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	data := make(map[string][]query.CountTotalBatchQueryResult)
+	data["data"] = []query.CountTotalBatchQueryResult{}
+	for _, v := range varQty {
+		data["data"] = append(data["data"], v)
+	}
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *GrowthServer) UploadCropPhotos(c echo.Context) error {
+	description := c.FormValue("description")
+	cropUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Validate //
+	photo, err := c.FormFile("photo")
+	if err != nil {
+		return Error(c, NewRequestValidationError(REQUIRED, "photo"))
+	}
+
+	result := <-s.CropReadQuery.FindByID(cropUID)
+	if result.Error != nil {
+		return Error(c, result.Error)
+	}
+
+	cropRead, ok := result.Result.(storage.CropRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusBadRequest, "Internal server error"))
+	}
+
+	if cropRead.UID == (uuid.UUID{}) {
+		return Error(c, NewRequestValidationError(NOT_FOUND, "id"))
+	}
+
+	// Process
+	eventQueryResult := <-s.CropEventQuery.FindAllByCropID(cropUID)
+	if eventQueryResult.Error != nil {
+		return Error(c, eventQueryResult.Error)
+	}
+
+	events := eventQueryResult.Result.([]storage.CropEvent)
+
+	crop := repository.NewCropBatchFromHistory(events)
+
+	photoUID, err := uuid.NewV4()
+	if err != nil {
+		return Error(c, err)
+	}
+
+	destPath := cropPhotoPath(domain.CropPhoto{UID: photoUID, Filename: photo.Filename})
+	err = s.File.Upload(photo, destPath)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	width, height, err := imagehelper.GetImageDimension(destPath)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	err = imagehelper.CreateThumbnails(destPath)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	err = crop.AddPhoto(
+		photoUID,
+		photo.Filename,
+		photo.Header["Content-Type"][0],
+		int(photo.Size),
+		width,
+		height,
+		description,
+	)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Persists //
+	resultSave := <-s.CropEventRepo.Save(crop.UID, crop.Version, crop.UncommittedChanges)
+	if resultSave != nil {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	// TRIGGER EVENTS //
+	s.publishUncommittedEvents(crop)
+
+	data := make(map[string]storage.CropRead)
+	cr, err := MapToCropRead(s, *crop)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	data["data"] = cr
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *GrowthServer) GetCropPhotos(c echo.Context) error {
+	cropUID, err := uuid.FromString(c.Param("crop_id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	photoUID, err := uuid.FromString(c.Param("photo_id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	size := c.QueryParam("size")
+
+	// Validate //
+	if size != "" && !imagehelper.IsValidThumbnailSize(size) {
+		return Error(c, NewRequestValidationError(INVALID_OPTION, "size"))
+	}
+
+	result := <-s.CropReadQuery.FindByID(cropUID)
+	if result.Error != nil {
+		return Error(c, result.Error)
+	}
+
+	cropRead, ok := result.Result.(storage.CropRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusBadRequest, "Internal server error"))
+	}
+
+	if cropRead.UID == (uuid.UUID{}) {
+		return Error(c, NewRequestValidationError(NOT_FOUND, "crop_id"))
+	}
+
+	found := storage.CropPhoto{}
+	for _, v := range cropRead.Photos {
+		if v.UID == photoUID {
+			found = v
+		}
+	}
+
+	if found == (storage.CropPhoto{}) {
+		return Error(c, NewRequestValidationError(NOT_FOUND, "photo_id"))
+	}
+
+	// Process //
+	srcPath := cropPhotoPath(domain.CropPhoto{UID: found.UID, Filename: found.Filename})
+
+	if size != "" {
+		srcPath, err = imagehelper.GetThumbnail(srcPath, size)
+		if err != nil {
+			return Error(c, err)
+		}
+	}
+
+	return c.File(srcPath)
+}
+
+// cropPhotoPath is the file of the photo, named after its UID
+func cropPhotoPath(photo domain.CropPhoto) string {
+	return stringhelper.Join(*config.Config.UploadPathCrop, "/", photo.StoredFilename())
+}
+
+func (s *GrowthServer) UpdateCropPhoto(c echo.Context) error {
+	cropUID, err := uuid.FromString(c.Param("crop_id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	photoUID, err := uuid.FromString(c.Param("photo_id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	description := c.FormValue("description")
+
+	// Validate //
+	if description == "" {
+		return Error(c, NewRequestValidationError(REQUIRED, "description"))
+	}
+
+	result := <-s.CropReadQuery.FindByID(cropUID)
+	if result.Error != nil {
+		return Error(c, result.Error)
+	}
+
+	cropRead, ok := result.Result.(storage.CropRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	if cropRead.UID == (uuid.UUID{}) {
+		return Error(c, NewRequestValidationError(NOT_FOUND, "crop_id"))
+	}
+
+	// Process //
+	eventQueryResult := <-s.CropEventQuery.FindAllByCropID(cropUID)
+	if eventQueryResult.Error != nil {
+		return Error(c, eventQueryResult.Error)
+	}
+
+	events := eventQueryResult.Result.([]storage.CropEvent)
+
+	crop := repository.NewCropBatchFromHistory(events)
+
+	err = crop.ChangePhotoDescription(photoUID, description)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Persists //
+	resultSave := <-s.CropEventRepo.Save(crop.UID, crop.Version, crop.UncommittedChanges)
+	if resultSave != nil {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	// TRIGGER EVENTS //
+	s.publishUncommittedEvents(crop)
+
+	data := make(map[string]storage.CropRead)
+	cr, err := MapToCropRead(s, *crop)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	data["data"] = cr
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *GrowthServer) RemoveCropPhoto(c echo.Context) error {
+	cropUID, err := uuid.FromString(c.Param("crop_id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	photoUID, err := uuid.FromString(c.Param("photo_id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Validate //
+	result := <-s.CropReadQuery.FindByID(cropUID)
+	if result.Error != nil {
+		return Error(c, result.Error)
+	}
+
+	cropRead, ok := result.Result.(storage.CropRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	if cropRead.UID == (uuid.UUID{}) {
+		return Error(c, NewRequestValidationError(NOT_FOUND, "crop_id"))
+	}
+
+	// Process //
+	eventQueryResult := <-s.CropEventQuery.FindAllByCropID(cropUID)
+	if eventQueryResult.Error != nil {
+		return Error(c, eventQueryResult.Error)
+	}
+
+	events := eventQueryResult.Result.([]storage.CropEvent)
+
+	crop := repository.NewCropBatchFromHistory(events)
+
+	removed := domain.CropPhoto{}
+	for _, v := range crop.Photos {
+		if v.UID == photoUID {
+			removed = v
+		}
+	}
+
+	err = crop.RemovePhoto(photoUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Persists //
+	resultSave := <-s.CropEventRepo.Save(crop.UID, crop.Version, crop.UncommittedChanges)
+	if resultSave != nil {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	srcPath := cropPhotoPath(removed)
+
+	paths := []string{srcPath}
+	for size := range imagehelper.ThumbnailSizes {
+		paths = append(paths, imagehelper.GetThumbnailPath(srcPath, size))
+	}
+
+	for _, v := range paths {
+		err = s.File.Remove(v)
+		if err != nil {
+			log.Error(err)
+		}
+	}
+
+	// TRIGGER EVENTS //
+	s.publishUncommittedEvents(crop)
+
+	data := make(map[string]storage.CropRead)
+	cr, err := MapToCropRead(s, *crop)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	data["data"] = cr
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *GrowthServer) GetCropLabels(c echo.Context) error {
+	farmUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	ids := c.QueryParam("ids")
+	format := c.QueryParam("format")
+
+	// Validate //
+	if ids == "" {
+		return Error(c, NewRequestValidationError(REQUIRED, "ids"))
+	}
+
+	if format == "" {
+		format = "pdf"
+	}
+
+	if format != "pdf" && format != "png" {
+		return Error(c, NewRequestValidationError(INVALID_OPTION, "format"))
+	}
+
+	result := <-s.FarmReadQuery.FindByID(farmUID)
+	if result.Error != nil {
+		return Error(c, result.Error)
+	}
+
+	farm, ok := result.Result.(query.CropFarmQueryResult)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	if farm.UID == (uuid.UUID{}) {
+		return Error(c, NewRequestValidationError(NOT_FOUND, "id"))
+	}
+
+	cropUIDs := []uuid.UUID{}
+	for _, v := range strings.Split(ids, ",") {
+		cropUID, err := uuid.FromString(strings.TrimSpace(v))
+		if err != nil {
+			return Error(c, NewRequestValidationError(PARSE_FAILED, "ids"))
+		}
+
+		cropUIDs = append(cropUIDs, cropUID)
+	}
+
+	if format == "png" && len(cropUIDs) > 1 {
+		return Error(c, NewRequestValidationError(INVALID_OPTION, "format"))
+	}
+
+	// Process //
+	baseURL := c.Scheme() + "://" + c.Request().Host
+
+	labels := []CropLabel{}
+	for _, v := range cropUIDs {
+		result := <-s.CropReadQuery.FindByID(v)
+		if result.Error != nil {
+			return Error(c, result.Error)
+		}
+
+		cropRead, ok := result.Result.(storage.CropRead)
+		if !ok {
+			return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+		}
+
+		if cropRead.UID == (uuid.UUID{}) || cropRead.FarmUID != farm.UID {
+			return Error(c, NewRequestValidationError(NOT_FOUND, "ids"))
+		}
+
+		labels = append(labels, NewCropLabel(cropRead, baseURL))
+	}
+
+	if format == "png" {
+		label, err := RenderCropLabelPNG(labels[0])
+		if err != nil {
+			return Error(c, err)
+		}
+
+		c.Response().Header().Set(echo.HeaderContentDisposition, "inline; filename=\""+labels[0].BatchID+".png\"")
+
+		return c.Blob(http.StatusOK, "image/png", label)
+	}
+
+	sheet, err := RenderCropLabelSheetPDF(labels)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	c.Response().Header().Set(echo.HeaderContentDisposition, "inline; filename=\"crop-labels.pdf\"")
+
+	return c.Blob(http.StatusOK, "application/pdf", sheet)
+}
+
+func (s *GrowthServer) LookupCropLabel(c echo.Context) error {
+	farmUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	payload := c.QueryParam("payload")
+
+	// Validate //
+	if payload == "" {
+		return Error(c, NewRequestValidationError(REQUIRED, "payload"))
+	}
+
+	cropUID, batchID, err := ParseCropLabelPayload(payload)
+	if err != nil {
+		return Error(c, NewRequestValidationError(PARSE_FAILED, "payload"))
+	}
+
+	// Process //
+	var result query.QueryResult
+	if cropUID != (uuid.UUID{}) {
+		result = <-s.CropReadQuery.FindByID(cropUID)
+	} else {
+		result = <-s.CropReadQuery.FindByBatchID(batchID)
+	}
+
+	if result.Error != nil {
+		return Error(c, result.Error)
+	}
+
+	cropRead, ok := result.Result.(storage.CropRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	if cropRead.UID == (uuid.UUID{}) || cropRead.FarmUID != farmUID {
+		return Error(c, NewRequestValidationError(NOT_FOUND, "payload"))
+	}
+
+	data := make(map[string]storage.CropRead)
+	data["data"] = cropRead
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *GrowthServer) GetCropActivities(c echo.Context) error {
+	cropUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Validate //
+	result := <-s.CropReadQuery.FindByID(cropUID)
+	if result.Error != nil {
+		return Error(c, result.Error)
+	}
+
+	crop, ok := result.Result.(storage.CropRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusBadRequest, "Internal server error"))
+	}
+
+	if crop.UID == (uuid.UUID{}) {
+		return Error(c, NewRequestValidationError(NOT_FOUND, "id"))
+	}
+
+	filter, err := parseCropActivityFilter(c)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	filter.CropUID = crop.UID
+
+	// Process //
+	return s.findAllCropActivities(c, filter)
+}
+
+// GetFarmCropActivities returns the activity timeline of all the crop batches of the farm
+func (s *GrowthServer) GetFarmCropActivities(c echo.Context) error {
+	farmUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Validate //
+	result := <-s.FarmReadQuery.FindByID(farmUID)
+	if result.Error != nil {
+		return Error(c, result.Error)
+	}
+
+	farm, ok := result.Result.(query.CropFarmQueryResult)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	if farm.UID == (uuid.UUID{}) {
+		return Error(c, NewRequestValidationError(NOT_FOUND, "id"))
+	}
+
+	filter, err := parseCropActivityFilter(c)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	filter.FarmUID = farm.UID
+
+	// Process //
+	return s.findAllCropActivities(c, filter)
+}
+
+// findAllCropActivities responds with the crop activities matching the filter.
+// The activities are only paginated when the page or limit is given.
+func (s *GrowthServer) findAllCropActivities(c echo.Context, filter query.CropActivityFilter) error {
+	data := make(map[string]interface{})
+
+	page := c.QueryParam("page")
+	limit := c.QueryParam("limit")
+
+	pageInt, limitInt := paginationhelper.DefaultPage, 0
+	if page != "" || limit != "" {
+		var err error
+		pageInt, limitInt, err = paginationhelper.ParsePagination(page, limit)
+		if err != nil {
+			return Error(c, err)
+		}
+	}
+
+	result := <-s.CropActivityQuery.FindAllByFilter(filter, pageInt, limitInt)
+	if result.Error != nil {
+		return Error(c, result.Error)
+	}
+
+	activities, ok := result.Result.([]storage.CropActivity)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	result = <-s.CropActivityQuery.CountAllByFilter(filter)
+	if result.Error != nil {
+		return Error(c, result.Error)
+	}
+
+	total, ok := result.Result.(int)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	temp := []CropActivity{}
+	for i := range activities {
+		temp = append(temp, MapToCropActivity(activities[i]))
+	}
+
+	data["data"] = temp
+	data["total_rows"] = total
+	data["page"] = pageInt
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// ExportCrops exports the crop batches of the farm with the same status filter as FindAllCrops
+func (s *GrowthServer) ExportCrops(c echo.Context) error {
+	farmUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	status := c.QueryParam("status")
+
+	// Validate //
+	farm, err := s.findExportFarm(farmUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Process //
+	result := <-s.CropReadQuery.CountAllCropsByFarm(farm.UID, status)
+	if result.Error != nil {
+		return Error(c, result.Error)
+	}
+
+	total, ok := result.Result.(int)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	crops := []storage.CropRead{}
+	if total > 0 {
+		result = <-s.CropReadQuery.FindAllCropsByFarm(farm.UID, status, paginationhelper.DefaultPage, total)
+		if result.Error != nil {
+			return Error(c, result.Error)
+		}
+
+		crops, ok = result.Result.([]storage.CropRead)
+		if !ok {
+			return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+		}
+	}
+
+	return writeExport(c, cropExportTable(crops), "crops")
+}
+
+// ExportCropArchives exports the archived crop batches of the farm with the same search as FindAllCropArchives
+func (s *GrowthServer) ExportCropArchives(c echo.Context) error {
+	farmUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	filter, err := parseCropArchiveFilter(c)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Validate //
+	farm, err := s.findExportFarm(farmUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Process //
+	result := <-s.CropReadQuery.CountAllArchivedCropsByFarm(farm.UID, filter)
+	if result.Error != nil {
+		return Error(c, result.Error)
+	}
+
+	total, ok := result.Result.(int)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	crops := []storage.CropRead{}
+	if total > 0 {
+		result = <-s.CropReadQuery.FindAllCropsArchives(farm.UID, filter, paginationhelper.DefaultPage, total)
+		if result.Error != nil {
+			return Error(c, result.Error)
+		}
+
+		crops, ok = result.Result.([]storage.CropRead)
+		if !ok {
+			return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+		}
+	}
+
+	return writeExport(c, cropExportTable(crops), "crop-archives")
+}
+
+// ExportCropHarvests exports the harvest history of the farm with the harvest corrections applied.
+// It takes the same filters as GetFarmCropActivities, except activity_type.
+func (s *GrowthServer) ExportCropHarvests(c echo.Context) error {
+	farmUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	filter, err := parseCropActivityFilter(c)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Validate //
+	farm, err := s.findExportFarm(farmUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// A harvest can be corrected later, so all the harvests are corrected before they are filtered by date
+	startDate, endDate, ascending := filter.StartDate, filter.EndDate, filter.Ascending
+
+	filter.FarmUID = farm.UID
+	filter.ActivityTypeCodes = []string{storage.HarvestActivityCode, storage.HarvestCorrectionActivityCode}
+	filter.StartDate = nil
+	filter.EndDate = nil
+	filter.Ascending = true
+
+	// Process //
+	activities, err := s.findAllCropActivitiesByFilter(filter)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	result := <-s.HarvestLotQuery.FindAllByFarm(farm.UID, nil)
+	if result.Error != nil {
+		return Error(c, result.Error)
+	}
+
+	harvestLots, ok := result.Result.([]storage.HarvestLot)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	harvests := []storage.CropActivity{}
+	for _, v := range correctHarvestActivities(activities, harvestLots) {
+		if startDate != nil && v.CreatedDate.Before(*startDate) {
+			continue
+		}
+
+		if endDate != nil && !v.CreatedDate.Before(*endDate) {
+			continue
+		}
+
+		harvests = append(harvests, v)
+	}
+
+	if !ascending {
+		for i, j := 0, len(harvests)-1; i < j; i, j = i+1, j-1 {
+			harvests[i], harvests[j] = harvests[j], harvests[i]
+		}
+	}
+
+	return writeExport(c, harvestExportTable(harvests), "harvests")
+}
+
+// ExportCropActivities exports the activity log of a crop batch with the same filters as GetCropActivities
+func (s *GrowthServer) ExportCropActivities(c echo.Context) error {
+	cropUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	filter, err := parseCropActivityFilter(c)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Validate //
+	result := <-s.CropReadQuery.FindByID(cropUID)
+	if result.Error != nil {
+		return Error(c, result.Error)
+	}
+
+	crop, ok := result.Result.(storage.CropRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusBadRequest, "Internal server error"))
+	}
+
+	if crop.UID == (uuid.UUID{}) {
+		return Error(c, NewRequestValidationError(NOT_FOUND, "id"))
+	}
+
+	filter.CropUID = crop.UID
+
+	// Process //
+	activities, err := s.findAllCropActivitiesByFilter(filter)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return writeExport(c, activityExportTable(activities), crop.BatchID+"-activities")
+}
+
+// ExportFarmCropActivities exports the activity log of the farm with the same filters as GetFarmCropActivities
+func (s *GrowthServer) ExportFarmCropActivities(c echo.Context) error {
+	farmUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	filter, err := parseCropActivityFilter(c)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Validate //
+	farm, err := s.findExportFarm(farmUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	filter.FarmUID = farm.UID
+
+	// Process //
+	activities, err := s.findAllCropActivitiesByFilter(filter)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return writeExport(c, activityExportTable(activities), "activities")
+}
+
+func (s *GrowthServer) findExportFarm(farmUID uuid.UUID) (query.CropFarmQueryResult, error) {
+	result := <-s.FarmReadQuery.FindByID(farmUID)
+	if result.Error != nil {
+		return query.CropFarmQueryResult{}, result.Error
+	}
+
+	farm, ok := result.Result.(query.CropFarmQueryResult)
+	if !ok {
+		return query.CropFarmQueryResult{}, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error")
+	}
+
+	if farm.UID == (uuid.UUID{}) {
+		return query.CropFarmQueryResult{}, NewRequestValidationError(NOT_FOUND, "id")
+	}
+
+	return farm, nil
+}
+
+// findAllCropActivitiesByFilter returns all the crop activities matching the filter, without pagination
+func (s *GrowthServer) findAllCropActivitiesByFilter(filter query.CropActivityFilter) ([]storage.CropActivity, error) {
+	result := <-s.CropActivityQuery.FindAllByFilter(filter, paginationhelper.DefaultPage, 0)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	activities, ok := result.Result.([]storage.CropActivity)
+	if !ok {
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error")
+	}
+
+	return activities, nil
+}
+
+// parseCropActivityFilter reads the activity timeline filters from the query string.
+// activity_type is a comma separated list of activity type codes. A code ending with *
+// matches every code starting with it, so TASK_* matches all the task activities.
+// start_date and end_date are both inclusive days written as YYYY-MM-DD.
+func parseCropActivityFilter(c echo.Context) (query.CropActivityFilter, error) {
+	filter := query.CropActivityFilter{}
+
+	activityType := c.QueryParam("activity_type")
+	areaID := c.QueryParam("area_id")
+	startDate := c.QueryParam("start_date")
+	endDate := c.QueryParam("end_date")
+	sort := c.QueryParam("sort")
+
+	if activityType != "" {
+		for _, v := range strings.Split(activityType, ",") {
+			code := strings.ToUpper(strings.TrimSpace(v))
+
+			matched := false
+			for _, at := range storage.ActivityTypeCodes() {
+				if at == code || (strings.HasSuffix(code, "*") && strings.HasPrefix(at, strings.TrimSuffix(code, "*"))) {
+					filter.ActivityTypeCodes = append(filter.ActivityTypeCodes, at)
+					matched = true
+				}
+			}
+
+			if !matched {
+				return filter, NewRequestValidationError(INVALID_OPTION, "activity_type")
+			}
+		}
+	}
+
+	if areaID != "" {
+		areaUID, err := uuid.FromString(areaID)
+		if err != nil {
+			return filter, NewRequestValidationError(PARSE_FAILED, "area_id")
+		}
+
+		filter.AreaUID = areaUID
+	}
+
+	if startDate != "" {
+		date, err := time.ParseInLocation("2006-01-02", startDate, time.Local)
+		if err != nil {
+			return filter, NewRequestValidationError(PARSE_FAILED, "start_date")
+		}
+
+		filter.StartDate = &date
+	}
+
+	if endDate != "" {
+		date, err := time.ParseInLocation("2006-01-02", endDate, time.Local)
+		if err != nil {
+			return filter, NewRequestValidationError(PARSE_FAILED, "end_date")
+		}
+
+		_, nextDay := datetimehelper.DayRange(date)
+		filter.EndDate = &nextDay
+	}
+
+	if filter.StartDate != nil && filter.EndDate != nil && !filter.StartDate.Before(*filter.EndDate) {
+		return filter, NewRequestValidationError(INVALID_OPTION, "end_date")
+	}
+
+	switch sort {
+	case "", "desc":
+	case "asc":
+		filter.Ascending = true
+	default:
+		return filter, NewRequestValidationError(INVALID_OPTION, "sort")
+	}
+
+	return filter, nil
+}
+
+// parseCropArchiveFilter reads the archived crop batch search from the query string.
+// batch_id and variety match a part of the batch ID and of the variety name.
+// start_date and end_date are both inclusive seeding days written as YYYY-MM-DD.
+func parseCropArchiveFilter(c echo.Context) (query.CropArchiveFilter, error) {
+	filter := query.CropArchiveFilter{
+		BatchID: strings.TrimSpace(c.QueryParam("batch_id")),
+		Variety: strings.TrimSpace(c.QueryParam("variety")),
+	}
+
+	startDate := c.QueryParam("start_date")
+	endDate := c.QueryParam("end_date")
+
+	if startDate != "" {
+		date, err := time.ParseInLocation("2006-01-02", startDate, time.Local)
+		if err != nil {
+			return filter, NewRequestValidationError(PARSE_FAILED, "start_date")
+		}
+
+		filter.StartDate = &date
+	}
+
+	if endDate != "" {
+		date, err := time.ParseInLocation("2006-01-02", endDate, time.Local)
+		if err != nil {
+			return filter, NewRequestValidationError(PARSE_FAILED, "end_date")
+		}
+
+		_, nextDay := datetimehelper.DayRange(date)
+		filter.EndDate = &nextDay
+	}
+
+	if filter.StartDate != nil && filter.EndDate != nil && !filter.StartDate.Before(*filter.EndDate) {
+		return filter, NewRequestValidationError(INVALID_OPTION, "end_date")
+	}
+
+	return filter, nil
+}
+
+func (s *GrowthServer) GetCropsInformation(c echo.Context) error {
+	farmUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	result := <-s.CropReadQuery.FindCropsInformation(farmUID)
+	if result.Error != nil {
+		return Error(c, result.Error)
+	}
+
+	cropInf, ok := result.Result.(query.CropInformationQueryResult)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusBadRequest, "Internal server error"))
+	}
+
+	data := make(map[string]query.CropInformationQueryResult)
+	data["data"] = cropInf
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// checkCropRotation checks the planting of a crop batch in an area against the rotation rules.
+// The past plantings of the same crop batch are not counted.
+func (s *GrowthServer) checkCropRotation(areaUID, cropUID uuid.UUID, plantFamily string, plantingDate time.Time) ([]domain.RotationWarning, error) {
+	histories, err := s.findAreaPlantingHistories(areaUID)
+	if err != nil {
+		return nil, err
+	}
+
+	records := []domain.PlantingRecord{}
+	for _, v := range histories {
+		if v.CropUID == cropUID {
+			continue
+		}
+
+		records = append(records, domain.PlantingRecord{
+			CropUID:     v.CropUID,
+			BatchID:     v.BatchID,
+			PlantFamily: v.PlantFamily,
+			PlantedDate: v.PlantedDate,
+		})
+	}
+
+	return domain.CheckCropRotation(s.RotationRules, plantFamily, plantingDate, records), nil
+}
+
+// findAreaPlantingHistories returns the plantings of the area with the current plant family of their materials,
+// because the plant family of a material can be changed after its plantings are recorded.
+func (s *GrowthServer) findAreaPlantingHistories(areaUID uuid.UUID) ([]storage.PlantingHistory, error) {
+	result := <-s.PlantingHistoryQuery.FindAllByArea(areaUID)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	histories, ok := result.Result.([]storage.PlantingHistory)
+	if !ok {
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error")
+	}
+
+	plantFamilies := make(map[uuid.UUID]string)
+	for i, v := range histories {
+		plantFamily, ok := plantFamilies[v.InventoryUID]
+		if !ok {
+			queryResult := <-s.MaterialReadQuery.FindByID(v.InventoryUID)
+			if queryResult.Error != nil {
+				return nil, queryResult.Error
+			}
+
+			material, ok := queryResult.Result.(query.CropMaterialQueryResult)
+			if !ok {
+				return nil, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error")
+			}
+
+			// The material can be gone, then the recorded plant family is kept
+			plantFamily = v.PlantFamily
+			if material.UID != (uuid.UUID{}) {
+				plantFamily = material.PlantFamily
+			}
+
+			plantFamilies[v.InventoryUID] = plantFamily
+		}
+
+		histories[i].PlantFamily = plantFamily
+	}
+
+	return histories, nil
+}
+
+// getUserUID returns the UID of the user doing the request.
+// It will be empty when the auth check is bypassed, for example in demo mode.
+func getUserUID(c echo.Context) uuid.UUID {
+	userUID, _ := c.Get("USER_UID").(uuid.UUID)
+
+	return userUID
+}
+
+// FindAllCropTemplates returns the crop templates, only those of an inventory material with the inventory_id param
+func (s *GrowthServer) FindAllCropTemplates(c echo.Context) error {
+	inventoryID := c.QueryParam("inventory_id")
+
+	// Validate //
+	var inventoryUID *uuid.UUID
+	if inventoryID != "" {
+		uid, err := uuid.FromString(inventoryID)
+		if err != nil {
+			return Error(c, NewRequestValidationError(PARSE_FAILED, "inventory_id"))
+		}
+
+		inventoryUID = &uid
+	}
+
+	// Process //
+	result := <-s.CropTemplateReadQuery.FindAll(inventoryUID)
+	if result.Error != nil {
+		return Error(c, result.Error)
+	}
+
+	cropTemplates, ok := result.Result.([]storage.CropTemplateRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	data := make(map[string][]storage.CropTemplateRead)
+	data["data"] = cropTemplates
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *GrowthServer) FindCropTemplateByID(c echo.Context) error {
+	uid, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	result := <-s.CropTemplateReadQuery.FindByID(uid)
+	if result.Error != nil {
+		return Error(c, result.Error)
+	}
+
+	cropTemplate, ok := result.Result.(storage.CropTemplateRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	if cropTemplate.UID == (uuid.UUID{}) {
+		return Error(c, NewRequestValidationError(NOT_FOUND, "id"))
+	}
+
+	data := make(map[string]storage.CropTemplateRead)
+	data["data"] = cropTemplate
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// SaveCropTemplate creates a crop template for an inventory material.
+// The tasks are sent as a JSON array of crop template tasks.
+func (s *GrowthServer) SaveCropTemplate(c echo.Context) error {
+	name := c.FormValue("name")
+	inventoryID := c.FormValue("inventory_id")
+	tasks := c.FormValue("tasks")
+
+	// Validate //
+	if inventoryID == "" {
+		return Error(c, NewRequestValidationError(REQUIRED, "inventory_id"))
+	}
+
+	inventoryUID, err := uuid.FromString(inventoryID)
+	if err != nil {
+		return Error(c, NewRequestValidationError(PARSE_FAILED, "inventory_id"))
+	}
+
+	templateTasks, err := parseCropTemplateTasks(tasks)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Process //
+	cropTemplate, err := domain.CreateCropTemplate(s.CropService, name, inventoryUID, templateTasks)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Persists //
+	err = <-s.CropTemplateEventRepo.Save(cropTemplate.UID, 0, cropTemplate.UncommittedChanges)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Trigger Events
+	s.publishUncommittedEvents(cropTemplate)
+
+	cropTemplateRead, err := s.getCropTemplateRead(cropTemplate.UID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	data := make(map[string]storage.CropTemplateRead)
+	data["data"] = cropTemplateRead
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// UpdateCropTemplate changes the name or replaces the tasks of a crop template.
+// The tasks already scheduled for crop batches are not changed.
+func (s *GrowthServer) UpdateCropTemplate(c echo.Context) error {
+	uid, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	name := c.FormValue("name")
+	tasks := c.FormValue("tasks")
+
+	// Validate //
+	cropTemplate, err := s.findCropTemplate(uid)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	if cropTemplate == nil {
+		return Error(c, NewRequestValidationError(NOT_FOUND, "id"))
+	}
+
+	// Process //
+	if name != "" {
+		err = cropTemplate.ChangeName(name)
+		if err != nil {
+			return Error(c, err)
+		}
+	}
+
+	if tasks != "" {
+		templateTasks, err := parseCropTemplateTasks(tasks)
+		if err != nil {
+			return Error(c, err)
+		}
+
+		err = cropTemplate.ChangeTasks(s.CropService, templateTasks)
+		if err != nil {
+			return Error(c, err)
+		}
+	}
+
+	// Persists //
+	err = <-s.CropTemplateEventRepo.Save(cropTemplate.UID, cropTemplate.Version, cropTemplate.UncommittedChanges)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Trigger Events
+	s.publishUncommittedEvents(cropTemplate)
+
+	cropTemplateRead, err := s.getCropTemplateRead(cropTemplate.UID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	data := make(map[string]storage.CropTemplateRead)
+	data["data"] = cropTemplateRead
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *GrowthServer) RemoveCropTemplate(c echo.Context) error {
+	uid, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Validate //
+	cropTemplate, err := s.findCropTemplate(uid)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	if cropTemplate == nil {
+		return Error(c, NewRequestValidationError(NOT_FOUND, "id"))
+	}
+
+	cropTemplateRead, err := s.getCropTemplateRead(cropTemplate.UID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Process //
+	err = cropTemplate.Remove()
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Persists //
+	err = <-s.CropTemplateEventRepo.Save(cropTemplate.UID, cropTemplate.Version, cropTemplate.UncommittedChanges)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Trigger Events
+	s.publishUncommittedEvents(cropTemplate)
+
+	data := make(map[string]storage.CropTemplateRead)
+	data["data"] = cropTemplateRead
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// findCropTemplate builds the crop template from its events.
+// It returns nil when the crop template doesn't exist or has been removed.
+func (s *GrowthServer) findCropTemplate(uid uuid.UUID) (*domain.CropTemplate, error) {
+	result := <-s.CropTemplateEventQuery.FindAllByID(uid)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	events, ok := result.Result.([]storage.CropTemplateEvent)
+	if !ok {
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error")
+	}
+
+	if len(events) == 0 {
+		return nil, nil
+	}
+
+	cropTemplate := repository.NewCropTemplateFromHistory(events)
+	if cropTemplate.IsRemoved {
+		return nil, nil
+	}
+
+	return cropTemplate, nil
+}
+
+func parseCropTemplateTasks(tasks string) ([]domain.CropTemplateTask, error) {
+	if tasks == "" {
+		return nil, NewRequestValidationError(REQUIRED, "tasks")
+	}
+
+	templateTasks := []domain.CropTemplateTask{}
+
+	err := json.Unmarshal([]byte(tasks), &templateTasks)
+	if err != nil {
+		return nil, NewRequestValidationError(PARSE_FAILED, "tasks")
+	}
+
+	return templateTasks, nil
+}
+
+// FindAllProducedUnits lists the units a crop batch can be harvested in.
+// With inventory_id, the harvest units of the inventory replace the built-in ones with the same code.
+func (s *GrowthServer) FindAllProducedUnits(c echo.Context) error {
+	inventoryID := c.QueryParam("inventory_id")
+
+	// Validate //
+	var inventoryUID *uuid.UUID
+	if inventoryID != "" {
+		uid, err := uuid.FromString(inventoryID)
+		if err != nil {
+			return Error(c, NewRequestValidationError(PARSE_FAILED, "inventory_id"))
+		}
+
+		inventoryUID = &uid
+	}
+
+	// Process //
+	producedUnits := domain.ProducedUnits()
+
+	if inventoryUID != nil {
+		result := <-s.HarvestUnitReadQuery.FindAll(inventoryUID)
+		if result.Error != nil {
+			return Error(c, result.Error)
+		}
+
+		harvestUnits, ok := result.Result.([]storage.HarvestUnitRead)
+		if !ok {
+			return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+		}
+
+		for _, hu := range harvestUnits {
+			pu := domain.ProducedUnit{
+				Code:       hu.Code,
+				Label:      hu.Label,
+				Type:       domain.ProducedUnitTypeCount,
+				GramWeight: hu.AverageGramWeight,
+			}
+
+			found := false
+			for i, v := range producedUnits {
+				if v.Code == pu.Code {
+					producedUnits[i] = pu
+					found = true
+				}
+			}
+
+			if !found {
+				producedUnits = append(producedUnits, pu)
+			}
+		}
+	}
+
+	data := make(map[string][]domain.ProducedUnit)
+	data["data"] = producedUnits
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *GrowthServer) FindAllHarvestUnits(c echo.Context) error {
+	inventoryID := c.QueryParam("inventory_id")
+
+	// Validate //
+	var inventoryUID *uuid.UUID
+	if inventoryID != "" {
+		uid, err := uuid.FromString(inventoryID)
+		if err != nil {
+			return Error(c, NewRequestValidationError(PARSE_FAILED, "inventory_id"))
+		}
+
+		inventoryUID = &uid
+	}
+
+	// Process //
+	result := <-s.HarvestUnitReadQuery.FindAll(inventoryUID)
+	if result.Error != nil {
+		return Error(c, result.Error)
+	}
+
+	harvestUnits, ok := result.Result.([]storage.HarvestUnitRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	data := make(map[string][]storage.HarvestUnitRead)
+	data["data"] = harvestUnits
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// SaveHarvestUnit creates a count unit for an inventory material.
+// average_gram_weight is optional, a harvest in a unit without it is not weighed.
+func (s *GrowthServer) SaveHarvestUnit(c echo.Context) error {
+	inventoryID := c.FormValue("inventory_id")
+	code := c.FormValue("code")
+	label := c.FormValue("label")
+	averageGramWeight := c.FormValue("average_gram_weight")
+
+	// Validate //
+	if inventoryID == "" {
+		return Error(c, NewRequestValidationError(REQUIRED, "inventory_id"))
+	}
+
+	inventoryUID, err := uuid.FromString(inventoryID)
+	if err != nil {
+		return Error(c, NewRequestValidationError(PARSE_FAILED, "inventory_id"))
+	}
+
+	if code == "" {
+		return Error(c, NewRequestValidationError(REQUIRED, "code"))
+	}
+
+	avgWeight, err := parseAverageGramWeight(averageGramWeight)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	result := <-s.HarvestUnitReadQuery.FindByInventoryAndCode(inventoryUID, code)
+	if result.Error != nil {
+		return Error(c, result.Error)
+	}
+
+	existing, ok := result.Result.(storage.HarvestUnitRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	if existing.UID != (uuid.UUID{}) {
+		return Error(c, domain.CropError{Code: domain.HarvestUnitErrorAlreadyExists})
+	}
+
+	// Process //
+	harvestUnit, err := domain.CreateHarvestUnit(s.CropService, inventoryUID, code, label, avgWeight)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Persists //
+	err = <-s.HarvestUnitEventRepo.Save(harvestUnit.UID, 0, harvestUnit.UncommittedChanges)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Trigger Events
+	s.publishUncommittedEvents(harvestUnit)
+
+	harvestUnitRead, err := s.getHarvestUnitRead(harvestUnit.UID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	data := make(map[string]storage.HarvestUnitRead)
+	data["data"] = harvestUnitRead
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// UpdateHarvestUnit changes the label and the average weight of a harvest unit.
+// The harvests already done in this unit keep the weight they were recorded with.
+func (s *GrowthServer) UpdateHarvestUnit(c echo.Context) error {
+	uid, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	label := c.FormValue("label")
+	averageGramWeight := c.FormValue("average_gram_weight")
+
+	// Validate //
+	harvestUnit, err := s.findHarvestUnit(uid)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	if harvestUnit == nil {
+		return Error(c, NewRequestValidationError(NOT_FOUND, "id"))
+	}
+
+	if label == "" {
+		label = harvestUnit.Label
+	}
+
+	avgWeight := harvestUnit.AverageGramWeight
+	if averageGramWeight != "" {
+		avgWeight, err = parseAverageGramWeight(averageGramWeight)
+		if err != nil {
+			return Error(c, err)
+		}
+	}
+
+	// Process //
+	err = harvestUnit.Change(label, avgWeight)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Persists //
+	err = <-s.HarvestUnitEventRepo.Save(harvestUnit.UID, harvestUnit.Version, harvestUnit.UncommittedChanges)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Trigger Events
+	s.publishUncommittedEvents(harvestUnit)
+
+	harvestUnitRead, err := s.getHarvestUnitRead(harvestUnit.UID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	data := make(map[string]storage.HarvestUnitRead)
+	data["data"] = harvestUnitRead
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *GrowthServer) RemoveHarvestUnit(c echo.Context) error {
+	uid, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Validate //
+	harvestUnit, err := s.findHarvestUnit(uid)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	if harvestUnit == nil {
+		return Error(c, NewRequestValidationError(NOT_FOUND, "id"))
+	}
+
+	harvestUnitRead, err := s.getHarvestUnitRead(harvestUnit.UID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Process //
+	err = harvestUnit.Remove()
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Persists //
+	err = <-s.HarvestUnitEventRepo.Save(harvestUnit.UID, harvestUnit.Version, harvestUnit.UncommittedChanges)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Trigger Events
+	s.publishUncommittedEvents(harvestUnit)
+
+	data := make(map[string]storage.HarvestUnitRead)
+	data["data"] = harvestUnitRead
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// findHarvestUnit builds the harvest unit from its events.
+// It returns nil when the harvest unit doesn't exist or has been removed.
+func (s *GrowthServer) findHarvestUnit(uid uuid.UUID) (*domain.HarvestUnit, error) {
+	result := <-s.HarvestUnitEventQuery.FindAllByID(uid)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	events, ok := result.Result.([]storage.HarvestUnitEvent)
+	if !ok {
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error")
+	}
+
+	if len(events) == 0 {
+		return nil, nil
+	}
+
+	harvestUnit := repository.NewHarvestUnitFromHistory(events)
+	if harvestUnit.IsRemoved {
+		return nil, nil
+	}
+
+	return harvestUnit, nil
+}
+
+// findProducedUnit returns the harvest unit of the inventory with the code, or the built-in unit.
+// The weight units can't be overridden by the inventory.
+func (s *GrowthServer) findProducedUnit(inventoryUID uuid.UUID, code string) (domain.ProducedUnit, error) {
+	pu := domain.GetProducedUnit(code)
+	if pu.Type == domain.ProducedUnitTypeWeight {
+		return pu, nil
+	}
+
+	result := <-s.HarvestUnitReadQuery.FindByInventoryAndCode(inventoryUID, code)
+	if result.Error != nil {
+		return domain.ProducedUnit{}, result.Error
+	}
+
+	hu, ok := result.Result.(storage.HarvestUnitRead)
+	if !ok {
+		return domain.ProducedUnit{}, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error")
+	}
+
+	if hu.UID != (uuid.UUID{}) {
+		return domain.ProducedUnit{
+			Code:       hu.Code,
+			Label:      hu.Label,
+			Type:       domain.ProducedUnitTypeCount,
+			GramWeight: hu.AverageGramWeight,
+		}, nil
+	}
+
+	return pu, nil
+}
+
+func parseAverageGramWeight(averageGramWeight string) (float32, error) {
+	if averageGramWeight == "" {
+		return 0, nil
+	}
+
+	avgWeight, err := strconv.ParseFloat(averageGramWeight, 32)
+	if err != nil {
+		return 0, NewRequestValidationError(NUMERIC, "average_gram_weight")
+	}
+
+	return float32(avgWeight), nil
+}
+
+// consumeSeedInventory consumes the inventory stock per seeded cell or pot when the material is counted
+// per seed or plant. Otherwise, such as packets or grams, the consumed quantity is required.
+func (s *GrowthServer) consumeSeedInventory(cropBatch *domain.Crop, material query.CropMaterialQueryResult, consumedQuantity string, overrideStock bool) error {
+	if consumedQuantity != "" {
+		q, err := strconv.ParseFloat(consumedQuantity, 32)
+		if err != nil {
+			return NewRequestValidationError(NUMERIC, "consumed_quantity")
+		}
+
+		return cropBatch.ConsumeInventory(s.CropService, float32(q), overrideStock)
+	}
+
+	if !domain.IsCountableInventoryUnit(material.QuantityUnit) {
+		return NewRequestValidationError(REQUIRED, "consumed_quantity")
+	}
+
+	return cropBatch.ConsumeInventory(s.CropService, float32(cropBatch.SeedQuantity()), overrideStock)
+}
+
+func (s *GrowthServer) FindAllSuccessionPlans(c echo.Context) error {
+	inventoryID := c.QueryParam("inventory_id")
+
+	// Validate //
+	var inventoryUID *uuid.UUID
+	if inventoryID != "" {
+		uid, err := uuid.FromString(inventoryID)
+		if err != nil {
+			return Error(c, NewRequestValidationError(PARSE_FAILED, "inventory_id"))
+		}
+
+		inventoryUID = &uid
+	}
+
+	// Process //
+	result := <-s.SuccessionPlanReadQuery.FindAll(inventoryUID)
 	if result.Error != nil {
 		return Error(c, result.Error)
 	}
 
-	crop, ok := result.Result.(storage.CropRead)
+	successionPlans, ok := result.Result.([]storage.SuccessionPlanRead)
 	if !ok {
-		return Error(c, echo.NewHTTPError(http.StatusBadRequest, "Internal server error"))
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
 	}
 
-	if crop.UID == (uuid.UUID{}) {
+	now := time.Now()
+	plans := []SuccessionPlan{}
+	for _, v := range successionPlans {
+		plans = append(plans, MapToSuccessionPlan(v, now))
+	}
+
+	data := make(map[string][]SuccessionPlan)
+	data["data"] = plans
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *GrowthServer) FindSuccessionPlanByID(c echo.Context) error {
+	uid, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	successionPlan, err := s.getSuccessionPlanRead(uid)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	if successionPlan.UID == (uuid.UUID{}) {
 		return Error(c, NewRequestValidationError(NOT_FOUND, "id"))
 	}
 
+	data := make(map[string]SuccessionPlan)
+	data["data"] = MapToSuccessionPlan(successionPlan, time.Now())
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// SaveSuccessionPlan plans a crop batch of the inventory every interval_days days from start_date to end_date.
+// area_ids is a comma separated list of the areas, which are used in turn.
+// The seeding tasks of the planned crop batches are created by the tasks domain from the triggered event.
+func (s *GrowthServer) SaveSuccessionPlan(c echo.Context) error {
+	name := c.FormValue("name")
+	inventoryID := c.FormValue("inventory_id")
+	areaIDs := c.FormValue("area_ids")
+	cropType := c.FormValue("crop_type")
+	containerType := c.FormValue("container_type")
+	containerCell := c.FormValue("container_cell")
+	quantity := c.FormValue("quantity")
+	intervalDays := c.FormValue("interval_days")
+	startDate := c.FormValue("start_date")
+	endDate := c.FormValue("end_date")
+
+	// Validate //
+	if inventoryID == "" {
+		return Error(c, NewRequestValidationError(REQUIRED, "inventory_id"))
+	}
+
+	inventoryUID, err := uuid.FromString(inventoryID)
+	if err != nil {
+		return Error(c, NewRequestValidationError(PARSE_FAILED, "inventory_id"))
+	}
+
+	if areaIDs == "" {
+		return Error(c, NewRequestValidationError(REQUIRED, "area_ids"))
+	}
+
+	areaUIDs := []uuid.UUID{}
+	for _, v := range strings.Split(areaIDs, ",") {
+		uid, err := uuid.FromString(strings.TrimSpace(v))
+		if err != nil {
+			return Error(c, NewRequestValidationError(PARSE_FAILED, "area_ids"))
+		}
+
+		areaUIDs = append(areaUIDs, uid)
+	}
+
+	var containerT domain.CropContainerType
+	switch containerType {
+	case domain.Tray{}.Code():
+		cell, err := strconv.Atoi(containerCell)
+		if err != nil {
+			return Error(c, NewRequestValidationError(NUMERIC, "container_cell"))
+		}
+
+		containerT = domain.Tray{Cell: cell}
+	case domain.Pot{}.Code():
+		containerT = domain.Pot{}
+	default:
+		return Error(c, NewRequestValidationError(NOT_FOUND, "container_type"))
+	}
+
+	qty, err := strconv.Atoi(quantity)
+	if err != nil {
+		return Error(c, NewRequestValidationError(NUMERIC, "quantity"))
+	}
+
+	interval, err := strconv.Atoi(intervalDays)
+	if err != nil {
+		return Error(c, NewRequestValidationError(NUMERIC, "interval_days"))
+	}
+
+	start, err := time.ParseInLocation("2006-01-02", startDate, time.Local)
+	if err != nil {
+		return Error(c, NewRequestValidationError(PARSE_FAILED, "start_date"))
+	}
+
+	end, err := time.ParseInLocation("2006-01-02", endDate, time.Local)
+	if err != nil {
+		return Error(c, NewRequestValidationError(PARSE_FAILED, "end_date"))
+	}
+
 	// Process //
-	queryResult := <-s.CropActivityQuery.FindAllByCropID(cropUID)
+	successionPlan, err := domain.CreateSuccessionPlan(
+		s.CropService,
+		name,
+		inventoryUID,
+		areaUIDs,
+		cropType,
+		containerT,
+		qty,
+		interval,
+		start,
+		end,
+	)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Persists //
+	err = <-s.SuccessionPlanEventRepo.Save(successionPlan.UID, 0, successionPlan.UncommittedChanges)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Trigger Events
+	s.publishUncommittedEvents(successionPlan)
+
+	successionPlanRead, err := s.getSuccessionPlanRead(successionPlan.UID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	data := make(map[string]SuccessionPlan)
+	data["data"] = MapToSuccessionPlan(successionPlanRead, time.Now())
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// ConfirmSuccessionPlanBatch seeds a planned crop batch of a succession plan.
+// The area and the quantity of the plan are used unless area_id or quantity are sent.
+// The inventory stock is consumed the same way as when a crop batch is seeded in an area.
+func (s *GrowthServer) ConfirmSuccessionPlanBatch(c echo.Context) error {
+	uid, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	areaID := c.FormValue("area_id")
+	quantity := c.FormValue("quantity")
+	consumedQuantity := c.FormValue("consumed_quantity")
+	overrideStock := c.FormValue("override_stock") == "true"
+
+	// Validate //
+	successionPlan, err := s.findSuccessionPlan(uid)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	if successionPlan == nil {
+		return Error(c, NewRequestValidationError(NOT_FOUND, "id"))
+	}
+
+	batchUID, err := uuid.FromString(c.Param("batch_id"))
+	if err != nil {
+		return Error(c, NewRequestValidationError(PARSE_FAILED, "batch_id"))
+	}
+
+	plannedBatch := successionPlan.PlannedBatch(batchUID)
+	if plannedBatch == nil {
+		return Error(c, NewRequestValidationError(NOT_FOUND, "batch_id"))
+	}
+
+	if plannedBatch.Status != domain.PlannedBatchStatusPlanned {
+		return Error(c, domain.CropError{Code: domain.SuccessionPlanErrorBatchNotPlanned})
+	}
+
+	areaUID := plannedBatch.AreaUID
+	if areaID != "" {
+		areaUID, err = uuid.FromString(areaID)
+		if err != nil {
+			return Error(c, NewRequestValidationError(PARSE_FAILED, "area_id"))
+		}
+	}
+
+	qty := plannedBatch.Quantity
+	if quantity != "" {
+		qty, err = strconv.Atoi(quantity)
+		if err != nil {
+			return Error(c, NewRequestValidationError(NUMERIC, "quantity"))
+		}
+	}
+
+	queryResult := <-s.MaterialReadQuery.FindByID(successionPlan.InventoryUID)
 	if queryResult.Error != nil {
 		return Error(c, queryResult.Error)
 	}
 
-	activities := queryResult.Result.([]storage.CropActivity)
+	material, ok := queryResult.Result.(query.CropMaterialQueryResult)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
 
-	data := make(map[string][]CropActivity)
-	for i := range activities {
-		data["data"] = append(data["data"], MapToCropActivity(activities[i]))
+	// Process //
+	cropBatch, err := domain.CreateCropBatch(
+		s.CropService,
+		areaUID,
+		successionPlan.CropType,
+		successionPlan.InventoryUID,
+		qty,
+		successionPlan.Container(),
+	)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	err = s.consumeSeedInventory(cropBatch, material, consumedQuantity, overrideStock)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	err = successionPlan.ConfirmBatch(batchUID, *cropBatch)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	warnings, err := s.checkCropRotation(areaUID, cropBatch.UID, material.PlantFamily, cropBatch.InitialArea.CreatedDate)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Persists //
+	err = <-s.CropEventRepo.Save(cropBatch.UID, 0, cropBatch.UncommittedChanges)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	err = <-s.SuccessionPlanEventRepo.Save(successionPlan.UID, successionPlan.Version, successionPlan.UncommittedChanges)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Trigger Events
+	s.publishUncommittedEvents(cropBatch)
+	s.publishUncommittedEvents(successionPlan)
+
+	data := make(map[string]interface{})
+	cr, err := MapToCropRead(s, *cropBatch)
+	if err != nil {
+		return Error(c, err)
 	}
 
+	data["data"] = cr
+	data["warnings"] = warnings
+
 	return c.JSON(http.StatusOK, data)
 }
 
-func (s *GrowthServer) GetCropsInformation(c echo.Context) error {
-	farmUID, err := uuid.FromString(c.Param("id"))
+func (s *GrowthServer) SkipSuccessionPlanBatch(c echo.Context) error {
+	uid, err := uuid.FromString(c.Param("id"))
 	if err != nil {
 		return Error(c, err)
 	}
 
-	result := <-s.CropReadQuery.FindCropsInformation(farmUID)
+	// Validate //
+	successionPlan, err := s.findSuccessionPlan(uid)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	if successionPlan == nil {
+		return Error(c, NewRequestValidationError(NOT_FOUND, "id"))
+	}
+
+	batchUID, err := uuid.FromString(c.Param("batch_id"))
+	if err != nil {
+		return Error(c, NewRequestValidationError(PARSE_FAILED, "batch_id"))
+	}
+
+	if successionPlan.PlannedBatch(batchUID) == nil {
+		return Error(c, NewRequestValidationError(NOT_FOUND, "batch_id"))
+	}
+
+	// Process //
+	err = successionPlan.SkipBatch(batchUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Persists //
+	err = <-s.SuccessionPlanEventRepo.Save(successionPlan.UID, successionPlan.Version, successionPlan.UncommittedChanges)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Trigger Events
+	s.publishUncommittedEvents(successionPlan)
+
+	successionPlanRead, err := s.getSuccessionPlanRead(successionPlan.UID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	data := make(map[string]SuccessionPlan)
+	data["data"] = MapToSuccessionPlan(successionPlanRead, time.Now())
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// RemoveSuccessionPlan removes the plan and its planned crop batches.
+// The crop batches already confirmed and the seeding tasks are kept.
+func (s *GrowthServer) RemoveSuccessionPlan(c echo.Context) error {
+	uid, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Validate //
+	successionPlan, err := s.findSuccessionPlan(uid)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	if successionPlan == nil {
+		return Error(c, NewRequestValidationError(NOT_FOUND, "id"))
+	}
+
+	successionPlanRead, err := s.getSuccessionPlanRead(successionPlan.UID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Process //
+	err = successionPlan.Remove()
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Persists //
+	err = <-s.SuccessionPlanEventRepo.Save(successionPlan.UID, successionPlan.Version, successionPlan.UncommittedChanges)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Trigger Events
+	s.publishUncommittedEvents(successionPlan)
+
+	data := make(map[string]SuccessionPlan)
+	data["data"] = MapToSuccessionPlan(successionPlanRead, time.Now())
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// findSuccessionPlan builds the succession plan from its events.
+// It returns nil when the succession plan doesn't exist or has been removed.
+func (s *GrowthServer) findSuccessionPlan(uid uuid.UUID) (*domain.SuccessionPlan, error) {
+	result := <-s.SuccessionPlanEventQuery.FindAllByID(uid)
 	if result.Error != nil {
-		return Error(c, result.Error)
+		return nil, result.Error
 	}
 
-	cropInf, ok := result.Result.(query.CropInformationQueryResult)
+	events, ok := result.Result.([]storage.SuccessionPlanEvent)
 	if !ok {
-		return Error(c, echo.NewHTTPError(http.StatusBadRequest, "Internal server error"))
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error")
 	}
 
-	data := make(map[string]query.CropInformationQueryResult)
-	data["data"] = cropInf
+	if len(events) == 0 {
+		return nil, nil
+	}
 
-	return c.JSON(http.StatusOK, data)
+	successionPlan := repository.NewSuccessionPlanFromHistory(events)
+	if successionPlan.IsRemoved {
+		return nil, nil
+	}
+
+	return successionPlan, nil
 }
 
 func (s *GrowthServer) publishUncommittedEvents(entity interface{}) error {
@@ -1244,6 +4358,21 @@ func (s *GrowthServer) publishUncommittedEvents(entity interface{}) error {
 			name := structhelper.GetName(v)
 			s.EventBus.Publish(name, v)
 		}
+	case *domain.CropTemplate:
+		for _, v := range e.UncommittedChanges {
+			name := structhelper.GetName(v)
+			s.EventBus.Publish(name, v)
+		}
+	case *domain.HarvestUnit:
+		for _, v := range e.UncommittedChanges {
+			name := structhelper.GetName(v)
+			s.EventBus.Publish(name, v)
+		}
+	case *domain.SuccessionPlan:
+		for _, v := range e.UncommittedChanges {
+			name := structhelper.GetName(v)
+			s.EventBus.Publish(name, v)
+		}
 	}
 
 	return nil
@@ -0,0 +1,393 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	assetsdomain "github.com/Tanibox/tania-core/src/assets/domain"
+	assetsstorage "github.com/Tanibox/tania-core/src/assets/storage"
+	"github.com/Tanibox/tania-core/src/growth/domain"
+	"github.com/Tanibox/tania-core/src/growth/domain/service"
+	"github.com/Tanibox/tania-core/src/growth/query"
+	queryInMem "github.com/Tanibox/tania-core/src/growth/query/inmemory"
+	repoInMem "github.com/Tanibox/tania-core/src/growth/repository/inmemory"
+	"github.com/Tanibox/tania-core/src/growth/storage"
+	"github.com/labstack/echo"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// EventBusMock records the names of the published events
+type EventBusMock struct {
+	Published []string
+}
+
+func (b *EventBusMock) Publish(eventName string, event interface{}) {
+	b.Published = append(b.Published, eventName)
+}
+
+func (b *EventBusMock) Subscribe(eventName string, handlerFunc interface{}) {}
+
+func TestParseCropActivityFilter(t *testing.T) {
+	// Given
+	areaUID, _ := uuid.NewV4()
+
+	newContext := func(queryString string) echo.Context {
+		req := httptest.NewRequest(http.MethodGet, "/activities?"+queryString, nil)
+
+		return echo.New().NewContext(req, httptest.NewRecorder())
+	}
+
+	// When
+	filter, err := parseCropActivityFilter(newContext(""))
+
+	// Then
+	assert.Nil(t, err)
+	assert.Nil(t, filter.ActivityTypeCodes)
+	assert.Equal(t, uuid.UUID{}, filter.AreaUID)
+	assert.Nil(t, filter.StartDate)
+	assert.Nil(t, filter.EndDate)
+	assert.False(t, filter.Ascending)
+
+	// When
+	filter, err = parseCropActivityFilter(newContext(
+		"activity_type=water,task_*&area_id=" + areaUID.String() + "&start_date=2018-05-01&end_date=2018-05-31&sort=asc",
+	))
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, []string{
+		storage.WaterActivityCode,
+		storage.TaskCropActivityCode,
+		storage.TaskNutrientActivityCode,
+		storage.TaskPestControlActivityCode,
+		storage.TaskSafetyActivityCode,
+		storage.TaskSanitationActivityCode,
+	}, filter.ActivityTypeCodes)
+	assert.Equal(t, areaUID, filter.AreaUID)
+	assert.Equal(t, time.Date(2018, time.May, 1, 0, 0, 0, 0, time.Local), *filter.StartDate)
+	assert.Equal(t, time.Date(2018, time.June, 1, 0, 0, 0, 0, time.Local), *filter.EndDate)
+	assert.True(t, filter.Ascending)
+
+	// When
+	filter, err = parseCropActivityFilter(newContext("start_date=2018-05-01&end_date=2018-05-01"))
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, filter.StartDate.AddDate(0, 0, 1), *filter.EndDate)
+
+	tests := []struct {
+		queryString string
+		err         RequestValidationError
+	}{
+		{queryString: "activity_type=WATER,RAIN", err: NewRequestValidationError(INVALID_OPTION, "activity_type")},
+		{queryString: "activity_type=FOO_*", err: NewRequestValidationError(INVALID_OPTION, "activity_type")},
+		{queryString: "area_id=area", err: NewRequestValidationError(PARSE_FAILED, "area_id")},
+		{queryString: "start_date=01-05-2018", err: NewRequestValidationError(PARSE_FAILED, "start_date")},
+		{queryString: "end_date=2018-05-32", err: NewRequestValidationError(PARSE_FAILED, "end_date")},
+		{queryString: "start_date=2018-05-02&end_date=2018-05-01", err: NewRequestValidationError(INVALID_OPTION, "end_date")},
+		{queryString: "sort=newest", err: NewRequestValidationError(INVALID_OPTION, "sort")},
+	}
+
+	for _, test := range tests {
+		// When
+		_, err := parseCropActivityFilter(newContext(test.queryString))
+
+		// Then
+		assert.Equal(t, test.err, err, test.queryString)
+	}
+}
+
+func TestSaveBulkCropOperation(t *testing.T) {
+	// Given
+	farmUID, _ := uuid.NewV4()
+	otherFarmUID, _ := uuid.NewV4()
+	areaUID, _ := uuid.NewV4()
+	otherAreaUID, _ := uuid.NewV4()
+
+	farmReadStorage := assetsstorage.CreateFarmReadStorage()
+	farmReadStorage.FarmReadMap[farmUID] = assetsstorage.FarmRead{UID: farmUID, Name: "My Farm"}
+
+	areaReadStorage := assetsstorage.CreateAreaReadStorage()
+	areaReadStorage.AreaReadMap[areaUID] = assetsstorage.AreaRead{UID: areaUID, Name: "Seeding A"}
+	areaReadStorage.AreaReadMap[otherAreaUID] = assetsstorage.AreaRead{UID: otherAreaUID, Name: "Seeding B"}
+
+	cropEventStorage := storage.CreateCropEventStorage()
+	cropReadStorage := storage.CreateCropReadStorage()
+	bus := &EventBusMock{}
+
+	s := &GrowthServer{
+		CropEventRepo:  repoInMem.NewCropEventRepositoryInMemory(cropEventStorage),
+		CropEventQuery: queryInMem.NewCropEventQueryInMemory(cropEventStorage),
+		CropReadQuery:  queryInMem.NewCropReadQueryInMemory(cropReadStorage),
+		AreaReadQuery:  queryInMem.NewAreaReadQueryInMemory(areaReadStorage),
+		FarmReadQuery:  queryInMem.NewFarmReadQueryInMemory(farmReadStorage),
+		EventBus:       bus,
+	}
+	s.CropService = service.CropServiceInMemory{
+		CropReadQuery: s.CropReadQuery,
+		AreaReadQuery: s.AreaReadQuery,
+	}
+
+	createCrop := func(batchID string, cropFarmUID, cropAreaUID uuid.UUID) uuid.UUID {
+		uid, _ := uuid.NewV4()
+
+		err := <-s.CropEventRepo.Save(uid, 0, []interface{}{domain.CropBatchCreated{
+			UID:            uid,
+			BatchID:        batchID,
+			Status:         domain.GetCropStatus(domain.CropActive),
+			Type:           domain.GetCropType(domain.CropTypeSeeding),
+			Container:      domain.CropContainer{Quantity: 2, Type: domain.Tray{Cell: 10}},
+			FarmUID:        cropFarmUID,
+			InitialAreaUID: cropAreaUID,
+			Quantity:       20,
+			CreatedDate:    time.Now(),
+		}})
+		assert.Nil(t, err)
+
+		cropReadStorage.CropReadMap[uid] = storage.CropRead{UID: uid, BatchID: batchID, FarmUID: cropFarmUID}
+
+		return uid
+	}
+
+	firstCropUID := createCrop("bro-sal-1may", farmUID, areaUID)
+	secondCropUID := createCrop("bro-sal-2may", farmUID, otherAreaUID)
+	otherFarmCropUID := createCrop("bro-sal-3may", otherFarmUID, areaUID)
+
+	saveBulk := func(form url.Values) (int, []BulkCropResult) {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(form.Encode()))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationForm)
+		rec := httptest.NewRecorder()
+
+		c := echo.New().NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues(farmUID.String())
+
+		err := s.SaveBulkCropOperation(c)
+		if err != nil {
+			return err.(*echo.HTTPError).Code, nil
+		}
+
+		// The validation errors of the request are not reported per crop batch
+		data := map[string]json.RawMessage{}
+		assert.Nil(t, json.Unmarshal(rec.Body.Bytes(), &data))
+
+		results := []BulkCropResult{}
+		if _, ok := data["data"]; ok {
+			assert.Nil(t, json.Unmarshal(data["data"], &results))
+		}
+
+		return rec.Code, results
+	}
+
+	cropEventCount := func() int {
+		return len(cropEventStorage.CropEvents)
+	}
+
+	statuses := func(results []BulkCropResult) map[uuid.UUID]string {
+		m := map[uuid.UUID]string{}
+		for _, v := range results {
+			m[v.UID] = v.Status
+		}
+
+		return m
+	}
+
+	// When
+	code, results := saveBulk(url.Values{
+		"ids":       {firstCropUID.String() + ", " + secondCropUID.String() + "," + firstCropUID.String()},
+		"operation": {BulkCropOperationNote},
+		"content":   {"Checked the leaves"},
+	})
+
+	// Then
+	assert.Equal(t, http.StatusOK, code)
+	assert.Len(t, results, 2)
+	assert.Equal(t, map[uuid.UUID]string{
+		firstCropUID:  BulkCropResultSuccess,
+		secondCropUID: BulkCropResultSuccess,
+	}, statuses(results))
+	assert.Equal(t, "bro-sal-1may", results[0].BatchID)
+	assert.Equal(t, 5, cropEventCount())
+	assert.Equal(t, []string{"CropBatchNoteCreated", "CropBatchNoteCreated"}, bus.Published)
+
+	// When
+	code, results = saveBulk(url.Values{
+		"ids":       {firstCropUID.String() + "," + otherFarmCropUID.String()},
+		"operation": {BulkCropOperationNote},
+		"content":   {"Checked the leaves"},
+	})
+
+	// Then
+	assert.Equal(t, http.StatusBadRequest, code)
+	assert.Equal(t, map[uuid.UUID]string{
+		firstCropUID:     BulkCropResultNotProcessed,
+		otherFarmCropUID: BulkCropResultInvalid,
+	}, statuses(results))
+	assert.Equal(t, NOT_FOUND, results[1].ErrorCode)
+	assert.Equal(t, 5, cropEventCount())
+
+	// When
+	code, results = saveBulk(url.Values{
+		"ids":            {firstCropUID.String() + "," + secondCropUID.String()},
+		"operation":      {BulkCropOperationWater},
+		"source_area_id": {areaUID.String()},
+		"watering_date":  {"2018-05-12 08:00"},
+	})
+
+	// Then
+	assert.Equal(t, http.StatusBadRequest, code)
+	assert.Equal(t, map[uuid.UUID]string{
+		firstCropUID:  BulkCropResultNotProcessed,
+		secondCropUID: BulkCropResultInvalid,
+	}, statuses(results))
+	assert.Equal(t, 5, cropEventCount())
+	assert.Len(t, bus.Published, 2)
+
+	tests := []struct {
+		name string
+		form url.Values
+	}{
+		{name: "without crop batches", form: url.Values{"operation": {BulkCropOperationNote}, "content": {"Note"}}},
+		{name: "without operation", form: url.Values{"ids": {firstCropUID.String()}}},
+		{name: "unknown operation", form: url.Values{"ids": {firstCropUID.String()}, "operation": {"PRUNE"}}},
+		{name: "invalid crop batch ID", form: url.Values{"ids": {"bro-sal-1may"}, "operation": {BulkCropOperationNote}, "content": {"Note"}}},
+		{name: "note without content", form: url.Values{"ids": {firstCropUID.String()}, "operation": {BulkCropOperationNote}}},
+	}
+
+	for _, test := range tests {
+		// When
+		code, _ := saveBulk(test.form)
+
+		// Then
+		assert.Equal(t, http.StatusBadRequest, code, test.name)
+		assert.Equal(t, 5, cropEventCount(), test.name)
+	}
+}
+
+func TestCheckCropRotationWithChangedPlantFamily(t *testing.T) {
+	// Given
+	areaUID, _ := uuid.NewV4()
+	cropUID, _ := uuid.NewV4()
+	previousCropUID, _ := uuid.NewV4()
+	inventoryUID, _ := uuid.NewV4()
+
+	materialReadStorage := assetsstorage.CreateMaterialReadStorage()
+	materialReadStorage.MaterialReadMap[inventoryUID] = assetsstorage.MaterialRead{
+		UID:         inventoryUID,
+		Name:        "Tomato",
+		Type:        assetsdomain.MaterialTypeSeed{},
+		PlantFamily: "SOLANACEAE",
+	}
+
+	// The plant family of the material was set after the planting was recorded
+	plantingHistoryStorage := storage.CreatePlantingHistoryStorage()
+	plantingHistoryStorage.PlantingHistoryMap = []storage.PlantingHistory{{
+		AreaUID:      areaUID,
+		CropUID:      previousCropUID,
+		BatchID:      "tom-1may",
+		InventoryUID: inventoryUID,
+		PlantedDate:  time.Date(2018, time.May, 1, 0, 0, 0, 0, time.UTC),
+	}}
+
+	s := &GrowthServer{
+		PlantingHistoryQuery: queryInMem.NewPlantingHistoryQueryInMemory(plantingHistoryStorage),
+		MaterialReadQuery:    queryInMem.NewMaterialReadQueryInMemory(materialReadStorage),
+		RotationRules:        []domain.RotationRule{{PlantFamily: "SOLANACEAE", Years: 3}},
+	}
+
+	// When
+	warnings, err := s.checkCropRotation(areaUID, cropUID, "SOLANACEAE", time.Date(2019, time.May, 1, 0, 0, 0, 0, time.UTC))
+
+	// Then
+	assert.Nil(t, err)
+	assert.Len(t, warnings, 1)
+	assert.Equal(t, previousCropUID, warnings[0].PreviousCropUID)
+
+	// When
+	histories, err := s.findAreaPlantingHistories(areaUID)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, "SOLANACEAE", histories[0].PlantFamily)
+}
+
+func TestConsumeSeedInventory(t *testing.T) {
+	// Given
+	seedUID, _ := uuid.NewV4()
+	packetUID, _ := uuid.NewV4()
+
+	materialReadStorage := assetsstorage.CreateMaterialReadStorage()
+	materialReadStorage.MaterialReadMap[seedUID] = assetsstorage.MaterialRead{
+		UID:      seedUID,
+		Type:     assetsdomain.MaterialTypeSeed{},
+		Quantity: assetsstorage.MaterialQuantity{Value: 100, Unit: assetsdomain.MaterialQuantityUnit{Code: "SEEDS"}},
+	}
+	materialReadStorage.MaterialReadMap[packetUID] = assetsstorage.MaterialRead{
+		UID:      packetUID,
+		Type:     assetsdomain.MaterialTypeSeed{},
+		Quantity: assetsstorage.MaterialQuantity{Value: 5, Unit: assetsdomain.MaterialQuantityUnit{Code: "PACKETS"}},
+	}
+
+	materialReadQuery := queryInMem.NewMaterialReadQueryInMemory(materialReadStorage)
+	s := &GrowthServer{
+		MaterialReadQuery: materialReadQuery,
+		CropService:       service.CropServiceInMemory{MaterialReadQuery: materialReadQuery},
+	}
+
+	newCropBatch := func(inventoryUID uuid.UUID) (*domain.Crop, query.CropMaterialQueryResult) {
+		uid, _ := uuid.NewV4()
+		material := (<-materialReadQuery.FindByID(inventoryUID)).Result.(query.CropMaterialQueryResult)
+
+		return &domain.Crop{
+			UID:          uid,
+			InventoryUID: inventoryUID,
+			Container:    domain.CropContainer{Quantity: 2, Type: domain.Tray{Cell: 10}},
+		}, material
+	}
+
+	consumedQuantity := func(cropBatch *domain.Crop) float32 {
+		quantity := float32(0)
+		for _, v := range cropBatch.UncommittedChanges {
+			if e, ok := v.(domain.CropBatchInventoryConsumed); ok {
+				quantity += e.Quantity
+			}
+		}
+
+		return quantity
+	}
+
+	// When
+	cropBatch, material := newCropBatch(seedUID)
+	err := s.consumeSeedInventory(cropBatch, material, "", false)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, float32(20), consumedQuantity(cropBatch))
+
+	// When
+	cropBatch, material = newCropBatch(packetUID)
+	err = s.consumeSeedInventory(cropBatch, material, "", false)
+
+	// Then
+	assert.Equal(t, NewRequestValidationError(REQUIRED, "consumed_quantity"), err)
+	assert.Len(t, cropBatch.UncommittedChanges, 0)
+
+	// When
+	err = s.consumeSeedInventory(cropBatch, material, "one", false)
+
+	// Then
+	assert.Equal(t, NewRequestValidationError(NUMERIC, "consumed_quantity"), err)
+
+	// When
+	err = s.consumeSeedInventory(cropBatch, material, "1.5", false)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, float32(1.5), consumedQuantity(cropBatch))
+}
@@ -376,6 +376,7 @@ func (s *GrowthServer) SaveToCropReadModel(event interface{}) error {
 					SourceAreaName: srcArea.Name,
 					CreatedDate:    v.CreatedDate,
 					LastUpdated:    e.DumpDate,
+					Reasons:        e.UpdatedTrash.Reasons,
 				}
 
 				isFound = true
@@ -389,6 +390,7 @@ func (s *GrowthServer) SaveToCropReadModel(event interface{}) error {
 				SourceAreaName: srcArea.Name,
 				CreatedDate:    e.DumpDate,
 				LastUpdated:    e.DumpDate,
+				Reasons:        e.UpdatedTrash.Reasons,
 			})
 		}
 
@@ -419,6 +421,199 @@ func (s *GrowthServer) SaveToCropReadModel(event interface{}) error {
 
 		cropRead.Status = e.CropStatus
 
+	case domain.CropBatchHarvestCorrected:
+		queryResult := <-s.CropReadQuery.FindByID(e.UID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+		}
+
+		cr, ok := queryResult.Result.(storage.CropRead)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+		}
+
+		cropRead = &cr
+
+		queryResult = <-s.AreaReadQuery.FindByID(e.SourceAreaUID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+		}
+
+		srcArea, ok := queryResult.Result.(query.CropAreaQueryResult)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+		}
+
+		for i, v := range cropRead.HarvestedStorage {
+			if v.SourceAreaUID == e.SourceAreaUID {
+				cropRead.HarvestedStorage[i].Quantity = e.UpdatedHarvestedStorage.Quantity
+				cropRead.HarvestedStorage[i].ProducedGramQuantity = e.UpdatedHarvestedStorage.ProducedGramQuantity
+				cropRead.HarvestedStorage[i].LastUpdated = e.UpdatedHarvestedStorage.LastUpdated
+			}
+		}
+
+		updateCropReadArea(cropRead, e.HarvestedArea)
+
+		restoredQuantity := e.PreviousHarvestedQuantity - e.UpdatedHarvestedStorage.Quantity
+		if srcArea.Type == "SEEDING" {
+			cropRead.AreaStatus.Seeding += restoredQuantity
+		}
+		if srcArea.Type == "GROWING" {
+			cropRead.AreaStatus.Growing += restoredQuantity
+		}
+
+		cropRead.Status = e.CropStatus
+
+	case domain.CropBatchDumpReverted:
+		queryResult := <-s.CropReadQuery.FindByID(e.UID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+		}
+
+		cr, ok := queryResult.Result.(storage.CropRead)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+		}
+
+		cropRead = &cr
+
+		queryResult = <-s.AreaReadQuery.FindByID(e.UpdatedTrash.SourceAreaUID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+		}
+
+		srcArea, ok := queryResult.Result.(query.CropAreaQueryResult)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+		}
+
+		for i, v := range cropRead.Trash {
+			if v.SourceAreaUID == e.UpdatedTrash.SourceAreaUID {
+				cropRead.Trash[i].Quantity = e.UpdatedTrash.Quantity
+				cropRead.Trash[i].LastUpdated = e.RevertDate
+				cropRead.Trash[i].Reasons = e.UpdatedTrash.Reasons
+			}
+		}
+
+		updateCropReadArea(cropRead, e.RestoredArea)
+
+		if srcArea.Type == "SEEDING" {
+			cropRead.AreaStatus.Seeding += e.Quantity
+		}
+		if srcArea.Type == "GROWING" {
+			cropRead.AreaStatus.Growing += e.Quantity
+		}
+
+		cropRead.AreaStatus.Dumped -= e.Quantity
+
+		cropRead.Status = e.CropStatus
+
+	case domain.CropBatchUnarchived:
+		queryResult := <-s.CropReadQuery.FindByID(e.UID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+		}
+
+		cr, ok := queryResult.Result.(storage.CropRead)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+		}
+
+		cropRead = &cr
+
+		queryResult = <-s.AreaReadQuery.FindByID(e.AreaUID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+		}
+
+		area, ok := queryResult.Result.(query.CropAreaQueryResult)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+		}
+
+		if e.DumpedQuantity > 0 {
+			for i, v := range cropRead.Trash {
+				if v.SourceAreaUID == e.AreaUID {
+					cropRead.Trash[i].Quantity = e.UpdatedTrash.Quantity
+					cropRead.Trash[i].LastUpdated = e.UpdatedTrash.LastUpdated
+					cropRead.Trash[i].Reasons = e.UpdatedTrash.Reasons
+				}
+			}
+		}
+
+		if e.HarvestedQuantity > 0 {
+			for i, v := range cropRead.HarvestedStorage {
+				if v.SourceAreaUID == e.AreaUID {
+					cropRead.HarvestedStorage[i].Quantity = e.UpdatedHarvestedStorage.Quantity
+					cropRead.HarvestedStorage[i].ProducedGramQuantity = e.UpdatedHarvestedStorage.ProducedGramQuantity
+					cropRead.HarvestedStorage[i].LastUpdated = e.UpdatedHarvestedStorage.LastUpdated
+				}
+			}
+		}
+
+		updateCropReadArea(cropRead, e.RestoredArea)
+
+		if area.Type == "SEEDING" {
+			cropRead.AreaStatus.Seeding += e.Quantity
+		}
+		if area.Type == "GROWING" {
+			cropRead.AreaStatus.Growing += e.Quantity
+		}
+
+		cropRead.AreaStatus.Dumped -= e.DumpedQuantity
+
+		cropRead.Status = e.CropStatus
+
+	case domain.CropBatchMoveReverted:
+		queryResult := <-s.CropReadQuery.FindByID(e.UID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+		}
+
+		cr, ok := queryResult.Result.(storage.CropRead)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+		}
+
+		cropRead = &cr
+
+		queryResult = <-s.AreaReadQuery.FindByID(e.SrcAreaUID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+		}
+
+		srcArea, ok := queryResult.Result.(query.CropAreaQueryResult)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+		}
+
+		queryResult = <-s.AreaReadQuery.FindByID(e.DstAreaUID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+		}
+
+		dstArea, ok := queryResult.Result.(query.CropAreaQueryResult)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+		}
+
+		updateCropReadArea(cropRead, e.UpdatedSrcArea)
+		updateCropReadArea(cropRead, e.UpdatedDstArea)
+
+		// The plants go back from the destination area to the source area
+		if srcArea.Type == "SEEDING" {
+			cropRead.AreaStatus.Seeding += e.Quantity
+		}
+		if srcArea.Type == "GROWING" {
+			cropRead.AreaStatus.Growing += e.Quantity
+		}
+		if dstArea.Type == "SEEDING" {
+			cropRead.AreaStatus.Seeding -= e.Quantity
+		}
+		if dstArea.Type == "GROWING" {
+			cropRead.AreaStatus.Growing -= e.Quantity
+		}
+
 	case domain.CropBatchWatered:
 		queryResult := <-s.CropReadQuery.FindByID(e.UID)
 		if queryResult.Error != nil {
@@ -509,6 +704,47 @@ func (s *GrowthServer) SaveToCropReadModel(event interface{}) error {
 			Height:      e.Height,
 			Description: e.Description,
 		})
+
+	case domain.CropBatchPhotoRemoved:
+		queryResult := <-s.CropReadQuery.FindByID(e.CropUID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+		}
+
+		cr, ok := queryResult.Result.(storage.CropRead)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+		}
+
+		cropRead = &cr
+
+		cropPhotoTmp := []storage.CropPhoto{}
+		for _, v := range cropRead.Photos {
+			if v.UID != e.UID {
+				cropPhotoTmp = append(cropPhotoTmp, v)
+			}
+		}
+
+		cropRead.Photos = cropPhotoTmp
+
+	case domain.CropBatchPhotoDescriptionChanged:
+		queryResult := <-s.CropReadQuery.FindByID(e.CropUID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+		}
+
+		cr, ok := queryResult.Result.(storage.CropRead)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+		}
+
+		cropRead = &cr
+
+		for i, v := range cropRead.Photos {
+			if v.UID == e.UID {
+				cropRead.Photos[i].Description = e.Description
+			}
+		}
 	}
 
 	err := <-s.CropReadRepo.Save(cropRead)
@@ -669,8 +905,12 @@ func (s *GrowthServer) SaveToCropActivityReadModel(event interface{}) error {
 			SrcAreaUID:           srcArea.UID,
 			SrcAreaName:          srcArea.Name,
 			Quantity:             e.HarvestedQuantity,
+			ProducedQuantity:     e.HarvestLot.ProducedQuantity,
+			ProducedUnit:         e.HarvestLot.ProducedUnit,
 			ProducedGramQuantity: e.ProducedGramQuantity,
 			HarvestDate:          e.HarvestDate,
+			LotNumber:            e.HarvestLot.LotNumber,
+			Grade:                e.HarvestLot.Grade,
 		}
 
 	case domain.CropBatchDumped:
@@ -703,6 +943,7 @@ func (s *GrowthServer) SaveToCropActivityReadModel(event interface{}) error {
 			SrcAreaUID:  srcArea.UID,
 			SrcAreaName: srcArea.Name,
 			Quantity:    e.Quantity,
+			Reason:      e.Reason,
 			DumpDate:    e.DumpDate,
 		}
 
@@ -742,60 +983,211 @@ func (s *GrowthServer) SaveToCropActivityReadModel(event interface{}) error {
 			Description: e.Description,
 		}
 
-	// TODO:
-	// We cannot listen to this events without refer to the original struct.
-	// This is considered as domain boundary leak.
-	case taskevents.TaskCompleted:
-		queryResult := <-s.TaskReadQuery.FindByID(e.UID)
+	case domain.CropBatchHarvestCorrected:
+		queryResult := <-s.CropReadQuery.FindByID(e.UID)
 		if queryResult.Error != nil {
 			log.Error(queryResult.Error)
 		}
 
-		taskQueryResult, ok := queryResult.Result.(query.CropTaskQueryResult)
+		cr, ok := queryResult.Result.(storage.CropRead)
 		if !ok {
 			log.Error(errors.New("Internal server error. Error type assertion"))
 		}
 
-		if taskQueryResult.Domain == "CROP" {
-			cropRead := storage.CropRead{}
-
-			queryResult := <-s.CropReadQuery.FindByID(taskQueryResult.AssetUID)
-			if queryResult.Error != nil {
-				log.Error(queryResult.Error)
-			}
+		queryResult = <-s.AreaReadQuery.FindByID(e.SourceAreaUID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+		}
 
-			cropRead, ok = queryResult.Result.(storage.CropRead)
-			if !ok {
-				log.Error(errors.New("Internal server error. Error type assertion"))
-			}
+		srcArea, ok := queryResult.Result.(query.CropAreaQueryResult)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+		}
 
-			areaQueryResult := query.CropAreaQueryResult{}
-			if taskQueryResult.AreaUID != (uuid.UUID{}) {
-				queryResult := <-s.AreaReadQuery.FindByID(taskQueryResult.AreaUID)
-				if queryResult.Error != nil {
-					log.Error(queryResult.Error)
-				}
+		cropActivity.UID = e.UID
+		cropActivity.BatchID = cr.BatchID
+		cropActivity.ContainerType = cr.Container.Type
+		cropActivity.CreatedDate = time.Now()
+		cropActivity.Description = e.Notes
+		cropActivity.ActivityType = storage.HarvestCorrectionActivity{
+			SrcAreaUID:                   srcArea.UID,
+			SrcAreaName:                  srcArea.Name,
+			PreviousQuantity:             e.PreviousHarvestedQuantity,
+			Quantity:                     e.UpdatedHarvestedStorage.Quantity,
+			PreviousProducedGramQuantity: e.PreviousProducedGramQuantity,
+			ProducedGramQuantity:         e.UpdatedHarvestedStorage.ProducedGramQuantity,
+			CorrectedBy:                  e.CorrectedBy,
+			CorrectionDate:               e.CorrectionDate,
+		}
+
+	case domain.CropBatchDumpReverted:
+		queryResult := <-s.CropReadQuery.FindByID(e.UID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+		}
 
-				areaQueryResult, ok = queryResult.Result.(query.CropAreaQueryResult)
-				if !ok {
-					log.Error(errors.New("Internal server error. Error type assertion"))
-				}
-			}
+		cr, ok := queryResult.Result.(storage.CropRead)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+		}
 
-			materialQueryResult := query.CropMaterialQueryResult{}
-			if taskQueryResult.MaterialUID != (uuid.UUID{}) {
-				queryResult := <-s.MaterialReadQuery.FindByID(taskQueryResult.MaterialUID)
-				if queryResult.Error != nil {
-					log.Error(queryResult.Error)
-				}
+		queryResult = <-s.AreaReadQuery.FindByID(e.UpdatedTrash.SourceAreaUID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+		}
 
-				materialQueryResult, ok = queryResult.Result.(query.CropMaterialQueryResult)
-				if !ok {
-					log.Error(errors.New("Internal server error. Error type assertion"))
-				}
-			}
+		srcArea, ok := queryResult.Result.(query.CropAreaQueryResult)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+		}
 
-			cropActivity.UID = cropRead.UID
+		cropActivity.UID = e.UID
+		cropActivity.BatchID = cr.BatchID
+		cropActivity.ContainerType = cr.Container.Type
+		cropActivity.CreatedDate = time.Now()
+		cropActivity.Description = e.Notes
+		cropActivity.ActivityType = storage.DumpRevertActivity{
+			SrcAreaUID:  srcArea.UID,
+			SrcAreaName: srcArea.Name,
+			Quantity:    e.Quantity,
+			RevertedBy:  e.RevertedBy,
+			RevertDate:  e.RevertDate,
+		}
+
+	case domain.CropBatchUnarchived:
+		queryResult := <-s.CropReadQuery.FindByID(e.UID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+		}
+
+		cr, ok := queryResult.Result.(storage.CropRead)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+		}
+
+		queryResult = <-s.AreaReadQuery.FindByID(e.AreaUID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+		}
+
+		area, ok := queryResult.Result.(query.CropAreaQueryResult)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+		}
+
+		cropActivity.UID = e.UID
+		cropActivity.BatchID = cr.BatchID
+		cropActivity.ContainerType = cr.Container.Type
+		cropActivity.CreatedDate = time.Now()
+		cropActivity.Description = e.Notes
+		cropActivity.ActivityType = storage.UnarchiveActivity{
+			AreaUID:        area.UID,
+			AreaName:       area.Name,
+			Quantity:       e.Quantity,
+			UnarchivedBy:   e.UnarchivedBy,
+			UnarchivedDate: e.UnarchivedDate,
+		}
+
+	case domain.CropBatchMoveReverted:
+		queryResult := <-s.CropReadQuery.FindByID(e.UID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+		}
+
+		cr, ok := queryResult.Result.(storage.CropRead)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+		}
+
+		queryResult = <-s.AreaReadQuery.FindByID(e.SrcAreaUID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+		}
+
+		srcArea, ok := queryResult.Result.(query.CropAreaQueryResult)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+		}
+
+		queryResult = <-s.AreaReadQuery.FindByID(e.DstAreaUID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+		}
+
+		dstArea, ok := queryResult.Result.(query.CropAreaQueryResult)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+		}
+
+		cropActivity.UID = e.UID
+		cropActivity.BatchID = cr.BatchID
+		cropActivity.ContainerType = cr.Container.Type
+		cropActivity.CreatedDate = time.Now()
+		cropActivity.Description = e.Notes
+		cropActivity.ActivityType = storage.MoveRevertActivity{
+			SrcAreaUID:  srcArea.UID,
+			SrcAreaName: srcArea.Name,
+			DstAreaUID:  dstArea.UID,
+			DstAreaName: dstArea.Name,
+			Quantity:    e.Quantity,
+			RevertedBy:  e.RevertedBy,
+			RevertDate:  e.RevertDate,
+		}
+
+	// TODO:
+	// We cannot listen to this events without refer to the original struct.
+	// This is considered as domain boundary leak.
+	case taskevents.TaskCompleted:
+		queryResult := <-s.TaskReadQuery.FindByID(e.UID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+		}
+
+		taskQueryResult, ok := queryResult.Result.(query.CropTaskQueryResult)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+		}
+
+		if taskQueryResult.Domain == "CROP" {
+			cropRead := storage.CropRead{}
+
+			queryResult := <-s.CropReadQuery.FindByID(taskQueryResult.AssetUID)
+			if queryResult.Error != nil {
+				log.Error(queryResult.Error)
+			}
+
+			cropRead, ok = queryResult.Result.(storage.CropRead)
+			if !ok {
+				log.Error(errors.New("Internal server error. Error type assertion"))
+			}
+
+			areaQueryResult := query.CropAreaQueryResult{}
+			if taskQueryResult.AreaUID != (uuid.UUID{}) {
+				queryResult := <-s.AreaReadQuery.FindByID(taskQueryResult.AreaUID)
+				if queryResult.Error != nil {
+					log.Error(queryResult.Error)
+				}
+
+				areaQueryResult, ok = queryResult.Result.(query.CropAreaQueryResult)
+				if !ok {
+					log.Error(errors.New("Internal server error. Error type assertion"))
+				}
+			}
+
+			materialQueryResult := query.CropMaterialQueryResult{}
+			if taskQueryResult.MaterialUID != (uuid.UUID{}) {
+				queryResult := <-s.MaterialReadQuery.FindByID(taskQueryResult.MaterialUID)
+				if queryResult.Error != nil {
+					log.Error(queryResult.Error)
+				}
+
+				materialQueryResult, ok = queryResult.Result.(query.CropMaterialQueryResult)
+				if !ok {
+					log.Error(errors.New("Internal server error. Error type assertion"))
+				}
+			}
+
+			cropActivity.UID = cropRead.UID
 			cropActivity.BatchID = cropRead.BatchID
 			cropActivity.ContainerType = cropRead.Container.Type
 			cropActivity.CreatedDate = time.Now()
@@ -850,3 +1242,452 @@ func (s *GrowthServer) SaveToCropActivityReadModel(event interface{}) error {
 
 	return nil
 }
+
+// SaveToPlantingHistoryReadModel records the plantings of the areas, which are used to check the crop rotation
+func (s *GrowthServer) SaveToPlantingHistoryReadModel(event interface{}) error {
+	plantingHistory := &storage.PlantingHistory{}
+
+	switch e := event.(type) {
+	case domain.CropBatchCreated:
+		plantingHistory.AreaUID = e.InitialAreaUID
+		plantingHistory.CropUID = e.UID
+		plantingHistory.BatchID = e.BatchID
+		plantingHistory.InventoryUID = e.InventoryUID
+		plantingHistory.PlantedDate = e.CreatedDate
+
+	case domain.CropBatchMoved:
+		queryResult := <-s.CropReadQuery.FindByID(e.UID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+		}
+
+		cropRead, ok := queryResult.Result.(storage.CropRead)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+		}
+
+		plantingHistory.AreaUID = e.DstAreaUID
+		plantingHistory.CropUID = e.UID
+		plantingHistory.BatchID = cropRead.BatchID
+		plantingHistory.InventoryUID = cropRead.Inventory.UID
+		plantingHistory.PlantedDate = e.MovedDate
+	}
+
+	queryResult := <-s.MaterialReadQuery.FindByID(plantingHistory.InventoryUID)
+	if queryResult.Error != nil {
+		log.Error(queryResult.Error)
+	}
+
+	inv, ok := queryResult.Result.(query.CropMaterialQueryResult)
+	if !ok {
+		log.Error(errors.New("Internal server error. Error type assertion"))
+	}
+
+	plantingHistory.VarietyName = inv.Name
+	plantingHistory.PlantFamily = inv.PlantFamily
+
+	err := <-s.PlantingHistoryRepo.Save(plantingHistory)
+	if err != nil {
+		log.Error(err)
+	}
+
+	return nil
+}
+
+// SaveToHarvestLotReadModel records every harvest as its own lot
+func (s *GrowthServer) SaveToHarvestLotReadModel(event interface{}) error {
+	e, ok := event.(domain.CropBatchHarvested)
+	if !ok {
+		return nil
+	}
+
+	// Harvests recorded before harvest lots were introduced don't have a lot
+	if e.HarvestLot.UID == (uuid.UUID{}) {
+		return nil
+	}
+
+	queryResult := <-s.CropReadQuery.FindByID(e.UID)
+	if queryResult.Error != nil {
+		log.Error(queryResult.Error)
+	}
+
+	cropRead, ok := queryResult.Result.(storage.CropRead)
+	if !ok {
+		log.Error(errors.New("Internal server error. Error type assertion"))
+	}
+
+	queryResult = <-s.AreaReadQuery.FindByID(e.HarvestLot.SourceAreaUID)
+	if queryResult.Error != nil {
+		log.Error(queryResult.Error)
+	}
+
+	srcArea, ok := queryResult.Result.(query.CropAreaQueryResult)
+	if !ok {
+		log.Error(errors.New("Internal server error. Error type assertion"))
+	}
+
+	harvestLot := &storage.HarvestLot{
+		UID:                  e.HarvestLot.UID,
+		LotNumber:            e.HarvestLot.LotNumber,
+		CropUID:              e.UID,
+		BatchID:              cropRead.BatchID,
+		FarmUID:              cropRead.FarmUID,
+		SourceAreaUID:        e.HarvestLot.SourceAreaUID,
+		SourceAreaName:       srcArea.Name,
+		Grade:                e.HarvestLot.Grade,
+		ProducedQuantity:     e.HarvestLot.ProducedQuantity,
+		ProducedUnit:         e.HarvestLot.ProducedUnit,
+		ProducedGramQuantity: e.HarvestLot.ProducedGramQuantity,
+		HarvestDate:          e.HarvestLot.HarvestDate,
+	}
+
+	err := <-s.HarvestLotRepo.Save(harvestLot)
+	if err != nil {
+		log.Error(err)
+	}
+
+	return nil
+}
+
+// CorrectHarvestLotReadModel applies a harvest correction, or the plants taken back from the harvest
+// when a crop batch is unarchived, to the lots of the source area
+func (s *GrowthServer) CorrectHarvestLotReadModel(event interface{}) error {
+	updatedHarvestLots := []domain.HarvestLot{}
+	voidedHarvestLotUIDs := []uuid.UUID{}
+
+	switch e := event.(type) {
+	case domain.CropBatchHarvestCorrected:
+		updatedHarvestLots = e.UpdatedHarvestLots
+		voidedHarvestLotUIDs = e.VoidedHarvestLotUIDs
+	case domain.CropBatchUnarchived:
+		updatedHarvestLots = e.UpdatedHarvestLots
+		voidedHarvestLotUIDs = e.VoidedHarvestLotUIDs
+	}
+
+	for _, v := range updatedHarvestLots {
+		queryResult := <-s.HarvestLotQuery.FindByID(v.UID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+			continue
+		}
+
+		harvestLot, ok := queryResult.Result.(storage.HarvestLot)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+			continue
+		}
+
+		if harvestLot.UID == (uuid.UUID{}) {
+			continue
+		}
+
+		harvestLot.ProducedQuantity = v.ProducedQuantity
+		harvestLot.ProducedGramQuantity = v.ProducedGramQuantity
+
+		err := <-s.HarvestLotRepo.Save(&harvestLot)
+		if err != nil {
+			log.Error(err)
+		}
+	}
+
+	for _, v := range voidedHarvestLotUIDs {
+		err := <-s.HarvestLotRepo.Remove(v)
+		if err != nil {
+			log.Error(err)
+		}
+	}
+
+	return nil
+}
+
+func (s *GrowthServer) SaveToCropTemplateReadModel(event interface{}) error {
+	cropTemplateRead := &storage.CropTemplateRead{}
+
+	switch e := event.(type) {
+	case domain.CropTemplateCreated:
+		queryResult := <-s.MaterialReadQuery.FindByID(e.InventoryUID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+		}
+
+		material, ok := queryResult.Result.(query.CropMaterialQueryResult)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+		}
+
+		cropTemplateRead.UID = e.UID
+		cropTemplateRead.Name = e.Name
+		cropTemplateRead.Inventory = storage.CropTemplateInventory{
+			UID:  e.InventoryUID,
+			Name: material.Name,
+		}
+		cropTemplateRead.Tasks = e.Tasks
+		cropTemplateRead.CreatedDate = e.CreatedDate
+
+	case domain.CropTemplateNameChanged:
+		cropTemplate, err := s.getCropTemplateRead(e.UID)
+		if err != nil {
+			log.Error(err)
+			return nil
+		}
+
+		cropTemplateRead = &cropTemplate
+		cropTemplateRead.Name = e.Name
+
+	case domain.CropTemplateTasksChanged:
+		cropTemplate, err := s.getCropTemplateRead(e.UID)
+		if err != nil {
+			log.Error(err)
+			return nil
+		}
+
+		cropTemplateRead = &cropTemplate
+		cropTemplateRead.Tasks = e.Tasks
+
+	case domain.CropTemplateRemoved:
+		err := <-s.CropTemplateReadRepo.Remove(e.UID)
+		if err != nil {
+			log.Error(err)
+		}
+
+		return nil
+	}
+
+	err := <-s.CropTemplateReadRepo.Save(cropTemplateRead)
+	if err != nil {
+		log.Error(err)
+	}
+
+	return nil
+}
+
+func (s *GrowthServer) getCropTemplateRead(uid uuid.UUID) (storage.CropTemplateRead, error) {
+	queryResult := <-s.CropTemplateReadQuery.FindByID(uid)
+	if queryResult.Error != nil {
+		return storage.CropTemplateRead{}, queryResult.Error
+	}
+
+	cropTemplate, ok := queryResult.Result.(storage.CropTemplateRead)
+	if !ok {
+		return storage.CropTemplateRead{}, errors.New("Internal server error. Error type assertion")
+	}
+
+	return cropTemplate, nil
+}
+
+func (s *GrowthServer) SaveToHarvestUnitReadModel(event interface{}) error {
+	harvestUnitRead := &storage.HarvestUnitRead{}
+
+	switch e := event.(type) {
+	case domain.HarvestUnitCreated:
+		queryResult := <-s.MaterialReadQuery.FindByID(e.InventoryUID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+		}
+
+		material, ok := queryResult.Result.(query.CropMaterialQueryResult)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+		}
+
+		harvestUnitRead.UID = e.UID
+		harvestUnitRead.Inventory = storage.HarvestUnitInventory{
+			UID:  e.InventoryUID,
+			Name: material.Name,
+		}
+		harvestUnitRead.Code = e.Code
+		harvestUnitRead.Label = e.Label
+		harvestUnitRead.AverageGramWeight = e.AverageGramWeight
+		harvestUnitRead.CreatedDate = e.CreatedDate
+
+	case domain.HarvestUnitChanged:
+		harvestUnit, err := s.getHarvestUnitRead(e.UID)
+		if err != nil {
+			log.Error(err)
+			return nil
+		}
+
+		harvestUnitRead = &harvestUnit
+		harvestUnitRead.Label = e.Label
+		harvestUnitRead.AverageGramWeight = e.AverageGramWeight
+
+	case domain.HarvestUnitRemoved:
+		err := <-s.HarvestUnitReadRepo.Remove(e.UID)
+		if err != nil {
+			log.Error(err)
+		}
+
+		return nil
+	}
+
+	err := <-s.HarvestUnitReadRepo.Save(harvestUnitRead)
+	if err != nil {
+		log.Error(err)
+	}
+
+	return nil
+}
+
+func (s *GrowthServer) getHarvestUnitRead(uid uuid.UUID) (storage.HarvestUnitRead, error) {
+	queryResult := <-s.HarvestUnitReadQuery.FindByID(uid)
+	if queryResult.Error != nil {
+		return storage.HarvestUnitRead{}, queryResult.Error
+	}
+
+	harvestUnit, ok := queryResult.Result.(storage.HarvestUnitRead)
+	if !ok {
+		return storage.HarvestUnitRead{}, errors.New("Internal server error. Error type assertion")
+	}
+
+	return harvestUnit, nil
+}
+
+// updateCropReadArea applies the updated domain area to the crop read model's area
+func updateCropReadArea(cropRead *storage.CropRead, area interface{}) {
+	switch v := area.(type) {
+	case domain.InitialArea:
+		cropRead.InitialArea.CurrentQuantity = v.CurrentQuantity
+		cropRead.InitialArea.LastUpdated = v.LastUpdated
+	case domain.MovedArea:
+		for i, ma := range cropRead.MovedArea {
+			if ma.AreaUID == v.AreaUID {
+				cropRead.MovedArea[i].InitialQuantity = v.InitialQuantity
+				cropRead.MovedArea[i].CurrentQuantity = v.CurrentQuantity
+				cropRead.MovedArea[i].LastUpdated = v.LastUpdated
+			}
+		}
+	}
+}
+
+func (s *GrowthServer) SaveToSuccessionPlanReadModel(event interface{}) error {
+	successionPlanRead := &storage.SuccessionPlanRead{}
+
+	switch e := event.(type) {
+	case domain.SuccessionPlanCreated:
+		queryResult := <-s.MaterialReadQuery.FindByID(e.InventoryUID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+		}
+
+		material, ok := queryResult.Result.(query.CropMaterialQueryResult)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+		}
+
+		areas := map[uuid.UUID]storage.SuccessionPlanArea{}
+		for _, v := range e.AreaUIDs {
+			areas[v] = s.getSuccessionPlanArea(v)
+			successionPlanRead.Areas = append(successionPlanRead.Areas, areas[v])
+		}
+
+		for _, v := range e.PlannedBatches {
+			successionPlanRead.PlannedBatches = append(successionPlanRead.PlannedBatches, storage.SuccessionPlannedBatch{
+				UID:         v.UID,
+				Area:        areas[v.AreaUID],
+				SeedingDate: v.SeedingDate,
+				Quantity:    v.Quantity,
+				Status:      v.Status,
+			})
+		}
+
+		successionPlanRead.UID = e.UID
+		successionPlanRead.Name = e.Name
+		successionPlanRead.Inventory = storage.SuccessionPlanInventory{
+			UID:  e.InventoryUID,
+			Name: material.Name,
+		}
+		successionPlanRead.CropType = e.CropType
+		successionPlanRead.ContainerType = e.ContainerType
+		successionPlanRead.ContainerCell = e.ContainerCell
+		successionPlanRead.Quantity = e.Quantity
+		successionPlanRead.IntervalDays = e.IntervalDays
+		successionPlanRead.StartDate = e.StartDate
+		successionPlanRead.EndDate = e.EndDate
+		successionPlanRead.CreatedDate = e.CreatedDate
+
+	case domain.SuccessionPlanBatchConfirmed:
+		successionPlan, err := s.getSuccessionPlanRead(e.UID)
+		if err != nil {
+			log.Error(err)
+			return nil
+		}
+
+		successionPlanRead = &successionPlan
+		for i, v := range successionPlanRead.PlannedBatches {
+			if v.UID == e.PlannedBatchUID {
+				successionPlanRead.PlannedBatches[i].Status = domain.PlannedBatchStatusConfirmed
+				successionPlanRead.PlannedBatches[i].Crop = &storage.SuccessionPlanCrop{
+					UID:         e.CropUID,
+					BatchID:     e.BatchID,
+					Area:        s.getSuccessionPlanArea(e.AreaUID),
+					Quantity:    e.Quantity,
+					SeedingDate: e.ConfirmedDate,
+				}
+			}
+		}
+
+	case domain.SuccessionPlanBatchSkipped:
+		successionPlan, err := s.getSuccessionPlanRead(e.UID)
+		if err != nil {
+			log.Error(err)
+			return nil
+		}
+
+		successionPlanRead = &successionPlan
+		for i, v := range successionPlanRead.PlannedBatches {
+			if v.UID == e.PlannedBatchUID {
+				successionPlanRead.PlannedBatches[i].Status = domain.PlannedBatchStatusSkipped
+			}
+		}
+
+	case domain.SuccessionPlanRemoved:
+		err := <-s.SuccessionPlanReadRepo.Remove(e.UID)
+		if err != nil {
+			log.Error(err)
+		}
+
+		return nil
+	}
+
+	err := <-s.SuccessionPlanReadRepo.Save(successionPlanRead)
+	if err != nil {
+		log.Error(err)
+	}
+
+	return nil
+}
+
+// getSuccessionPlanRead returns a copy of the succession plan read model,
+// so its planned crop batches can be changed without changing the stored ones.
+func (s *GrowthServer) getSuccessionPlanRead(uid uuid.UUID) (storage.SuccessionPlanRead, error) {
+	queryResult := <-s.SuccessionPlanReadQuery.FindByID(uid)
+	if queryResult.Error != nil {
+		return storage.SuccessionPlanRead{}, queryResult.Error
+	}
+
+	successionPlan, ok := queryResult.Result.(storage.SuccessionPlanRead)
+	if !ok {
+		return storage.SuccessionPlanRead{}, errors.New("Internal server error. Error type assertion")
+	}
+
+	successionPlan.PlannedBatches = append([]storage.SuccessionPlannedBatch{}, successionPlan.PlannedBatches...)
+
+	return successionPlan, nil
+}
+
+func (s *GrowthServer) getSuccessionPlanArea(areaUID uuid.UUID) storage.SuccessionPlanArea {
+	queryResult := <-s.AreaReadQuery.FindByID(areaUID)
+	if queryResult.Error != nil {
+		log.Error(queryResult.Error)
+	}
+
+	area, ok := queryResult.Result.(query.CropAreaQueryResult)
+	if !ok {
+		log.Error(errors.New("Internal server error. Error type assertion"))
+	}
+
+	return storage.SuccessionPlanArea{
+		UID:  areaUID,
+		Name: area.Name,
+	}
+}
@@ -0,0 +1,207 @@
+package server
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/growth/storage"
+	"github.com/jung-kurt/gofpdf"
+	uuid "github.com/satori/go.uuid"
+	qrcode "github.com/skip2/go-qrcode"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+	"golang.org/x/image/math/fixed"
+)
+
+const (
+	// Crop page path in the frontend router. The frontend uses hash routing.
+	cropLabelLinkPath = "/crop/"
+
+	labelDateFormat = "2006-01-02"
+
+	// Label PNG size in pixels
+	labelPNGWidth  = 600
+	labelPNGHeight = 240
+
+	// Label sheet layout in millimeters, fits an A4 page
+	labelSheetColumns      = 2
+	labelSheetRows         = 7
+	labelSheetMarginLeft   = 10.0
+	labelSheetMarginTop    = 12.0
+	labelSheetLabelWidth   = 95.0
+	labelSheetLabelHeight  = 38.0
+	labelSheetLabelPadding = 3.0
+)
+
+// CropLabel is the information printed on a crop batch label
+type CropLabel struct {
+	UID         uuid.UUID `json:"uid"`
+	BatchID     string    `json:"batch_id"`
+	VarietyName string    `json:"variety_name"`
+	SeedingDate time.Time `json:"seeding_date"`
+	Link        string    `json:"link"`
+}
+
+// NewCropLabel builds the label of a crop.
+// baseURL is the scheme and host used to build the deep link to the crop page.
+func NewCropLabel(cropRead storage.CropRead, baseURL string) CropLabel {
+	return CropLabel{
+		UID:         cropRead.UID,
+		BatchID:     cropRead.BatchID,
+		VarietyName: cropRead.Inventory.Name,
+		SeedingDate: cropRead.InitialArea.CreatedDate,
+		Link:        strings.TrimSuffix(baseURL, "/") + "/#" + cropLabelLinkPath + cropRead.UID.String(),
+	}
+}
+
+// Payload is the content encoded in the label's QR code.
+// It is the deep link to the crop page with the batch ID, variety name
+// and seeding date as query string, so the label can still be read without Tania.
+func (l CropLabel) Payload() string {
+	values := url.Values{}
+	values.Set("batch_id", l.BatchID)
+	values.Set("variety", l.VarietyName)
+	values.Set("seeding_date", l.SeedingDate.Format(labelDateFormat))
+
+	return l.Link + "?" + values.Encode()
+}
+
+// ParseCropLabelPayload reads a scanned QR payload.
+// It accepts a label payload, a bare crop UID, or a bare batch ID.
+// It returns the crop UID if found, otherwise the batch ID.
+func ParseCropLabelPayload(payload string) (uuid.UUID, string, error) {
+	payload = strings.TrimSpace(payload)
+	if payload == "" {
+		return uuid.UUID{}, "", errors.New("Empty label payload")
+	}
+
+	if uid, err := uuid.FromString(payload); err == nil {
+		return uid, "", nil
+	}
+
+	u, err := url.Parse(payload)
+	if err != nil || u.Scheme == "" {
+		return uuid.UUID{}, payload, nil
+	}
+
+	// The crop path and query string are inside the fragment because of the hash routing
+	link, err := url.Parse(u.Fragment)
+	if err != nil {
+		return uuid.UUID{}, "", err
+	}
+
+	if !strings.HasPrefix(link.Path, cropLabelLinkPath) {
+		return uuid.UUID{}, "", errors.New("Unrecognized label payload")
+	}
+
+	uid, err := uuid.FromString(strings.TrimPrefix(link.Path, cropLabelLinkPath))
+	if err != nil {
+		return uuid.UUID{}, link.Query().Get("batch_id"), nil
+	}
+
+	return uid, "", nil
+}
+
+// RenderCropLabelPNG renders a single label as PNG image
+func RenderCropLabelPNG(label CropLabel) ([]byte, error) {
+	qr, err := qrcode.New(label.Payload(), qrcode.Medium)
+	if err != nil {
+		return nil, err
+	}
+
+	img := image.NewRGBA(image.Rect(0, 0, labelPNGWidth, labelPNGHeight))
+	draw.Draw(img, img.Bounds(), image.White, image.ZP, draw.Src)
+
+	qrImage := qr.Image(labelPNGHeight)
+	draw.Draw(img, qrImage.Bounds(), qrImage, image.ZP, draw.Src)
+
+	drawer := font.Drawer{
+		Dst:  img,
+		Src:  image.NewUniform(color.Black),
+		Face: basicfont.Face7x13,
+	}
+
+	lines := []string{
+		label.BatchID,
+		label.VarietyName,
+		"Seeded " + label.SeedingDate.Format(labelDateFormat),
+	}
+
+	for i, v := range lines {
+		drawer.Dot = fixed.P(labelPNGHeight+10, 80+i*30)
+		drawer.DrawString(v)
+	}
+
+	buf := new(bytes.Buffer)
+	err = png.Encode(buf, img)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// RenderCropLabelSheetPDF renders the labels as printable A4 PDF sheets
+func RenderCropLabelSheetPDF(labels []CropLabel) ([]byte, error) {
+	pdf := gofpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(0, 0, 0)
+	pdf.SetAutoPageBreak(false, 0)
+
+	translate := pdf.UnicodeTranslatorFromDescriptor("")
+	qrSize := labelSheetLabelHeight - 2*labelSheetLabelPadding
+	labelsPerPage := labelSheetColumns * labelSheetRows
+
+	for i, label := range labels {
+		if i%labelsPerPage == 0 {
+			pdf.AddPage()
+		}
+
+		position := i % labelsPerPage
+		x := labelSheetMarginLeft + float64(position%labelSheetColumns)*labelSheetLabelWidth
+		y := labelSheetMarginTop + float64(position/labelSheetColumns)*labelSheetLabelHeight
+
+		// Cutting guide
+		pdf.SetDrawColor(200, 200, 200)
+		pdf.Rect(x, y, labelSheetLabelWidth, labelSheetLabelHeight, "D")
+
+		qr, err := qrcode.Encode(label.Payload(), qrcode.Medium, 256)
+		if err != nil {
+			return nil, err
+		}
+
+		imageName := "qr-" + label.UID.String()
+		imageOptions := gofpdf.ImageOptions{ImageType: "PNG"}
+		pdf.RegisterImageOptionsReader(imageName, imageOptions, bytes.NewReader(qr))
+		pdf.ImageOptions(imageName, x+labelSheetLabelPadding, y+labelSheetLabelPadding, qrSize, qrSize, false, imageOptions, 0, "")
+
+		textX := x + qrSize + 2*labelSheetLabelPadding
+		textWidth := labelSheetLabelWidth - qrSize - 3*labelSheetLabelPadding
+
+		pdf.SetXY(textX, y+labelSheetLabelPadding+2)
+		pdf.SetFont("Helvetica", "B", 12)
+		pdf.CellFormat(textWidth, 7, translate(label.BatchID), "", 2, "L", false, 0, "")
+
+		pdf.SetFont("Helvetica", "", 10)
+		pdf.CellFormat(textWidth, 6, translate(label.VarietyName), "", 2, "L", false, 0, "")
+		pdf.CellFormat(textWidth, 6, "Seeded "+label.SeedingDate.Format(labelDateFormat), "", 2, "L", false, 0, "")
+	}
+
+	if pdf.Err() {
+		return nil, pdf.Error()
+	}
+
+	buf := new(bytes.Buffer)
+	err := pdf.Output(buf)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,54 @@
+package server_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/Tanibox/tania-core/src/growth/server"
+	"github.com/Tanibox/tania-core/src/growth/storage"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCropLabelPayload(t *testing.T) {
+	// Given
+	cropUID, _ := uuid.NewV4()
+
+	label := NewCropLabel(storage.CropRead{
+		UID:         cropUID,
+		BatchID:     "bro-sal-12may",
+		Inventory:   storage.Inventory{Name: "Broccoli Salad"},
+		InitialArea: storage.InitialArea{CreatedDate: time.Date(2018, time.May, 12, 0, 0, 0, 0, time.UTC)},
+	}, "http://localhost:8080/")
+
+	tests := []struct {
+		name    string
+		payload string
+		uid     uuid.UUID
+		batchID string
+		isError bool
+	}{
+		{name: "label payload", payload: label.Payload(), uid: cropUID},
+		{name: "label link", payload: label.Link, uid: cropUID},
+		{name: "crop UID", payload: " " + cropUID.String() + " ", uid: cropUID},
+		{name: "batch ID", payload: "bro-sal-12may", batchID: "bro-sal-12may"},
+		{name: "label payload with an invalid crop UID", payload: "http://localhost:8080/#/crop/invalid?batch_id=bro-sal-12may", batchID: "bro-sal-12may"},
+		{name: "link to another page", payload: "http://localhost:8080/#/area/" + cropUID.String(), isError: true},
+		{name: "empty payload", payload: "  ", isError: true},
+	}
+
+	for _, test := range tests {
+		// When
+		uid, batchID, err := ParseCropLabelPayload(test.payload)
+
+		// Then
+		if test.isError {
+			assert.NotNil(t, err, test.name)
+			continue
+		}
+
+		assert.Nil(t, err, test.name)
+		assert.Equal(t, test.uid, uid, test.name)
+		assert.Equal(t, test.batchID, batchID, test.name)
+	}
+}
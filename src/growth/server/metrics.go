@@ -0,0 +1,260 @@
+package server
+
+import (
+	"sort"
+
+	"github.com/Tanibox/tania-core/src/growth/domain"
+	"github.com/Tanibox/tania-core/src/growth/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+// LossMetrics counts the losses of a group of plants.
+// The rates are percentages, and are null when there is nothing to compute them from.
+type LossMetrics struct {
+	// SeededQuantity and NotGerminatedQuantity only count the seeding crop batches
+	SeededQuantity        int `json:"seeded_quantity"`
+	NotGerminatedQuantity int `json:"not_germinated_quantity"`
+
+	PlantedQuantity int `json:"planted_quantity"`
+	DumpedQuantity  int `json:"dumped_quantity"`
+
+	GerminationRate *float32       `json:"germination_rate"`
+	SurvivalRate    *float32       `json:"survival_rate"`
+	LossReasons     map[string]int `json:"loss_reasons"`
+}
+
+// CropMetrics are the germination and survival rates of a crop batch,
+// in total and per area and area stage (SEEDING or GROWING) it has lived in
+type CropMetrics struct {
+	UID          uuid.UUID `json:"uid"`
+	BatchID      string    `json:"batch_id"`
+	Type         string    `json:"type"`
+	InventoryUID uuid.UUID `json:"inventory_id"`
+	Variety      string    `json:"variety"`
+	LossMetrics
+	Stages []StageMetrics `json:"stages"`
+	Areas  []AreaMetrics  `json:"areas"`
+}
+
+type StageMetrics struct {
+	Stage string `json:"stage"`
+	LossMetrics
+}
+
+type AreaMetrics struct {
+	AreaUID  uuid.UUID `json:"area_id"`
+	AreaName string    `json:"area_name"`
+	AreaType string    `json:"area_type"`
+	LossMetrics
+}
+
+// VarietyMetrics are the metrics of all the crop batches of a variety
+type VarietyMetrics struct {
+	InventoryUID uuid.UUID `json:"inventory_id"`
+	Variety      string    `json:"variety"`
+	TotalBatch   int       `json:"total_batch"`
+	LossMetrics
+	Stages []StageMetrics `json:"stages"`
+}
+
+func newLossMetrics() LossMetrics {
+	return LossMetrics{LossReasons: make(map[string]int)}
+}
+
+func (m *LossMetrics) add(other LossMetrics) {
+	m.SeededQuantity += other.SeededQuantity
+	m.NotGerminatedQuantity += other.NotGerminatedQuantity
+	m.PlantedQuantity += other.PlantedQuantity
+	m.DumpedQuantity += other.DumpedQuantity
+
+	for k, v := range other.LossReasons {
+		m.LossReasons[k] += v
+	}
+
+	m.computeRates()
+}
+
+func (m *LossMetrics) computeRates() {
+	m.GerminationRate = nil
+	if m.SeededQuantity > 0 {
+		rate := float32(m.SeededQuantity-m.NotGerminatedQuantity) / float32(m.SeededQuantity) * 100
+		m.GerminationRate = &rate
+	}
+
+	m.SurvivalRate = nil
+	if m.PlantedQuantity > 0 {
+		rate := float32(m.PlantedQuantity-m.DumpedQuantity) / float32(m.PlantedQuantity) * 100
+		m.SurvivalRate = &rate
+	}
+}
+
+// NewCropMetrics computes the metrics of a crop batch from its read model.
+// areaTypes maps the area UIDs to their type, which is the area stage.
+//
+// The germination rate is only computed for seeding crop batches. It is the share of seeds
+// that were not dumped as not germinated. The survival rate of an area is the share
+// of the plants put in the area that were not dumped there. Plants moved out are not losses.
+func NewCropMetrics(cropRead storage.CropRead, areaTypes map[uuid.UUID]string) CropMetrics {
+	metrics := CropMetrics{
+		UID:          cropRead.UID,
+		BatchID:      cropRead.BatchID,
+		Type:         cropRead.Type,
+		InventoryUID: cropRead.Inventory.UID,
+		Variety:      cropRead.Inventory.Name,
+		LossMetrics:  newLossMetrics(),
+		Stages:       []StageMetrics{},
+		Areas:        []AreaMetrics{},
+	}
+
+	areas := make(map[uuid.UUID]*AreaMetrics)
+	areaOrder := []uuid.UUID{}
+
+	getArea := func(areaUID uuid.UUID, areaName string) *AreaMetrics {
+		if _, ok := areas[areaUID]; !ok {
+			areas[areaUID] = &AreaMetrics{
+				AreaUID:     areaUID,
+				AreaName:    areaName,
+				AreaType:    areaTypes[areaUID],
+				LossMetrics: newLossMetrics(),
+			}
+			areaOrder = append(areaOrder, areaUID)
+		}
+
+		return areas[areaUID]
+	}
+
+	initialArea := getArea(cropRead.InitialArea.AreaUID, cropRead.InitialArea.Name)
+	initialArea.PlantedQuantity += cropRead.InitialArea.InitialQuantity
+
+	for _, v := range cropRead.MovedArea {
+		area := getArea(v.AreaUID, v.Name)
+		area.PlantedQuantity += v.InitialQuantity
+	}
+
+	for _, v := range cropRead.Trash {
+		area := getArea(v.SourceAreaUID, v.SourceAreaName)
+		area.DumpedQuantity += v.Quantity
+
+		reasons := domain.Trash{Quantity: v.Quantity, Reasons: v.Reasons}.QuantityByReason()
+		for k, q := range reasons {
+			area.LossReasons[k] += q
+		}
+
+		if cropRead.Type == domain.CropTypeSeeding {
+			metrics.NotGerminatedQuantity += reasons[domain.DumpReasonNotGerminated]
+		}
+	}
+
+	if cropRead.Type == domain.CropTypeSeeding {
+		metrics.SeededQuantity = cropRead.InitialArea.InitialQuantity
+
+		initialArea.SeededQuantity = metrics.SeededQuantity
+		initialArea.NotGerminatedQuantity = metrics.NotGerminatedQuantity
+	}
+
+	metrics.PlantedQuantity = cropRead.InitialArea.InitialQuantity
+
+	stages := make(map[string]*StageMetrics)
+	stageOrder := []string{}
+
+	for _, uid := range areaOrder {
+		area := areas[uid]
+		area.computeRates()
+
+		metrics.DumpedQuantity += area.DumpedQuantity
+		for k, v := range area.LossReasons {
+			metrics.LossReasons[k] += v
+		}
+
+		metrics.Areas = append(metrics.Areas, *area)
+
+		if _, ok := stages[area.AreaType]; !ok {
+			stages[area.AreaType] = &StageMetrics{Stage: area.AreaType, LossMetrics: newLossMetrics()}
+			stageOrder = append(stageOrder, area.AreaType)
+		}
+
+		stages[area.AreaType].add(area.LossMetrics)
+	}
+
+	for _, v := range stageOrder {
+		metrics.Stages = append(metrics.Stages, *stages[v])
+	}
+
+	metrics.computeRates()
+
+	return metrics
+}
+
+// AggregateCropMetricsByVariety sums the crop batch metrics per variety
+func AggregateCropMetricsByVariety(cropMetrics []CropMetrics) []VarietyMetrics {
+	varieties := make(map[uuid.UUID]*VarietyMetrics)
+	stages := make(map[uuid.UUID]map[string]*StageMetrics)
+
+	for _, v := range cropMetrics {
+		if _, ok := varieties[v.InventoryUID]; !ok {
+			varieties[v.InventoryUID] = &VarietyMetrics{
+				InventoryUID: v.InventoryUID,
+				Variety:      v.Variety,
+				LossMetrics:  newLossMetrics(),
+			}
+			stages[v.InventoryUID] = make(map[string]*StageMetrics)
+		}
+
+		variety := varieties[v.InventoryUID]
+		variety.TotalBatch++
+		variety.add(v.LossMetrics)
+
+		for _, stage := range v.Stages {
+			if _, ok := stages[v.InventoryUID][stage.Stage]; !ok {
+				stages[v.InventoryUID][stage.Stage] = &StageMetrics{Stage: stage.Stage, LossMetrics: newLossMetrics()}
+			}
+
+			stages[v.InventoryUID][stage.Stage].add(stage.LossMetrics)
+		}
+	}
+
+	result := []VarietyMetrics{}
+	for uid, v := range varieties {
+		v.Stages = []StageMetrics{}
+		for _, stage := range stages[uid] {
+			v.Stages = append(v.Stages, *stage)
+		}
+
+		sort.Slice(v.Stages, func(i, j int) bool { return v.Stages[i].Stage > v.Stages[j].Stage })
+
+		result = append(result, *v)
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].Variety < result[j].Variety })
+
+	return result
+}
+
+// AggregateCropMetricsByArea sums the crop batch metrics per area
+func AggregateCropMetricsByArea(cropMetrics []CropMetrics) []AreaMetrics {
+	areas := make(map[uuid.UUID]*AreaMetrics)
+
+	for _, v := range cropMetrics {
+		for _, area := range v.Areas {
+			if _, ok := areas[area.AreaUID]; !ok {
+				areas[area.AreaUID] = &AreaMetrics{
+					AreaUID:     area.AreaUID,
+					AreaName:    area.AreaName,
+					AreaType:    area.AreaType,
+					LossMetrics: newLossMetrics(),
+				}
+			}
+
+			areas[area.AreaUID].add(area.LossMetrics)
+		}
+	}
+
+	result := []AreaMetrics{}
+	for _, v := range areas {
+		result = append(result, *v)
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].AreaName < result[j].AreaName })
+
+	return result
+}
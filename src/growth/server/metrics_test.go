@@ -0,0 +1,265 @@
+package server_test
+
+import (
+	"testing"
+
+	"github.com/Tanibox/tania-core/src/growth/domain"
+	. "github.com/Tanibox/tania-core/src/growth/server"
+	"github.com/Tanibox/tania-core/src/growth/storage"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func rate(v float32) *float32 {
+	return &v
+}
+
+func TestNewCropMetrics(t *testing.T) {
+	// Given
+	seedingAreaUID, _ := uuid.NewV4()
+	growingAreaUID, _ := uuid.NewV4()
+	inventoryUID, _ := uuid.NewV4()
+
+	areaTypes := map[uuid.UUID]string{
+		seedingAreaUID: "SEEDING",
+		growingAreaUID: "GROWING",
+	}
+
+	tests := []struct {
+		name            string
+		cropRead        storage.CropRead
+		seeded          int
+		notGerminated   int
+		planted         int
+		dumped          int
+		germinationRate *float32
+		survivalRate    *float32
+		lossReasons     map[string]int
+		stages          []string
+		areas           []AreaMetrics
+	}{
+		{
+			name: "seeding batch moved to a growing area",
+			cropRead: storage.CropRead{
+				Type:        domain.CropTypeSeeding,
+				Inventory:   storage.Inventory{UID: inventoryUID, Name: "Basil"},
+				InitialArea: storage.InitialArea{AreaUID: seedingAreaUID, Name: "Seeding A", InitialQuantity: 100},
+				MovedArea: []storage.MovedArea{
+					{AreaUID: growingAreaUID, Name: "Growing B", InitialQuantity: 80},
+				},
+				Trash: []storage.Trash{
+					{SourceAreaUID: seedingAreaUID, SourceAreaName: "Seeding A", Quantity: 10, Reasons: map[string]int{domain.DumpReasonNotGerminated: 10}},
+					{SourceAreaUID: growingAreaUID, SourceAreaName: "Growing B", Quantity: 10, Reasons: map[string]int{domain.DumpReasonDisease: 8}},
+				},
+			},
+			seeded:          100,
+			notGerminated:   10,
+			planted:         100,
+			dumped:          20,
+			germinationRate: rate(90),
+			survivalRate:    rate(80),
+			lossReasons:     map[string]int{domain.DumpReasonNotGerminated: 10, domain.DumpReasonDisease: 8, domain.DumpReasonOther: 2},
+			stages:          []string{"SEEDING", "GROWING"},
+			areas: []AreaMetrics{
+				{
+					AreaUID:  seedingAreaUID,
+					AreaName: "Seeding A",
+					AreaType: "SEEDING",
+					LossMetrics: LossMetrics{
+						SeededQuantity:        100,
+						NotGerminatedQuantity: 10,
+						PlantedQuantity:       100,
+						DumpedQuantity:        10,
+						GerminationRate:       rate(90),
+						SurvivalRate:          rate(90),
+						LossReasons:           map[string]int{domain.DumpReasonNotGerminated: 10},
+					},
+				},
+				{
+					AreaUID:  growingAreaUID,
+					AreaName: "Growing B",
+					AreaType: "GROWING",
+					LossMetrics: LossMetrics{
+						PlantedQuantity: 80,
+						DumpedQuantity:  10,
+						SurvivalRate:    rate(87.5),
+						LossReasons:     map[string]int{domain.DumpReasonDisease: 8, domain.DumpReasonOther: 2},
+					},
+				},
+			},
+		},
+		{
+			name: "growing batch without losses",
+			cropRead: storage.CropRead{
+				Type:        domain.CropTypeGrowing,
+				Inventory:   storage.Inventory{UID: inventoryUID, Name: "Basil"},
+				InitialArea: storage.InitialArea{AreaUID: growingAreaUID, Name: "Growing B", InitialQuantity: 40},
+			},
+			planted:      40,
+			survivalRate: rate(100),
+			lossReasons:  map[string]int{},
+			stages:       []string{"GROWING"},
+			areas: []AreaMetrics{
+				{
+					AreaUID:  growingAreaUID,
+					AreaName: "Growing B",
+					AreaType: "GROWING",
+					LossMetrics: LossMetrics{
+						PlantedQuantity: 40,
+						SurvivalRate:    rate(100),
+						LossReasons:     map[string]int{},
+					},
+				},
+			},
+		},
+		{
+			name: "seeding batch without plants",
+			cropRead: storage.CropRead{
+				Type:        domain.CropTypeSeeding,
+				Inventory:   storage.Inventory{UID: inventoryUID, Name: "Basil"},
+				InitialArea: storage.InitialArea{AreaUID: seedingAreaUID, Name: "Seeding A"},
+			},
+			lossReasons: map[string]int{},
+			stages:      []string{"SEEDING"},
+			areas: []AreaMetrics{
+				{
+					AreaUID:     seedingAreaUID,
+					AreaName:    "Seeding A",
+					AreaType:    "SEEDING",
+					LossMetrics: LossMetrics{LossReasons: map[string]int{}},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		// When
+		metrics := NewCropMetrics(test.cropRead, areaTypes)
+
+		// Then
+		assert.Equal(t, test.seeded, metrics.SeededQuantity, test.name)
+		assert.Equal(t, test.notGerminated, metrics.NotGerminatedQuantity, test.name)
+		assert.Equal(t, test.planted, metrics.PlantedQuantity, test.name)
+		assert.Equal(t, test.dumped, metrics.DumpedQuantity, test.name)
+		assert.Equal(t, test.germinationRate, metrics.GerminationRate, test.name)
+		assert.Equal(t, test.survivalRate, metrics.SurvivalRate, test.name)
+		assert.Equal(t, test.lossReasons, metrics.LossReasons, test.name)
+		assert.Equal(t, test.areas, metrics.Areas, test.name)
+
+		stages := []string{}
+		for _, v := range metrics.Stages {
+			stages = append(stages, v.Stage)
+		}
+
+		assert.Equal(t, test.stages, stages, test.name)
+	}
+}
+
+func TestAggregateCropMetrics(t *testing.T) {
+	// Given
+	seedingAreaUID, _ := uuid.NewV4()
+	growingAreaUID, _ := uuid.NewV4()
+	basilUID, _ := uuid.NewV4()
+	tomatoUID, _ := uuid.NewV4()
+
+	areaTypes := map[uuid.UUID]string{
+		seedingAreaUID: "SEEDING",
+		growingAreaUID: "GROWING",
+	}
+
+	cropMetrics := []CropMetrics{
+		NewCropMetrics(storage.CropRead{
+			Type:        domain.CropTypeSeeding,
+			Inventory:   storage.Inventory{UID: tomatoUID, Name: "Tomato"},
+			InitialArea: storage.InitialArea{AreaUID: seedingAreaUID, Name: "Seeding A", InitialQuantity: 50},
+			Trash: []storage.Trash{
+				{SourceAreaUID: seedingAreaUID, SourceAreaName: "Seeding A", Quantity: 5, Reasons: map[string]int{domain.DumpReasonNotGerminated: 5}},
+			},
+		}, areaTypes),
+		NewCropMetrics(storage.CropRead{
+			Type:        domain.CropTypeSeeding,
+			Inventory:   storage.Inventory{UID: basilUID, Name: "Basil"},
+			InitialArea: storage.InitialArea{AreaUID: seedingAreaUID, Name: "Seeding A", InitialQuantity: 100},
+			MovedArea: []storage.MovedArea{
+				{AreaUID: growingAreaUID, Name: "Growing B", InitialQuantity: 90},
+			},
+			Trash: []storage.Trash{
+				{SourceAreaUID: seedingAreaUID, SourceAreaName: "Seeding A", Quantity: 10, Reasons: map[string]int{domain.DumpReasonNotGerminated: 10}},
+			},
+		}, areaTypes),
+		NewCropMetrics(storage.CropRead{
+			Type:        domain.CropTypeGrowing,
+			Inventory:   storage.Inventory{UID: basilUID, Name: "Basil"},
+			InitialArea: storage.InitialArea{AreaUID: growingAreaUID, Name: "Growing B", InitialQuantity: 10},
+			Trash: []storage.Trash{
+				{SourceAreaUID: growingAreaUID, SourceAreaName: "Growing B", Quantity: 10, Reasons: map[string]int{domain.DumpReasonPest: 10}},
+			},
+		}, areaTypes),
+	}
+
+	// When
+	varieties := AggregateCropMetricsByVariety(cropMetrics)
+
+	// Then
+	tests := []struct {
+		variety         string
+		totalBatch      int
+		planted         int
+		dumped          int
+		germinationRate float32
+		survivalRate    float32
+		stages          []string
+	}{
+		{variety: "Basil", totalBatch: 2, planted: 110, dumped: 20, germinationRate: 90, survivalRate: 81.82, stages: []string{"SEEDING", "GROWING"}},
+		{variety: "Tomato", totalBatch: 1, planted: 50, dumped: 5, germinationRate: 90, survivalRate: 90, stages: []string{"SEEDING"}},
+	}
+
+	assert.Len(t, varieties, len(tests))
+	for i, test := range tests {
+		assert.Equal(t, test.variety, varieties[i].Variety)
+		assert.Equal(t, test.totalBatch, varieties[i].TotalBatch, test.variety)
+		assert.Equal(t, test.planted, varieties[i].PlantedQuantity, test.variety)
+		assert.Equal(t, test.dumped, varieties[i].DumpedQuantity, test.variety)
+		assert.InDelta(t, test.germinationRate, *varieties[i].GerminationRate, 0.01, test.variety)
+		assert.InDelta(t, test.survivalRate, *varieties[i].SurvivalRate, 0.01, test.variety)
+
+		stages := []string{}
+		for _, v := range varieties[i].Stages {
+			stages = append(stages, v.Stage)
+		}
+
+		assert.Equal(t, test.stages, stages, test.variety)
+	}
+
+	// When
+	areas := AggregateCropMetricsByArea(cropMetrics)
+
+	// Then
+	assert.Equal(t, []AreaMetrics{
+		{
+			AreaUID:  growingAreaUID,
+			AreaName: "Growing B",
+			AreaType: "GROWING",
+			LossMetrics: LossMetrics{
+				PlantedQuantity: 100,
+				DumpedQuantity:  10,
+				SurvivalRate:    rate(90),
+				LossReasons:     map[string]int{domain.DumpReasonPest: 10},
+			},
+		},
+		{
+			AreaUID:  seedingAreaUID,
+			AreaName: "Seeding A",
+			AreaType: "SEEDING",
+			LossMetrics: LossMetrics{
+				SeededQuantity:        150,
+				NotGerminatedQuantity: 15,
+				PlantedQuantity:       150,
+				DumpedQuantity:        15,
+				GerminationRate:       rate(90),
+				SurvivalRate:          rate(90),
+				LossReasons:           map[string]int{domain.DumpReasonNotGerminated: 15},
+			},
+		},
+	}, areas)
+}
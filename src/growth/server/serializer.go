@@ -8,6 +8,7 @@ import (
 
 	"github.com/Tanibox/tania-core/src/growth/query"
 	"github.com/Tanibox/tania-core/src/growth/storage"
+	"github.com/Tanibox/tania-core/src/helper/datetimehelper"
 	uuid "github.com/satori/go.uuid"
 
 	"github.com/Tanibox/tania-core/src/growth/domain"
@@ -60,6 +61,12 @@ type TaskSafetyActivity struct{ *storage.TaskSafetyActivity }
 type TaskSanitationActivity struct {
 	*storage.TaskSanitationActivity
 }
+type HarvestCorrectionActivity struct {
+	*storage.HarvestCorrectionActivity
+}
+type DumpRevertActivity struct{ *storage.DumpRevertActivity }
+type MoveRevertActivity struct{ *storage.MoveRevertActivity }
+type UnarchiveActivity struct{ *storage.UnarchiveActivity }
 
 func MapToCropActivity(activity storage.CropActivity) CropActivity {
 	ca := CropActivity(activity)
@@ -87,6 +94,14 @@ func MapToCropActivity(activity storage.CropActivity) CropActivity {
 		ca.ActivityType = TaskSanitationActivity{&v}
 	case storage.TaskSafetyActivity:
 		ca.ActivityType = TaskSafetyActivity{&v}
+	case storage.HarvestCorrectionActivity:
+		ca.ActivityType = HarvestCorrectionActivity{&v}
+	case storage.DumpRevertActivity:
+		ca.ActivityType = DumpRevertActivity{&v}
+	case storage.MoveRevertActivity:
+		ca.ActivityType = MoveRevertActivity{&v}
+	case storage.UnarchiveActivity:
+		ca.ActivityType = UnarchiveActivity{&v}
 	}
 
 	return ca
@@ -217,6 +232,7 @@ func MapToCropRead(s *GrowthServer, crop domain.Crop) (storage.CropRead, error)
 			SourceAreaName: area.Name,
 			CreatedDate:    v.CreatedDate,
 			LastUpdated:    v.LastUpdated,
+			Reasons:        v.Reasons,
 		})
 	}
 
@@ -313,6 +329,54 @@ func MapToCropRead(s *GrowthServer, crop domain.Crop) (storage.CropRead, error)
 	return cropRead, nil
 }
 
+// SuccessionPlan is a succession plan with its planned crop batches compared to the seeded ones
+type SuccessionPlan struct {
+	storage.SuccessionPlanRead
+	Summary SuccessionPlanSummary `json:"summary"`
+}
+
+type SuccessionPlanSummary struct {
+	PlannedBatches   int `json:"planned_batches"`
+	ConfirmedBatches int `json:"confirmed_batches"`
+	SkippedBatches   int `json:"skipped_batches"`
+	OverdueBatches   int `json:"overdue_batches"`
+	PlannedQuantity  int `json:"planned_quantity"`
+	ActualQuantity   int `json:"actual_quantity"`
+}
+
+// MapToSuccessionPlan marks the planned crop batches which should have been seeded before today as overdue.
+// The planned quantity doesn't count the skipped crop batches.
+func MapToSuccessionPlan(successionPlan storage.SuccessionPlanRead, now time.Time) SuccessionPlan {
+	today, _ := datetimehelper.DayRange(now)
+
+	sp := SuccessionPlan{SuccessionPlanRead: successionPlan}
+	sp.PlannedBatches = make([]storage.SuccessionPlannedBatch, len(successionPlan.PlannedBatches))
+	copy(sp.PlannedBatches, successionPlan.PlannedBatches)
+
+	for i, v := range sp.PlannedBatches {
+		sp.Summary.PlannedBatches++
+
+		switch v.Status {
+		case domain.PlannedBatchStatusConfirmed:
+			sp.Summary.ConfirmedBatches++
+			sp.Summary.PlannedQuantity += v.Quantity
+			if v.Crop != nil {
+				sp.Summary.ActualQuantity += v.Crop.Quantity
+			}
+		case domain.PlannedBatchStatusSkipped:
+			sp.Summary.SkippedBatches++
+		case domain.PlannedBatchStatusPlanned:
+			sp.Summary.PlannedQuantity += v.Quantity
+			if v.SeedingDate.Before(today) {
+				sp.PlannedBatches[i].Status = domain.PlannedBatchStatusOverdue
+				sp.Summary.OverdueBatches++
+			}
+		}
+	}
+
+	return sp
+}
+
 func MapToCropListInArea(crop query.CropAreaByAreaQueryResult) (CropListInArea, error) {
 	cl := CropListInArea{}
 
@@ -462,3 +526,47 @@ func (a TaskSanitationActivity) MarshalJSON() ([]byte, error) {
 		Code:  a.Code(),
 	})
 }
+
+func (a HarvestCorrectionActivity) MarshalJSON() ([]byte, error) {
+	type Alias HarvestCorrectionActivity
+	return json.Marshal(struct {
+		*Alias
+		Code string `json:"code"`
+	}{
+		Alias: (*Alias)(&a),
+		Code:  a.Code(),
+	})
+}
+
+func (a DumpRevertActivity) MarshalJSON() ([]byte, error) {
+	type Alias DumpRevertActivity
+	return json.Marshal(struct {
+		*Alias
+		Code string `json:"code"`
+	}{
+		Alias: (*Alias)(&a),
+		Code:  a.Code(),
+	})
+}
+
+func (a MoveRevertActivity) MarshalJSON() ([]byte, error) {
+	type Alias MoveRevertActivity
+	return json.Marshal(struct {
+		*Alias
+		Code string `json:"code"`
+	}{
+		Alias: (*Alias)(&a),
+		Code:  a.Code(),
+	})
+}
+
+func (a UnarchiveActivity) MarshalJSON() ([]byte, error) {
+	type Alias UnarchiveActivity
+	return json.Marshal(struct {
+		*Alias
+		Code string `json:"code"`
+	}{
+		Alias: (*Alias)(&a),
+		Code:  a.Code(),
+	})
+}
@@ -0,0 +1,261 @@
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/growth/query"
+	"github.com/Tanibox/tania-core/src/growth/storage"
+	"github.com/jung-kurt/gofpdf"
+	uuid "github.com/satori/go.uuid"
+)
+
+const traceabilityDateFormat = "2006-01-02 15:04"
+
+// Traceability is the lineage of crop batches for food-safety recalls.
+// It goes from the seed material to the crop batches planted from it,
+// then to everything that happened to each crop batch until its harvest lots.
+type Traceability struct {
+	Material      *TraceabilityMaterial `json:"material"`
+	Crops         []TraceabilityCrop    `json:"crops"`
+	GeneratedDate time.Time             `json:"generated_date"`
+}
+
+type TraceabilityMaterial struct {
+	UID         uuid.UUID `json:"uid"`
+	Name        string    `json:"name"`
+	Type        string    `json:"type"`
+	PlantType   string    `json:"plant_type"`
+	PlantFamily string    `json:"plant_family"`
+}
+
+type TraceabilityCrop struct {
+	UID         uuid.UUID              `json:"uid"`
+	BatchID     string                 `json:"batch_id"`
+	Status      string                 `json:"status"`
+	SeedingDate time.Time              `json:"seeding_date"`
+	Areas       []TraceabilityArea     `json:"areas"`
+	Waterings   []TraceabilityWatering `json:"waterings"`
+	Tasks       []TraceabilityTask     `json:"tasks"`
+	Harvests    []storage.HarvestLot   `json:"harvests"`
+}
+
+// TraceabilityArea is an area where the crop batch has lived
+type TraceabilityArea struct {
+	AreaUID     uuid.UUID `json:"area_id"`
+	Name        string    `json:"name"`
+	Quantity    int       `json:"quantity"`
+	PlantedDate time.Time `json:"planted_date"`
+}
+
+type TraceabilityWatering struct {
+	AreaUID      uuid.UUID `json:"area_id"`
+	AreaName     string    `json:"area_name"`
+	WateringDate time.Time `json:"watering_date"`
+}
+
+// TraceabilityTask is a completed task that applied a material to the crop batch
+type TraceabilityTask struct {
+	TaskUID       uuid.UUID `json:"task_id"`
+	Category      string    `json:"category"`
+	MaterialType  string    `json:"material_type"`
+	MaterialName  string    `json:"material_name"`
+	AreaName      string    `json:"area_name"`
+	CompletedDate time.Time `json:"completed_date"`
+}
+
+// NewTraceabilityMaterial maps the material of the crop batches
+func NewTraceabilityMaterial(material query.CropMaterialQueryResult) *TraceabilityMaterial {
+	return &TraceabilityMaterial{
+		UID:         material.UID,
+		Name:        material.Name,
+		Type:        material.TypeCode,
+		PlantType:   material.PlantTypeCode,
+		PlantFamily: material.PlantFamily,
+	}
+}
+
+// NewTraceabilityCrop builds the lineage of a crop batch from its read model, activities and harvest lots.
+// When until is not nil, only what happened to the crop batch until that date is kept,
+// which is used to trace a single harvest lot.
+func NewTraceabilityCrop(cropRead storage.CropRead, activities []storage.CropActivity, harvestLots []storage.HarvestLot, until *time.Time) TraceabilityCrop {
+	tc := TraceabilityCrop{
+		UID:         cropRead.UID,
+		BatchID:     cropRead.BatchID,
+		Status:      cropRead.Status,
+		SeedingDate: cropRead.InitialArea.CreatedDate,
+		Areas:       []TraceabilityArea{},
+		Waterings:   []TraceabilityWatering{},
+		Tasks:       []TraceabilityTask{},
+		Harvests:    []storage.HarvestLot{},
+	}
+
+	isBefore := func(date time.Time) bool {
+		return until == nil || !date.After(*until)
+	}
+
+	tc.Areas = append(tc.Areas, TraceabilityArea{
+		AreaUID:     cropRead.InitialArea.AreaUID,
+		Name:        cropRead.InitialArea.Name,
+		Quantity:    cropRead.InitialArea.InitialQuantity,
+		PlantedDate: cropRead.InitialArea.CreatedDate,
+	})
+
+	for _, v := range cropRead.MovedArea {
+		if !isBefore(v.CreatedDate) {
+			continue
+		}
+
+		tc.Areas = append(tc.Areas, TraceabilityArea{
+			AreaUID:     v.AreaUID,
+			Name:        v.Name,
+			Quantity:    v.InitialQuantity,
+			PlantedDate: v.CreatedDate,
+		})
+	}
+
+	for _, v := range activities {
+		if !isBefore(v.CreatedDate) {
+			continue
+		}
+
+		switch a := v.ActivityType.(type) {
+		case storage.WaterActivity:
+			tc.Waterings = append(tc.Waterings, TraceabilityWatering{
+				AreaUID:      a.AreaUID,
+				AreaName:     a.AreaName,
+				WateringDate: a.WateringDate,
+			})
+		case storage.TaskNutrientActivity:
+			tc.Tasks = append(tc.Tasks, TraceabilityTask{
+				TaskUID:       a.TaskUID,
+				Category:      a.Code(),
+				MaterialType:  a.MaterialType,
+				MaterialName:  a.MaterialName,
+				AreaName:      a.AreaName,
+				CompletedDate: v.CreatedDate,
+			})
+		case storage.TaskPestControlActivity:
+			tc.Tasks = append(tc.Tasks, TraceabilityTask{
+				TaskUID:       a.TaskUID,
+				Category:      a.Code(),
+				MaterialType:  a.MaterialType,
+				MaterialName:  a.MaterialName,
+				AreaName:      a.AreaName,
+				CompletedDate: v.CreatedDate,
+			})
+		}
+	}
+
+	for _, v := range harvestLots {
+		if !isBefore(v.HarvestDate) {
+			continue
+		}
+
+		tc.Harvests = append(tc.Harvests, v)
+	}
+
+	sort.Slice(tc.Areas, func(i, j int) bool { return tc.Areas[i].PlantedDate.Before(tc.Areas[j].PlantedDate) })
+	sort.Slice(tc.Waterings, func(i, j int) bool { return tc.Waterings[i].WateringDate.Before(tc.Waterings[j].WateringDate) })
+	sort.Slice(tc.Tasks, func(i, j int) bool { return tc.Tasks[i].CompletedDate.Before(tc.Tasks[j].CompletedDate) })
+	sort.Slice(tc.Harvests, func(i, j int) bool { return tc.Harvests[i].HarvestDate.Before(tc.Harvests[j].HarvestDate) })
+
+	return tc
+}
+
+// RenderTraceabilityPDF renders the traceability report as a printable A4 PDF
+func RenderTraceabilityPDF(t Traceability) ([]byte, error) {
+	pdf := gofpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(15, 15, 15)
+	pdf.SetAutoPageBreak(true, 15)
+	pdf.AddPage()
+
+	translate := pdf.UnicodeTranslatorFromDescriptor("")
+
+	heading := func(text string) {
+		pdf.Ln(3)
+		pdf.SetFont("Helvetica", "B", 12)
+		pdf.CellFormat(0, 7, translate(text), "B", 1, "L", false, 0, "")
+		pdf.SetFont("Helvetica", "", 10)
+	}
+
+	line := func(text string) {
+		pdf.MultiCell(0, 5, translate(text), "", "L", false)
+	}
+
+	pdf.SetFont("Helvetica", "B", 16)
+	pdf.CellFormat(0, 10, "Traceability Report", "", 1, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "", 10)
+	line("Generated on " + t.GeneratedDate.Format(traceabilityDateFormat))
+
+	if t.Material != nil {
+		heading("Seed material")
+		line("Name: " + t.Material.Name)
+		line("Type: " + t.Material.Type + ", plant type: " + t.Material.PlantType)
+		if t.Material.PlantFamily != "" {
+			line("Plant family: " + t.Material.PlantFamily)
+		}
+	}
+
+	for _, crop := range t.Crops {
+		heading("Crop batch " + crop.BatchID)
+		line("Status: " + crop.Status + ", seeded on " + crop.SeedingDate.Format(traceabilityDateFormat))
+
+		pdf.Ln(2)
+		pdf.SetFont("Helvetica", "B", 10)
+		line("Areas")
+		pdf.SetFont("Helvetica", "", 10)
+		for _, v := range crop.Areas {
+			line(fmt.Sprintf("- %s: %d planted on %s", v.Name, v.Quantity, v.PlantedDate.Format(traceabilityDateFormat)))
+		}
+
+		pdf.Ln(2)
+		pdf.SetFont("Helvetica", "B", 10)
+		line("Waterings")
+		pdf.SetFont("Helvetica", "", 10)
+		if len(crop.Waterings) == 0 {
+			line("- None")
+		}
+		for _, v := range crop.Waterings {
+			line("- " + v.AreaName + " on " + v.WateringDate.Format(traceabilityDateFormat))
+		}
+
+		pdf.Ln(2)
+		pdf.SetFont("Helvetica", "B", 10)
+		line("Nutrient and pest control tasks")
+		pdf.SetFont("Helvetica", "", 10)
+		if len(crop.Tasks) == 0 {
+			line("- None")
+		}
+		for _, v := range crop.Tasks {
+			line(fmt.Sprintf("- %s: %s (%s) in %s, completed on %s",
+				v.Category, v.MaterialName, v.MaterialType, v.AreaName, v.CompletedDate.Format(traceabilityDateFormat)))
+		}
+
+		pdf.Ln(2)
+		pdf.SetFont("Helvetica", "B", 10)
+		line("Harvest lots")
+		pdf.SetFont("Helvetica", "", 10)
+		if len(crop.Harvests) == 0 {
+			line("- None")
+		}
+		for _, v := range crop.Harvests {
+			line(fmt.Sprintf("- %s: grade %s, %.2f gr from %s, harvested on %s",
+				v.LotNumber, v.Grade, v.ProducedGramQuantity, v.SourceAreaName, v.HarvestDate.Format(traceabilityDateFormat)))
+		}
+	}
+
+	if pdf.Err() {
+		return nil, pdf.Error()
+	}
+
+	buf := new(bytes.Buffer)
+	err := pdf.Output(buf)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,101 @@
+package server_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/growth/domain"
+	. "github.com/Tanibox/tania-core/src/growth/server"
+	"github.com/Tanibox/tania-core/src/growth/storage"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTraceabilityCrop(t *testing.T) {
+	// Given
+	cropUID, _ := uuid.NewV4()
+	seedingAreaUID, _ := uuid.NewV4()
+	growingAreaUID, _ := uuid.NewV4()
+	greenhouseAreaUID, _ := uuid.NewV4()
+	nutrientTaskUID, _ := uuid.NewV4()
+	pestControlTaskUID, _ := uuid.NewV4()
+	firstLotUID, _ := uuid.NewV4()
+	secondLotUID, _ := uuid.NewV4()
+
+	day := func(d int) time.Time {
+		return time.Date(2018, time.May, d, 8, 0, 0, 0, time.UTC)
+	}
+
+	cropRead := storage.CropRead{
+		UID:         cropUID,
+		BatchID:     "bro-sal-1may",
+		Status:      domain.CropActive,
+		InitialArea: storage.InitialArea{AreaUID: seedingAreaUID, Name: "Seeding A", InitialQuantity: 50, CreatedDate: day(1)},
+		MovedArea: []storage.MovedArea{
+			{AreaUID: greenhouseAreaUID, Name: "Greenhouse C", InitialQuantity: 10, CreatedDate: day(20)},
+			{AreaUID: growingAreaUID, Name: "Growing B", InitialQuantity: 40, CreatedDate: day(10)},
+		},
+	}
+
+	activities := []storage.CropActivity{
+		{ActivityType: storage.SeedActivity{AreaUID: seedingAreaUID, AreaName: "Seeding A", Quantity: 50, SeedingDate: day(1)}, CreatedDate: day(1)},
+		{ActivityType: storage.WaterActivity{AreaUID: growingAreaUID, AreaName: "Growing B", WateringDate: day(12)}, CreatedDate: day(12)},
+		{ActivityType: storage.WaterActivity{AreaUID: seedingAreaUID, AreaName: "Seeding A", WateringDate: day(3)}, CreatedDate: day(3)},
+		{ActivityType: storage.TaskPestControlActivity{TaskUID: pestControlTaskUID, MaterialType: "PESTICIDE", MaterialName: "Neem Oil", AreaName: "Growing B"}, CreatedDate: day(21)},
+		{ActivityType: storage.TaskNutrientActivity{TaskUID: nutrientTaskUID, MaterialType: "AGROCHEMICAL", MaterialName: "NPK", AreaName: "Growing B"}, CreatedDate: day(11)},
+	}
+
+	harvestLots := []storage.HarvestLot{
+		{UID: secondLotUID, LotNumber: "BRO-20180525-01", HarvestDate: day(25)},
+		{UID: firstLotUID, LotNumber: "BRO-20180515-01", HarvestDate: day(15)},
+	}
+
+	// When
+	tc := NewTraceabilityCrop(cropRead, activities, harvestLots, nil)
+
+	// Then
+	assert.Equal(t, cropUID, tc.UID)
+	assert.Equal(t, "bro-sal-1may", tc.BatchID)
+	assert.Equal(t, domain.CropActive, tc.Status)
+	assert.Equal(t, day(1), tc.SeedingDate)
+	assert.Equal(t, []TraceabilityArea{
+		{AreaUID: seedingAreaUID, Name: "Seeding A", Quantity: 50, PlantedDate: day(1)},
+		{AreaUID: growingAreaUID, Name: "Growing B", Quantity: 40, PlantedDate: day(10)},
+		{AreaUID: greenhouseAreaUID, Name: "Greenhouse C", Quantity: 10, PlantedDate: day(20)},
+	}, tc.Areas)
+	assert.Equal(t, []TraceabilityWatering{
+		{AreaUID: seedingAreaUID, AreaName: "Seeding A", WateringDate: day(3)},
+		{AreaUID: growingAreaUID, AreaName: "Growing B", WateringDate: day(12)},
+	}, tc.Waterings)
+	assert.Equal(t, []TraceabilityTask{
+		{TaskUID: nutrientTaskUID, Category: storage.TaskNutrientActivityCode, MaterialType: "AGROCHEMICAL", MaterialName: "NPK", AreaName: "Growing B", CompletedDate: day(11)},
+		{TaskUID: pestControlTaskUID, Category: storage.TaskPestControlActivityCode, MaterialType: "PESTICIDE", MaterialName: "Neem Oil", AreaName: "Growing B", CompletedDate: day(21)},
+	}, tc.Tasks)
+	assert.Len(t, tc.Harvests, 2)
+	assert.Equal(t, firstLotUID, tc.Harvests[0].UID)
+	assert.Equal(t, secondLotUID, tc.Harvests[1].UID)
+
+	// When
+	until := day(15)
+	tc = NewTraceabilityCrop(cropRead, activities, harvestLots, &until)
+
+	// Then
+	assert.Equal(t, []TraceabilityArea{
+		{AreaUID: seedingAreaUID, Name: "Seeding A", Quantity: 50, PlantedDate: day(1)},
+		{AreaUID: growingAreaUID, Name: "Growing B", Quantity: 40, PlantedDate: day(10)},
+	}, tc.Areas)
+	assert.Len(t, tc.Waterings, 2)
+	assert.Len(t, tc.Tasks, 1)
+	assert.Equal(t, nutrientTaskUID, tc.Tasks[0].TaskUID)
+	assert.Len(t, tc.Harvests, 1)
+	assert.Equal(t, firstLotUID, tc.Harvests[0].UID)
+
+	// When
+	tc = NewTraceabilityCrop(storage.CropRead{UID: cropUID}, nil, nil, nil)
+
+	// Then
+	assert.Len(t, tc.Areas, 1)
+	assert.Equal(t, []TraceabilityWatering{}, tc.Waterings)
+	assert.Equal(t, []TraceabilityTask{}, tc.Tasks)
+	assert.Equal(t, []storage.HarvestLot{}, tc.Harvests)
+}
@@ -42,3 +42,123 @@ func CreateCropActivityStorage() *CropActivityStorage {
 
 	return &CropActivityStorage{CropActivityMap: []CropActivity{}, Lock: &rwMutex}
 }
+
+type PlantingHistoryStorage struct {
+	Lock               *deadlock.RWMutex
+	PlantingHistoryMap []PlantingHistory
+}
+
+func CreatePlantingHistoryStorage() *PlantingHistoryStorage {
+	rwMutex := deadlock.RWMutex{}
+	deadlock.Opts.DeadlockTimeout = time.Second * 10
+	deadlock.Opts.OnPotentialDeadlock = func() {
+		fmt.Println("PLANTING HISTORY STORAGE DEADLOCK!")
+	}
+
+	return &PlantingHistoryStorage{PlantingHistoryMap: []PlantingHistory{}, Lock: &rwMutex}
+}
+
+type HarvestLotStorage struct {
+	Lock          *deadlock.RWMutex
+	HarvestLotMap map[uuid.UUID]HarvestLot
+}
+
+func CreateHarvestLotStorage() *HarvestLotStorage {
+	rwMutex := deadlock.RWMutex{}
+	deadlock.Opts.DeadlockTimeout = time.Second * 10
+	deadlock.Opts.OnPotentialDeadlock = func() {
+		fmt.Println("HARVEST LOT STORAGE DEADLOCK!")
+	}
+
+	return &HarvestLotStorage{HarvestLotMap: make(map[uuid.UUID]HarvestLot), Lock: &rwMutex}
+}
+
+type CropTemplateEventStorage struct {
+	Lock               *deadlock.RWMutex
+	CropTemplateEvents []CropTemplateEvent
+}
+
+func CreateCropTemplateEventStorage() *CropTemplateEventStorage {
+	rwMutex := deadlock.RWMutex{}
+	deadlock.Opts.DeadlockTimeout = time.Second * 10
+	deadlock.Opts.OnPotentialDeadlock = func() {
+		fmt.Println("CROP TEMPLATE EVENT STORAGE DEADLOCK!")
+	}
+
+	return &CropTemplateEventStorage{Lock: &rwMutex}
+}
+
+type CropTemplateReadStorage struct {
+	Lock                *deadlock.RWMutex
+	CropTemplateReadMap map[uuid.UUID]CropTemplateRead
+}
+
+func CreateCropTemplateReadStorage() *CropTemplateReadStorage {
+	rwMutex := deadlock.RWMutex{}
+	deadlock.Opts.DeadlockTimeout = time.Second * 10
+	deadlock.Opts.OnPotentialDeadlock = func() {
+		fmt.Println("CROP TEMPLATE READ STORAGE DEADLOCK!")
+	}
+
+	return &CropTemplateReadStorage{CropTemplateReadMap: make(map[uuid.UUID]CropTemplateRead), Lock: &rwMutex}
+}
+
+type HarvestUnitEventStorage struct {
+	Lock              *deadlock.RWMutex
+	HarvestUnitEvents []HarvestUnitEvent
+}
+
+func CreateHarvestUnitEventStorage() *HarvestUnitEventStorage {
+	rwMutex := deadlock.RWMutex{}
+	deadlock.Opts.DeadlockTimeout = time.Second * 10
+	deadlock.Opts.OnPotentialDeadlock = func() {
+		fmt.Println("HARVEST UNIT EVENT STORAGE DEADLOCK!")
+	}
+
+	return &HarvestUnitEventStorage{Lock: &rwMutex}
+}
+
+type HarvestUnitReadStorage struct {
+	Lock               *deadlock.RWMutex
+	HarvestUnitReadMap map[uuid.UUID]HarvestUnitRead
+}
+
+func CreateHarvestUnitReadStorage() *HarvestUnitReadStorage {
+	rwMutex := deadlock.RWMutex{}
+	deadlock.Opts.DeadlockTimeout = time.Second * 10
+	deadlock.Opts.OnPotentialDeadlock = func() {
+		fmt.Println("HARVEST UNIT READ STORAGE DEADLOCK!")
+	}
+
+	return &HarvestUnitReadStorage{HarvestUnitReadMap: make(map[uuid.UUID]HarvestUnitRead), Lock: &rwMutex}
+}
+
+type SuccessionPlanEventStorage struct {
+	Lock                 *deadlock.RWMutex
+	SuccessionPlanEvents []SuccessionPlanEvent
+}
+
+func CreateSuccessionPlanEventStorage() *SuccessionPlanEventStorage {
+	rwMutex := deadlock.RWMutex{}
+	deadlock.Opts.DeadlockTimeout = time.Second * 10
+	deadlock.Opts.OnPotentialDeadlock = func() {
+		fmt.Println("SUCCESSION PLAN EVENT STORAGE DEADLOCK!")
+	}
+
+	return &SuccessionPlanEventStorage{Lock: &rwMutex}
+}
+
+type SuccessionPlanReadStorage struct {
+	Lock                  *deadlock.RWMutex
+	SuccessionPlanReadMap map[uuid.UUID]SuccessionPlanRead
+}
+
+func CreateSuccessionPlanReadStorage() *SuccessionPlanReadStorage {
+	rwMutex := deadlock.RWMutex{}
+	deadlock.Opts.DeadlockTimeout = time.Second * 10
+	deadlock.Opts.OnPotentialDeadlock = func() {
+		fmt.Println("SUCCESSION PLAN READ STORAGE DEADLOCK!")
+	}
+
+	return &SuccessionPlanReadStorage{SuccessionPlanReadMap: make(map[uuid.UUID]SuccessionPlanRead), Lock: &rwMutex}
+}
@@ -83,11 +83,12 @@ type HarvestedStorage struct {
 }
 
 type Trash struct {
-	Quantity       int       `json:"quantity"`
-	SourceAreaUID  uuid.UUID `json:"source_area_id"`
-	SourceAreaName string    `json:"source_area_name"`
-	CreatedDate    time.Time `json:"created_date"`
-	LastUpdated    time.Time `json:"last_updated"`
+	Quantity       int            `json:"quantity"`
+	SourceAreaUID  uuid.UUID      `json:"source_area_id"`
+	SourceAreaName string         `json:"source_area_name"`
+	CreatedDate    time.Time      `json:"created_date"`
+	LastUpdated    time.Time      `json:"last_updated"`
+	Reasons        map[string]int `json:"reasons"`
 }
 
 type Container struct {
@@ -120,17 +121,21 @@ type CropPhoto struct {
 }
 
 const (
-	SeedActivityCode            = "SEED"
-	MoveActivityCode            = "MOVE"
-	HarvestActivityCode         = "HARVEST"
-	DumpActivityCode            = "DUMP"
-	PhotoActivityCode           = "PHOTO"
-	WaterActivityCode           = "WATER"
-	TaskCropActivityCode        = "TASK_CROP"
-	TaskNutrientActivityCode    = "TASK_NUTRIENT"
-	TaskPestControlActivityCode = "TASK_PEST_CONTROL"
-	TaskSafetyActivityCode      = "TASK_SAFETY"
-	TaskSanitationActivityCode  = "TASK_SANITATION"
+	SeedActivityCode              = "SEED"
+	MoveActivityCode              = "MOVE"
+	HarvestActivityCode           = "HARVEST"
+	DumpActivityCode              = "DUMP"
+	PhotoActivityCode             = "PHOTO"
+	WaterActivityCode             = "WATER"
+	TaskCropActivityCode          = "TASK_CROP"
+	TaskNutrientActivityCode      = "TASK_NUTRIENT"
+	TaskPestControlActivityCode   = "TASK_PEST_CONTROL"
+	TaskSafetyActivityCode        = "TASK_SAFETY"
+	TaskSanitationActivityCode    = "TASK_SANITATION"
+	HarvestCorrectionActivityCode = "HARVEST_CORRECTION"
+	DumpRevertActivityCode        = "DUMP_REVERT"
+	MoveRevertActivityCode        = "MOVE_REVERT"
+	UnarchiveActivityCode         = "UNARCHIVE"
 )
 
 type CropActivity struct {
@@ -142,10 +147,58 @@ type CropActivity struct {
 	Description   string       `json:"description"`
 }
 
+// HasArea checks whether the activity took place in the area.
+// Task and photo activities don't keep the area UID so they never match.
+func (a CropActivity) HasArea(areaUID uuid.UUID) bool {
+	switch v := a.ActivityType.(type) {
+	case SeedActivity:
+		return v.AreaUID == areaUID
+	case MoveActivity:
+		return v.SrcAreaUID == areaUID || v.DstAreaUID == areaUID
+	case HarvestActivity:
+		return v.SrcAreaUID == areaUID
+	case DumpActivity:
+		return v.SrcAreaUID == areaUID
+	case WaterActivity:
+		return v.AreaUID == areaUID
+	case HarvestCorrectionActivity:
+		return v.SrcAreaUID == areaUID
+	case DumpRevertActivity:
+		return v.SrcAreaUID == areaUID
+	case MoveRevertActivity:
+		return v.SrcAreaUID == areaUID || v.DstAreaUID == areaUID
+	case UnarchiveActivity:
+		return v.AreaUID == areaUID
+	}
+
+	return false
+}
+
 type ActivityType interface {
 	Code() string
 }
 
+// ActivityTypeCodes returns the codes of every crop activity type
+func ActivityTypeCodes() []string {
+	return []string{
+		SeedActivityCode,
+		MoveActivityCode,
+		HarvestActivityCode,
+		DumpActivityCode,
+		PhotoActivityCode,
+		WaterActivityCode,
+		TaskCropActivityCode,
+		TaskNutrientActivityCode,
+		TaskPestControlActivityCode,
+		TaskSafetyActivityCode,
+		TaskSanitationActivityCode,
+		HarvestCorrectionActivityCode,
+		DumpRevertActivityCode,
+		MoveRevertActivityCode,
+		UnarchiveActivityCode,
+	}
+}
+
 type SeedActivity struct {
 	AreaUID     uuid.UUID `json:"area_id"`
 	AreaName    string    `json:"area_name"`
@@ -175,8 +228,12 @@ type HarvestActivity struct {
 	SrcAreaUID           uuid.UUID `json:"source_area_id"`
 	SrcAreaName          string    `json:"source_area_name"`
 	Quantity             int       `json:"quantity"`
+	ProducedQuantity     float32   `json:"produced_quantity"`
+	ProducedUnit         string    `json:"produced_unit"`
 	ProducedGramQuantity float32   `json:"produced_gram_quantity"`
 	HarvestDate          time.Time `json:"harvest_date"`
+	LotNumber            string    `json:"lot_number"`
+	Grade                string    `json:"grade"`
 }
 
 func (a HarvestActivity) Code() string {
@@ -187,6 +244,7 @@ type DumpActivity struct {
 	SrcAreaUID  uuid.UUID `json:"source_area_id"`
 	SrcAreaName string    `json:"source_area_name"`
 	Quantity    int       `json:"quantity"`
+	Reason      string    `json:"reason"`
 	DumpDate    time.Time `json:"dump_date"`
 }
 
@@ -272,3 +330,176 @@ type TaskSanitationActivity struct {
 func (a TaskSanitationActivity) Code() string {
 	return TaskSanitationActivityCode
 }
+
+type HarvestCorrectionActivity struct {
+	SrcAreaUID                   uuid.UUID `json:"source_area_id"`
+	SrcAreaName                  string    `json:"source_area_name"`
+	PreviousQuantity             int       `json:"previous_quantity"`
+	Quantity                     int       `json:"quantity"`
+	PreviousProducedGramQuantity float32   `json:"previous_produced_gram_quantity"`
+	ProducedGramQuantity         float32   `json:"produced_gram_quantity"`
+	CorrectedBy                  uuid.UUID `json:"corrected_by"`
+	CorrectionDate               time.Time `json:"correction_date"`
+}
+
+func (a HarvestCorrectionActivity) Code() string {
+	return HarvestCorrectionActivityCode
+}
+
+type DumpRevertActivity struct {
+	SrcAreaUID  uuid.UUID `json:"source_area_id"`
+	SrcAreaName string    `json:"source_area_name"`
+	Quantity    int       `json:"quantity"`
+	RevertedBy  uuid.UUID `json:"reverted_by"`
+	RevertDate  time.Time `json:"revert_date"`
+}
+
+func (a DumpRevertActivity) Code() string {
+	return DumpRevertActivityCode
+}
+
+type MoveRevertActivity struct {
+	SrcAreaUID  uuid.UUID `json:"source_area_id"`
+	SrcAreaName string    `json:"source_area_name"`
+	DstAreaUID  uuid.UUID `json:"destination_area_id"`
+	DstAreaName string    `json:"destination_area_name"`
+	Quantity    int       `json:"quantity"`
+	RevertedBy  uuid.UUID `json:"reverted_by"`
+	RevertDate  time.Time `json:"revert_date"`
+}
+
+func (a MoveRevertActivity) Code() string {
+	return MoveRevertActivityCode
+}
+
+type UnarchiveActivity struct {
+	AreaUID        uuid.UUID `json:"area_id"`
+	AreaName       string    `json:"area_name"`
+	Quantity       int       `json:"quantity"`
+	UnarchivedBy   uuid.UUID `json:"unarchived_by"`
+	UnarchivedDate time.Time `json:"unarchived_date"`
+}
+
+func (a UnarchiveActivity) Code() string {
+	return UnarchiveActivityCode
+}
+
+// PlantingHistory is a planting of a crop batch in an area,
+// either when the crop batch is created or moved to the area
+type PlantingHistory struct {
+	AreaUID      uuid.UUID `json:"area_id"`
+	CropUID      uuid.UUID `json:"crop_id"`
+	BatchID      string    `json:"batch_id"`
+	InventoryUID uuid.UUID `json:"inventory_id"`
+	VarietyName  string    `json:"variety_name"`
+	PlantFamily  string    `json:"plant_family"`
+	PlantedDate  time.Time `json:"planted_date"`
+}
+
+// HarvestLot is a single harvest of a crop batch, identified by its lot number
+type HarvestLot struct {
+	UID                  uuid.UUID `json:"uid"`
+	LotNumber            string    `json:"lot_number"`
+	CropUID              uuid.UUID `json:"crop_id"`
+	BatchID              string    `json:"batch_id"`
+	FarmUID              uuid.UUID `json:"farm_id"`
+	SourceAreaUID        uuid.UUID `json:"source_area_id"`
+	SourceAreaName       string    `json:"source_area_name"`
+	Grade                string    `json:"grade"`
+	ProducedQuantity     float32   `json:"produced_quantity"`
+	ProducedUnit         string    `json:"produced_unit"`
+	ProducedGramQuantity float32   `json:"produced_gram_quantity"`
+	HarvestDate          time.Time `json:"harvest_date"`
+}
+
+type CropTemplateEvent struct {
+	CropTemplateUID uuid.UUID
+	Version         int
+	CreatedDate     time.Time
+	Event           interface{}
+}
+
+type CropTemplateRead struct {
+	UID         uuid.UUID                 `json:"uid"`
+	Name        string                    `json:"name"`
+	Inventory   CropTemplateInventory     `json:"inventory"`
+	Tasks       []domain.CropTemplateTask `json:"tasks"`
+	CreatedDate time.Time                 `json:"created_date"`
+}
+
+type CropTemplateInventory struct {
+	UID  uuid.UUID `json:"uid"`
+	Name string    `json:"name"`
+}
+
+type HarvestUnitEvent struct {
+	HarvestUnitUID uuid.UUID
+	Version        int
+	CreatedDate    time.Time
+	Event          interface{}
+}
+
+type HarvestUnitRead struct {
+	UID               uuid.UUID            `json:"uid"`
+	Inventory         HarvestUnitInventory `json:"inventory"`
+	Code              string               `json:"code"`
+	Label             string               `json:"label"`
+	AverageGramWeight float32              `json:"average_gram_weight"`
+	CreatedDate       time.Time            `json:"created_date"`
+}
+
+type HarvestUnitInventory struct {
+	UID  uuid.UUID `json:"uid"`
+	Name string    `json:"name"`
+}
+
+type SuccessionPlanEvent struct {
+	SuccessionPlanUID uuid.UUID
+	Version           int
+	CreatedDate       time.Time
+	Event             interface{}
+}
+
+type SuccessionPlanRead struct {
+	UID            uuid.UUID                `json:"uid"`
+	Name           string                   `json:"name"`
+	Inventory      SuccessionPlanInventory  `json:"inventory"`
+	Areas          []SuccessionPlanArea     `json:"areas"`
+	CropType       string                   `json:"crop_type"`
+	ContainerType  string                   `json:"container_type"`
+	ContainerCell  int                      `json:"container_cell"`
+	Quantity       int                      `json:"quantity"`
+	IntervalDays   int                      `json:"interval_days"`
+	StartDate      time.Time                `json:"start_date"`
+	EndDate        time.Time                `json:"end_date"`
+	PlannedBatches []SuccessionPlannedBatch `json:"planned_batches"`
+	CreatedDate    time.Time                `json:"created_date"`
+}
+
+type SuccessionPlanInventory struct {
+	UID  uuid.UUID `json:"uid"`
+	Name string    `json:"name"`
+}
+
+type SuccessionPlanArea struct {
+	UID  uuid.UUID `json:"uid"`
+	Name string    `json:"name"`
+}
+
+// SuccessionPlannedBatch is a planned crop batch with the crop batch it was confirmed into
+type SuccessionPlannedBatch struct {
+	UID         uuid.UUID           `json:"uid"`
+	Area        SuccessionPlanArea  `json:"area"`
+	SeedingDate time.Time           `json:"seeding_date"`
+	Quantity    int                 `json:"quantity"`
+	Status      string              `json:"status"`
+	Crop        *SuccessionPlanCrop `json:"crop"`
+}
+
+type SuccessionPlanCrop struct {
+	UID         uuid.UUID          `json:"uid"`
+	BatchID     string             `json:"batch_id"`
+	Area        SuccessionPlanArea `json:"area"`
+	Quantity    int                `json:"quantity"`
+	SeedingDate time.Time          `json:"seeding_date"`
+}
@@ -0,0 +1,11 @@
+package datetimehelper
+
+import "time"
+
+// DayRange returns the start of the date's day and the start of the next day,
+// in the date's location
+func DayRange(date time.Time) (time.Time, time.Time) {
+	start := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
+
+	return start, start.AddDate(0, 0, 1)
+}
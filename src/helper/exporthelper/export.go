@@ -0,0 +1,276 @@
+package exporthelper
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/csv"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	FormatCSV  = "csv"
+	FormatXLSX = "xlsx"
+)
+
+// DateTimeLayout is how the time values are written in the exported files
+const DateTimeLayout = "2006-01-02 15:04:05"
+
+// ErrUnknownColumn is returned when a selected column is not in the table
+var ErrUnknownColumn = errors.New("Unknown export column")
+
+// Table is a list of rows to export. A cell value can be a string, a number,
+// a time.Time or a *time.Time. Nil values are written as empty cells.
+type Table struct {
+	Columns []string
+	Rows    [][]interface{}
+}
+
+// ContentType returns the HTTP content type of an export format
+func ContentType(format string) string {
+	switch format {
+	case FormatXLSX:
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	default:
+		return "text/csv; charset=utf-8"
+	}
+}
+
+// IsFormat checks whether the format is supported
+func IsFormat(format string) bool {
+	return format == FormatCSV || format == FormatXLSX
+}
+
+// SelectColumns returns a table with only the columns, in the given order.
+// All the columns are kept when columns is empty.
+func (t Table) SelectColumns(columns []string) (Table, error) {
+	if len(columns) == 0 {
+		return t, nil
+	}
+
+	indexes := []int{}
+	for _, c := range columns {
+		found := false
+		for i, v := range t.Columns {
+			if v == c {
+				indexes = append(indexes, i)
+				found = true
+			}
+		}
+
+		if !found {
+			return Table{}, ErrUnknownColumn
+		}
+	}
+
+	selected := Table{Columns: columns}
+	for _, row := range t.Rows {
+		r := []interface{}{}
+		for _, i := range indexes {
+			r = append(r, row[i])
+		}
+
+		selected.Rows = append(selected.Rows, r)
+	}
+
+	return selected, nil
+}
+
+// Write writes the table to w in the format
+func (t Table) Write(w io.Writer, format, sheetName string) error {
+	switch format {
+	case FormatCSV:
+		return t.WriteCSV(w)
+	case FormatXLSX:
+		return t.WriteXLSX(w, sheetName)
+	}
+
+	return fmt.Errorf("Unsupported export format %s", format)
+}
+
+// WriteCSV writes the table as CSV with the column names as the first line
+func (t Table) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+
+	err := cw.Write(t.Columns)
+	if err != nil {
+		return err
+	}
+
+	for _, row := range t.Rows {
+		record := []string{}
+		for _, v := range row {
+			record = append(record, formatValue(v))
+		}
+
+		err = cw.Write(record)
+		if err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+
+	return cw.Error()
+}
+
+// WriteXLSX writes the table as a workbook with a single sheet.
+// Only the parts required by the spreadsheet applications are written
+// and the strings are inlined, so there is no shared strings part.
+func (t Table) WriteXLSX(w io.Writer, sheetName string) error {
+	// Spreadsheet applications refuse sheet names longer than 31 characters
+	if len(sheetName) > 31 {
+		sheetName = sheetName[:31]
+	}
+
+	zw := zip.NewWriter(w)
+
+	parts := []struct {
+		name    string
+		content string
+	}{
+		{"[Content_Types].xml", xlsxContentTypes},
+		{"_rels/.rels", xlsxRels},
+		{"xl/workbook.xml", fmt.Sprintf(xlsxWorkbook, escapeXML(sheetName))},
+		{"xl/_rels/workbook.xml.rels", xlsxWorkbookRels},
+	}
+
+	for _, p := range parts {
+		f, err := zw.Create(p.name)
+		if err != nil {
+			return err
+		}
+
+		_, err = io.WriteString(f, p.content)
+		if err != nil {
+			return err
+		}
+	}
+
+	f, err := zw.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return err
+	}
+
+	err = t.writeSheet(f)
+	if err != nil {
+		return err
+	}
+
+	return zw.Close()
+}
+
+func (t Table) writeSheet(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+
+	bw.WriteString(xml.Header)
+	bw.WriteString(`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`)
+
+	header := []interface{}{}
+	for _, v := range t.Columns {
+		header = append(header, v)
+	}
+
+	writeSheetRow(bw, 1, header)
+	for i, row := range t.Rows {
+		writeSheetRow(bw, i+2, row)
+	}
+
+	bw.WriteString(`</sheetData></worksheet>`)
+
+	return bw.Flush()
+}
+
+func writeSheetRow(w *bufio.Writer, rowNumber int, row []interface{}) {
+	fmt.Fprintf(w, `<row r="%d">`, rowNumber)
+
+	for i, v := range row {
+		ref := columnName(i) + strconv.Itoa(rowNumber)
+
+		switch v.(type) {
+		case int, int64, float32, float64:
+			fmt.Fprintf(w, `<c r="%s"><v>%s</v></c>`, ref, formatValue(v))
+		default:
+			fmt.Fprintf(w, `<c r="%s" t="inlineStr"><is><t>%s</t></is></c>`, ref, escapeXML(formatValue(v)))
+		}
+	}
+
+	w.WriteString(`</row>`)
+}
+
+// columnName converts a zero based column index to its spreadsheet name, like A, Z or AA
+func columnName(index int) string {
+	name := ""
+	for index >= 0 {
+		name = string(rune('A'+index%26)) + name
+		index = index/26 - 1
+	}
+
+	return name
+}
+
+func formatValue(v interface{}) string {
+	switch val := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return val
+	case int:
+		return strconv.Itoa(val)
+	case int64:
+		return strconv.FormatInt(val, 10)
+	case float32:
+		return strconv.FormatFloat(float64(val), 'f', -1, 32)
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64)
+	case time.Time:
+		if val.IsZero() {
+			return ""
+		}
+
+		return val.Format(DateTimeLayout)
+	case *time.Time:
+		if val == nil || val.IsZero() {
+			return ""
+		}
+
+		return val.Format(DateTimeLayout)
+	}
+
+	return fmt.Sprintf("%v", v)
+}
+
+func escapeXML(s string) string {
+	b := strings.Builder{}
+	xml.EscapeText(&b, []byte(s))
+
+	return b.String()
+}
+
+const xlsxContentTypes = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+	`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+	`<Default Extension="xml" ContentType="application/xml"/>` +
+	`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+	`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+	`</Types>`
+
+const xlsxRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+	`</Relationships>`
+
+const xlsxWorkbook = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+	`<sheets><sheet name="%s" sheetId="1" r:id="rId1"/></sheets>` +
+	`</workbook>`
+
+const xlsxWorkbookRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+	`</Relationships>`
@@ -0,0 +1,95 @@
+package exporthelper
+
+import (
+	"archive/zip"
+	"bytes"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelectColumns(t *testing.T) {
+	// Given
+	table := Table{
+		Columns: []string{"batch_id", "variety", "quantity"},
+		Rows: [][]interface{}{
+			{"tom-sup-one-18oct", "Tomato Super One", 20},
+		},
+	}
+
+	// When
+	selected, err := table.SelectColumns([]string{"quantity", "batch_id"})
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"quantity", "batch_id"}, selected.Columns)
+	assert.Equal(t, []interface{}{20, "tom-sup-one-18oct"}, selected.Rows[0])
+
+	// When
+	_, err = table.SelectColumns([]string{"unknown"})
+
+	// Then
+	assert.Equal(t, ErrUnknownColumn, err)
+}
+
+func TestWriteCSV(t *testing.T) {
+	// Given
+	date := time.Date(2018, time.October, 18, 9, 30, 0, 0, time.UTC)
+	table := Table{
+		Columns: []string{"batch_id", "quantity", "grams", "date", "watered"},
+		Rows: [][]interface{}{
+			{"tom,sup", 20, float32(1.5), date, (*time.Time)(nil)},
+		},
+	}
+
+	// When
+	buf := bytes.Buffer{}
+	err := table.Write(&buf, FormatCSV, "Crops")
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, "batch_id,quantity,grams,date,watered\n\"tom,sup\",20,1.5,2018-10-18 09:30:00,\n", buf.String())
+}
+
+func TestWriteXLSX(t *testing.T) {
+	// Given
+	table := Table{
+		Columns: []string{"batch_id", "quantity"},
+		Rows: [][]interface{}{
+			{"tom & sup", 20},
+		},
+	}
+
+	// When
+	buf := bytes.Buffer{}
+	err := table.Write(&buf, FormatXLSX, "Crops")
+
+	// Then
+	assert.Nil(t, err)
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	assert.Nil(t, err)
+
+	sheet := ""
+	for _, f := range zr.File {
+		if f.Name == "xl/worksheets/sheet1.xml" {
+			rc, _ := f.Open()
+			b, _ := ioutil.ReadAll(rc)
+			rc.Close()
+
+			sheet = string(b)
+		}
+	}
+
+	assert.Contains(t, sheet, `<c r="A2" t="inlineStr"><is><t>tom &amp; sup</t></is></c>`)
+	assert.Contains(t, sheet, `<c r="B2"><v>20</v></c>`)
+}
+
+func TestColumnName(t *testing.T) {
+	assert.Equal(t, "A", columnName(0))
+	assert.Equal(t, "Z", columnName(25))
+	assert.Equal(t, "AA", columnName(26))
+	assert.Equal(t, "AB", columnName(27))
+}
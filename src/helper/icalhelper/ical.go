@@ -0,0 +1,158 @@
+package icalhelper
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// ContentType is the HTTP content type of the calendar files
+const ContentType = "text/calendar; charset=utf-8"
+
+// The VEVENT statuses
+const (
+	StatusConfirmed = "CONFIRMED"
+	StatusCancelled = "CANCELLED"
+)
+
+// maxLineLength is the length in octets after which the lines are folded, as required by RFC 5545
+const maxLineLength = 75
+
+const (
+	dateLayout     = "20060102"
+	dateTimeLayout = "20060102T150405Z"
+)
+
+// Calendar is an iCalendar (RFC 5545) file with its events
+type Calendar struct {
+	Name string
+	// Stamp is when the calendar is generated, written as the DTSTAMP of the events
+	Stamp  time.Time
+	Events []Event
+}
+
+// Event is an all day VEVENT on the day of Date, in the time zone of Date
+type Event struct {
+	UID         string
+	Date        time.Time
+	Summary     string
+	Description string
+	Location    string
+	Categories  []string
+	// Priority goes from 1, the highest, to 9. Zero leaves it undefined.
+	Priority int
+	Status   string
+}
+
+// Write writes the calendar, with CRLF line endings and the long lines folded
+func (c Calendar) Write(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+
+	lines := []string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//Tanibox//Tania//EN",
+		"CALSCALE:GREGORIAN",
+		"METHOD:PUBLISH",
+	}
+
+	if c.Name != "" {
+		lines = append(lines, "X-WR-CALNAME:"+EscapeText(c.Name))
+	}
+
+	for _, e := range c.Events {
+		lines = append(lines, e.lines(c.Stamp)...)
+	}
+
+	lines = append(lines, "END:VCALENDAR")
+
+	for _, v := range lines {
+		_, err := bw.WriteString(FoldLine(v))
+		if err != nil {
+			return err
+		}
+	}
+
+	return bw.Flush()
+}
+
+func (e Event) lines(stamp time.Time) []string {
+	day := time.Date(e.Date.Year(), e.Date.Month(), e.Date.Day(), 0, 0, 0, 0, time.UTC)
+
+	lines := []string{
+		"BEGIN:VEVENT",
+		"UID:" + EscapeText(e.UID),
+		"DTSTAMP:" + stamp.UTC().Format(dateTimeLayout),
+		"DTSTART;VALUE=DATE:" + day.Format(dateLayout),
+		"DTEND;VALUE=DATE:" + day.AddDate(0, 0, 1).Format(dateLayout),
+		"SUMMARY:" + EscapeText(e.Summary),
+	}
+
+	if e.Description != "" {
+		lines = append(lines, "DESCRIPTION:"+EscapeText(e.Description))
+	}
+
+	if e.Location != "" {
+		lines = append(lines, "LOCATION:"+EscapeText(e.Location))
+	}
+
+	if len(e.Categories) > 0 {
+		categories := []string{}
+		for _, v := range e.Categories {
+			categories = append(categories, EscapeText(v))
+		}
+
+		lines = append(lines, "CATEGORIES:"+strings.Join(categories, ","))
+	}
+
+	if e.Priority > 0 {
+		lines = append(lines, "PRIORITY:"+strconv.Itoa(e.Priority))
+	}
+
+	if e.Status != "" {
+		lines = append(lines, "STATUS:"+e.Status)
+	}
+
+	return append(lines, "END:VEVENT")
+}
+
+// EscapeText escapes a TEXT value
+func EscapeText(s string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+		"\r", "",
+	).Replace(s)
+}
+
+// FoldLine splits a content line in lines of at most 75 octets, without breaking the UTF-8 characters.
+// The continuation lines start with a space. The returned line ends with CRLF.
+func FoldLine(line string) string {
+	var b strings.Builder
+
+	limit := maxLineLength
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+
+		// The leading space of the continuation line counts in its length
+		limit = maxLineLength - 1
+	}
+
+	b.WriteString(line)
+	b.WriteString("\r\n")
+
+	return b.String()
+}
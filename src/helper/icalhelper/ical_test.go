@@ -0,0 +1,79 @@
+package icalhelper
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEscapeText(t *testing.T) {
+	assert.Equal(t, `Water\, prune\; then \\ check\nthe drip lines`, EscapeText("Water, prune; then \\ check\r\nthe drip lines"))
+}
+
+func TestFoldLine(t *testing.T) {
+	// Given
+	line := "DESCRIPTION:" + strings.Repeat("é", 50)
+
+	// When
+	folded := FoldLine(line)
+
+	// Then
+	parts := strings.Split(strings.TrimSuffix(folded, "\r\n"), "\r\n")
+	assert.Len(t, parts, 2)
+	assert.True(t, len(parts[0]) <= 75)
+	assert.True(t, len(parts[1]) <= 75)
+	assert.True(t, strings.HasPrefix(parts[1], " "))
+	assert.Equal(t, line, parts[0]+strings.TrimPrefix(parts[1], " "))
+
+	assert.Equal(t, "SUMMARY:Harvest\r\n", FoldLine("SUMMARY:Harvest"))
+}
+
+func TestWriteCalendar(t *testing.T) {
+	// Given
+	location := time.FixedZone("WIB", 7*60*60)
+	calendar := Calendar{
+		Name:  "Tania tasks",
+		Stamp: time.Date(2018, time.October, 18, 9, 30, 0, 0, time.UTC),
+		Events: []Event{
+			{
+				UID:         "d2a1c1d4@tania",
+				Date:        time.Date(2018, time.October, 20, 6, 0, 0, 0, location),
+				Summary:     "Water the seedlings",
+				Description: "Area: Nursery",
+				Location:    "Nursery",
+				Categories:  []string{"NUTRIENT"},
+				Priority:    1,
+				Status:      StatusConfirmed,
+			},
+		},
+	}
+
+	// When
+	buf := bytes.Buffer{}
+	err := calendar.Write(&buf)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, "BEGIN:VCALENDAR\r\n"+
+		"VERSION:2.0\r\n"+
+		"PRODID:-//Tanibox//Tania//EN\r\n"+
+		"CALSCALE:GREGORIAN\r\n"+
+		"METHOD:PUBLISH\r\n"+
+		"X-WR-CALNAME:Tania tasks\r\n"+
+		"BEGIN:VEVENT\r\n"+
+		"UID:d2a1c1d4@tania\r\n"+
+		"DTSTAMP:20181018T093000Z\r\n"+
+		"DTSTART;VALUE=DATE:20181020\r\n"+
+		"DTEND;VALUE=DATE:20181021\r\n"+
+		"SUMMARY:Water the seedlings\r\n"+
+		"DESCRIPTION:Area: Nursery\r\n"+
+		"LOCATION:Nursery\r\n"+
+		"CATEGORIES:NUTRIENT\r\n"+
+		"PRIORITY:1\r\n"+
+		"STATUS:CONFIRMED\r\n"+
+		"END:VEVENT\r\n"+
+		"END:VCALENDAR\r\n", buf.String())
+}
@@ -2,11 +2,22 @@ package imagehelper
 
 import (
 	"image"
-	_ "image/jpeg"
-	_ "image/png"
+	"image/color"
+	"image/jpeg"
+	"image/png"
 	"os"
+	"path/filepath"
+	"strings"
 )
 
+// ThumbnailSizes maps the size name used in the API to the maximum
+// width or height, in pixels, of the generated thumbnail.
+var ThumbnailSizes = map[string]int{
+	"small":  150,
+	"medium": 400,
+	"large":  800,
+}
+
 func GetImageDimension(srcPath string) (width int, height int, err error) {
 	src, err := os.Open(srcPath)
 	if err != nil {
@@ -21,3 +32,135 @@ func GetImageDimension(srcPath string) (width int, height int, err error) {
 
 	return image.Width, image.Height, nil
 }
+
+// IsValidThumbnailSize checks whether the size name is one of ThumbnailSizes.
+func IsValidThumbnailSize(size string) bool {
+	_, ok := ThumbnailSizes[size]
+
+	return ok
+}
+
+// GetThumbnailPath returns the path of the thumbnail for the given size.
+// For example, uploads/crops/tomato.jpg becomes uploads/crops/tomato_small.jpg.
+func GetThumbnailPath(srcPath, size string) string {
+	ext := filepath.Ext(srcPath)
+
+	return strings.TrimSuffix(srcPath, ext) + "_" + size + ext
+}
+
+// CreateThumbnails generates a thumbnail of srcPath for every size in ThumbnailSizes.
+func CreateThumbnails(srcPath string) error {
+	for size := range ThumbnailSizes {
+		err := CreateThumbnail(srcPath, size)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// CreateThumbnail scales srcPath down so it fits inside the given size
+// and writes the result next to the original image.
+// Images that are already smaller than the size are written unscaled.
+func CreateThumbnail(srcPath, size string) error {
+	src, err := os.Open(srcPath)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	img, format, err := image.Decode(src)
+	if err != nil {
+		return err
+	}
+
+	bounds := img.Bounds()
+	width, height := fitDimension(bounds.Dx(), bounds.Dy(), ThumbnailSizes[size])
+
+	dst, err := os.Create(GetThumbnailPath(srcPath, size))
+	if err != nil {
+		return err
+	}
+	defer dst.Close()
+
+	thumbnail := scale(img, width, height)
+
+	if format == "png" {
+		return png.Encode(dst, thumbnail)
+	}
+
+	return jpeg.Encode(dst, thumbnail, &jpeg.Options{Quality: 85})
+}
+
+// GetThumbnail returns the path of the thumbnail of srcPath for the given size.
+// The thumbnail is generated first if it doesn't exist yet,
+// for example for images uploaded before thumbnails were introduced.
+func GetThumbnail(srcPath, size string) (string, error) {
+	destPath := GetThumbnailPath(srcPath, size)
+
+	if _, err := os.Stat(destPath); os.IsNotExist(err) {
+		err = CreateThumbnail(srcPath, size)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return destPath, nil
+}
+
+func fitDimension(width, height, max int) (int, int) {
+	if width <= max && height <= max {
+		return width, height
+	}
+
+	if width >= height {
+		return max, maxInt(1, height*max/width)
+	}
+
+	return maxInt(1, width*max/height), max
+}
+
+// scale resizes img by averaging the source pixels covered by each destination pixel.
+func scale(img image.Image, width, height int) image.Image {
+	bounds := img.Bounds()
+	srcWidth, srcHeight := bounds.Dx(), bounds.Dy()
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+
+	for y := 0; y < height; y++ {
+		y0 := bounds.Min.Y + y*srcHeight/height
+		y1 := maxInt(y0+1, bounds.Min.Y+(y+1)*srcHeight/height)
+
+		for x := 0; x < width; x++ {
+			x0 := bounds.Min.X + x*srcWidth/width
+			x1 := maxInt(x0+1, bounds.Min.X+(x+1)*srcWidth/width)
+
+			var r, g, b, a, n uint32
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					pr, pg, pb, pa := img.At(sx, sy).RGBA()
+					r, g, b, a = r+pr, g+pg, b+pb, a+pa
+					n++
+				}
+			}
+
+			dst.Set(x, y, color.RGBA64{
+				R: uint16(r / n),
+				G: uint16(g / n),
+				B: uint16(b / n),
+				A: uint16(a / n),
+			})
+		}
+	}
+
+	return dst
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+
+	return b
+}
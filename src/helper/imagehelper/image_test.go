@@ -0,0 +1,30 @@
+package imagehelper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetThumbnailPath(t *testing.T) {
+	// When
+	path := GetThumbnailPath("uploads/crops/tomato.jpg", "small")
+
+	// Then
+	assert.Equal(t, "uploads/crops/tomato_small.jpg", path)
+}
+
+func TestFitDimension(t *testing.T) {
+	// When
+	width1, height1 := fitDimension(1600, 1200, 400)
+	width2, height2 := fitDimension(600, 1200, 400)
+	width3, height3 := fitDimension(100, 50, 400)
+
+	// Then
+	assert.Equal(t, 400, width1)
+	assert.Equal(t, 300, height1)
+	assert.Equal(t, 200, width2)
+	assert.Equal(t, 400, height2)
+	assert.Equal(t, 100, width3)
+	assert.Equal(t, 50, height3)
+}
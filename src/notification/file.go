@@ -0,0 +1,46 @@
+package notification
+
+import (
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+)
+
+// FileChannel appends the messages to a local file, one JSON object per line.
+// It is meant to try the notifications without a mail server or a webhook.
+type FileChannel struct {
+	Path string
+
+	mutex sync.Mutex
+}
+
+func NewFileChannel(path string) *FileChannel {
+	return &FileChannel{Path: path}
+}
+
+func (f *FileChannel) Send(message Message) error {
+	line, err := json.Marshal(struct {
+		Message
+		SentDate time.Time `json:"sent_date"`
+	}{message, time.Now()})
+	if err != nil {
+		return err
+	}
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	file, err := os.OpenFile(f.Path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+
+	_, err = file.Write(append(line, '\n'))
+	if err != nil {
+		file.Close()
+		return err
+	}
+
+	return file.Close()
+}
@@ -0,0 +1,117 @@
+package notification
+
+import (
+	"errors"
+	"sort"
+	"sync"
+)
+
+// The channels of the notifications
+const (
+	ChannelEmail   = "EMAIL"
+	ChannelWebhook = "WEBHOOK"
+	ChannelFile    = "FILE"
+)
+
+var (
+	// ErrChannelNotFound is returned when sending through a channel which is not registered
+	ErrChannelNotFound = errors.New("Notification channel not found")
+
+	// ErrInvalidRecipient is returned when the recipient can't be read by the channel
+	ErrInvalidRecipient = errors.New("Notification recipient is invalid")
+
+	// ErrRecipientNotAllowed is returned when the channel refuses to send to the recipient
+	ErrRecipientNotAllowed = errors.New("Notification recipient is not allowed")
+)
+
+// Message is a notification sent to a recipient.
+// The recipient depends on the channel, such as an email address or a webhook url.
+type Message struct {
+	Recipient string      `json:"recipient"`
+	Subject   string      `json:"subject"`
+	Body      string      `json:"body"`
+	Data      interface{} `json:"data"`
+}
+
+// Channel sends the messages to their recipients
+type Channel interface {
+	Send(message Message) error
+}
+
+// RecipientValidator is implemented by the channels checking a recipient before it is saved
+type RecipientValidator interface {
+	ValidateRecipient(recipient string) error
+}
+
+// Notifier sends the messages through the registered channels
+type Notifier struct {
+	mutex    sync.RWMutex
+	channels map[string]Channel
+}
+
+func NewNotifier() *Notifier {
+	return &Notifier{channels: make(map[string]Channel)}
+}
+
+// Register adds the channel under the name, replacing the channel already registered with it
+func (n *Notifier) Register(name string, channel Channel) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	n.channels[name] = channel
+}
+
+// HasChannel tells if a channel is registered with the name
+func (n *Notifier) HasChannel(name string) bool {
+	n.mutex.RLock()
+	defer n.mutex.RUnlock()
+
+	_, ok := n.channels[name]
+
+	return ok
+}
+
+// Channels returns the names of the registered channels, sorted
+func (n *Notifier) Channels() []string {
+	n.mutex.RLock()
+	defer n.mutex.RUnlock()
+
+	names := []string{}
+	for name := range n.channels {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// ValidateRecipient checks the recipient with the channel, when the channel can validate it
+func (n *Notifier) ValidateRecipient(name, recipient string) error {
+	n.mutex.RLock()
+	channel, ok := n.channels[name]
+	n.mutex.RUnlock()
+
+	if !ok {
+		return ErrChannelNotFound
+	}
+
+	validator, ok := channel.(RecipientValidator)
+	if !ok {
+		return nil
+	}
+
+	return validator.ValidateRecipient(recipient)
+}
+
+func (n *Notifier) Send(name string, message Message) error {
+	n.mutex.RLock()
+	channel, ok := n.channels[name]
+	n.mutex.RUnlock()
+
+	if !ok {
+		return ErrChannelNotFound
+	}
+
+	return channel.Send(message)
+}
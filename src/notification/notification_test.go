@@ -0,0 +1,176 @@
+package notification
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotifier(t *testing.T) {
+	// Given
+	dir, _ := ioutil.TempDir("", "notification")
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "notifications.log")
+
+	notifier := NewNotifier()
+	notifier.Register(ChannelFile, NewFileChannel(path))
+
+	// When
+	err := notifier.Send(ChannelEmail, Message{Recipient: "farmer@example.com"})
+
+	// Then
+	assert.Equal(t, ErrChannelNotFound, err)
+	assert.False(t, notifier.HasChannel(ChannelEmail))
+	assert.Equal(t, []string{ChannelFile}, notifier.Channels())
+
+	// When
+	err = notifier.Send(ChannelFile, Message{Subject: "First"})
+	assert.Nil(t, err)
+
+	err = notifier.Send(ChannelFile, Message{Subject: "Second"})
+	assert.Nil(t, err)
+
+	// Then
+	content, _ := ioutil.ReadFile(path)
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	assert.Len(t, lines, 2)
+
+	message := Message{}
+	err = json.Unmarshal([]byte(lines[1]), &message)
+	assert.Nil(t, err)
+	assert.Equal(t, "Second", message.Subject)
+}
+
+func TestWebhookChannel(t *testing.T) {
+	// Given
+	received := Message{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&received)
+
+		if received.Subject == "Rejected" {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	webhook := NewWebhookChannel(true)
+
+	// When
+	err := webhook.Send(Message{Recipient: server.URL, Subject: "Water the seedlings"})
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, "Water the seedlings", received.Subject)
+
+	// When
+	err = webhook.Send(Message{Recipient: server.URL, Subject: "Rejected"})
+
+	// Then
+	assert.NotNil(t, err)
+
+	// When
+	err = webhook.Send(Message{Subject: "No url"})
+
+	// Then
+	assert.NotNil(t, err)
+}
+
+func TestWebhookChannelPrivateHosts(t *testing.T) {
+	// Given
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("webhook to a private host must not be posted")
+	}))
+	defer server.Close()
+
+	webhook := NewWebhookChannel(false)
+
+	// When
+	err := webhook.Send(Message{Recipient: server.URL, Subject: "Water the seedlings"})
+
+	// Then
+	assert.Equal(t, ErrRecipientNotAllowed, err)
+
+	// When
+	err = webhook.controlAddress("tcp", "127.0.0.1:80", nil)
+
+	// Then
+	assert.Equal(t, ErrRecipientNotAllowed, err)
+
+	recipients := map[string]error{
+		"http://127.0.0.1:8080/hook":              ErrRecipientNotAllowed,
+		"http://10.1.2.3/hook":                    ErrRecipientNotAllowed,
+		"http://169.254.169.254/latest/meta-data": ErrRecipientNotAllowed,
+		"http://[::1]/hook":                       ErrRecipientNotAllowed,
+		"http://93.184.216.34/hook":               nil,
+		"ftp://93.184.216.34/hook":                ErrInvalidRecipient,
+		"not a url":                               ErrInvalidRecipient,
+	}
+
+	for recipient, expected := range recipients {
+		// When
+		err := webhook.ValidateRecipient(recipient)
+
+		// Then
+		assert.Equal(t, expected, err, recipient)
+	}
+}
+
+func TestIsPublicIP(t *testing.T) {
+	ips := map[string]bool{
+		"8.8.8.8":          true,
+		"2001:4860::8888":  true,
+		"127.0.0.1":        false,
+		"10.0.0.1":         false,
+		"172.16.5.4":       false,
+		"192.168.1.1":      false,
+		"169.254.169.254":  false,
+		"0.0.0.0":          false,
+		"::1":              false,
+		"fe80::1":          false,
+		"fd00::1":          false,
+		"::ffff:127.0.0.1": false,
+		"64:ff9b::a00:1":   false,
+	}
+
+	for ip, expected := range ips {
+		assert.Equal(t, expected, IsPublicIP(net.ParseIP(ip)), ip)
+	}
+}
+
+func TestSMTPChannelRecipient(t *testing.T) {
+	// Given
+	channel := NewSMTPChannel("localhost", "25", "", "", "tania@example.com")
+
+	// Then
+	assert.Nil(t, channel.ValidateRecipient("farmer@example.com"))
+	assert.Equal(t, ErrInvalidRecipient, channel.ValidateRecipient("Farmer <farmer@example.com>"))
+	assert.Equal(t, ErrInvalidRecipient, channel.ValidateRecipient("farmer"))
+}
+
+func TestSMTPChannelMail(t *testing.T) {
+	// Given
+	channel := NewSMTPChannel("localhost", "25", "", "", "tania@example.com")
+
+	// When
+	mail := string(channel.buildMail(Message{
+		Recipient: "farmer@example.com",
+		Subject:   "Due soon\r\nBcc: someone@example.com",
+		Body:      "First line\nSecond line",
+	}, time.Now()))
+
+	// Then
+	assert.Contains(t, mail, "To: farmer@example.com\r\n")
+	assert.Contains(t, mail, "Subject: Due soon Bcc: someone@example.com\r\n")
+	assert.NotContains(t, mail, "\r\nBcc:")
+	assert.True(t, strings.HasSuffix(mail, "\r\n\r\nFirst line\r\nSecond line\r\n"))
+}
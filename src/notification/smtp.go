@@ -0,0 +1,73 @@
+package notification
+
+import (
+	"bytes"
+	"errors"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+// SMTPChannel sends the messages by email. The recipient is the email address.
+type SMTPChannel struct {
+	Host     string
+	Port     string
+	Username string
+	Password string
+	From     string
+}
+
+func NewSMTPChannel(host, port, username, password, from string) *SMTPChannel {
+	return &SMTPChannel{
+		Host:     host,
+		Port:     port,
+		Username: username,
+		Password: password,
+		From:     from,
+	}
+}
+
+// Send authenticates only when there is a username, so a local relay can be used without credentials
+func (s *SMTPChannel) Send(message Message) error {
+	if message.Recipient == "" {
+		return errors.New("Email recipient is empty")
+	}
+
+	var auth smtp.Auth
+	if s.Username != "" {
+		auth = smtp.PlainAuth("", s.Username, s.Password, s.Host)
+	}
+
+	return smtp.SendMail(net.JoinHostPort(s.Host, s.Port), auth, s.From, []string{message.Recipient}, s.buildMail(message, time.Now()))
+}
+
+// ValidateRecipient checks the recipient is a single email address
+func (s *SMTPChannel) ValidateRecipient(recipient string) error {
+	address, err := mail.ParseAddress(recipient)
+	if err != nil || address.Address != recipient {
+		return ErrInvalidRecipient
+	}
+
+	return nil
+}
+
+// buildMail writes the headers and the plain text body of the email.
+// The line breaks of the header values are removed, so they can't add headers.
+func (s *SMTPChannel) buildMail(message Message, date time.Time) []byte {
+	headerValue := strings.NewReplacer("\r", "", "\n", " ")
+
+	buf := bytes.Buffer{}
+	buf.WriteString("From: " + headerValue.Replace(s.From) + "\r\n")
+	buf.WriteString("To: " + headerValue.Replace(message.Recipient) + "\r\n")
+	buf.WriteString("Subject: " + headerValue.Replace(message.Subject) + "\r\n")
+	buf.WriteString("Date: " + date.Format(time.RFC1123Z) + "\r\n")
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	buf.WriteString("\r\n")
+	buf.WriteString(strings.Replace(message.Body, "\n", "\r\n", -1))
+	buf.WriteString("\r\n")
+
+	return buf.Bytes()
+}
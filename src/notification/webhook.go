@@ -0,0 +1,170 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"syscall"
+	"time"
+)
+
+// privateNetworks are the addresses of the server itself and of its local networks.
+// The webhooks are given by the users, so they must not reach the services which are not public.
+var privateNetworks = parseNetworks(
+	"0.0.0.0/8",
+	"10.0.0.0/8",
+	"100.64.0.0/10",
+	"127.0.0.0/8",
+	"169.254.0.0/16",
+	"172.16.0.0/12",
+	"192.0.0.0/24",
+	"192.168.0.0/16",
+	"198.18.0.0/15",
+	"224.0.0.0/4",
+	"240.0.0.0/4",
+	"::/128",
+	"::1/128",
+	"64:ff9b::/96",
+	"fc00::/7",
+	"fe80::/10",
+	"ff00::/8",
+)
+
+func parseNetworks(cidrs ...string) []*net.IPNet {
+	networks := []*net.IPNet{}
+
+	for _, v := range cidrs {
+		_, network, err := net.ParseCIDR(v)
+		if err != nil {
+			panic(err)
+		}
+
+		networks = append(networks, network)
+	}
+
+	return networks
+}
+
+// IsPublicIP checks the ip is not a loopback, link-local, private or reserved address
+func IsPublicIP(ip net.IP) bool {
+	for _, v := range privateNetworks {
+		if v.Contains(ip) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// WebhookChannel posts the messages as JSON. The recipient is the url of the webhook.
+// Unless AllowPrivateHosts is set, the webhooks can only be posted to public addresses.
+// The address is checked again when connecting, so a host resolving to a private address later is refused too.
+type WebhookChannel struct {
+	Client            *http.Client
+	AllowPrivateHosts bool
+}
+
+func NewWebhookChannel(allowPrivateHosts bool) *WebhookChannel {
+	w := &WebhookChannel{AllowPrivateHosts: allowPrivateHosts}
+
+	dialer := &net.Dialer{
+		Timeout: 10 * time.Second,
+		Control: w.controlAddress,
+	}
+
+	w.Client = &http.Client{
+		Timeout: 10 * time.Second,
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, network, address string) (net.Conn, error) {
+				return dialer.DialContext(ctx, network, address)
+			},
+		},
+	}
+
+	return w
+}
+
+// ValidateRecipient checks the recipient is an http url whose host resolves to allowed addresses
+func (w *WebhookChannel) ValidateRecipient(recipient string) error {
+	u, err := url.Parse(recipient)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+		return ErrInvalidRecipient
+	}
+
+	if w.AllowPrivateHosts {
+		return nil
+	}
+
+	ips, err := net.LookupIP(u.Hostname())
+	if err != nil {
+		return ErrInvalidRecipient
+	}
+
+	for _, v := range ips {
+		if !IsPublicIP(v) {
+			return ErrRecipientNotAllowed
+		}
+	}
+
+	return nil
+}
+
+// controlAddress refuses the connections to the private addresses, after the host is resolved
+func (w *WebhookChannel) controlAddress(network, address string, c syscall.RawConn) error {
+	if w.AllowPrivateHosts {
+		return nil
+	}
+
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil || !IsPublicIP(ip) {
+		return ErrRecipientNotAllowed
+	}
+
+	return nil
+}
+
+// Send fails when the webhook doesn't answer with a 2xx status.
+// The address of the webhook is only checked when connecting, so the host is resolved once.
+func (w *WebhookChannel) Send(message Message) error {
+	if message.Recipient == "" {
+		return errors.New("Webhook url is empty")
+	}
+
+	body, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+
+	resp, err := w.Client.Post(message.Recipient, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return dialError(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("Webhook answered with status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// dialError gives back ErrRecipientNotAllowed when the connection was refused by controlAddress
+func dialError(err error) error {
+	if urlErr, ok := err.(*url.Error); ok {
+		if opErr, ok := urlErr.Err.(*net.OpError); ok && opErr.Err == ErrRecipientNotAllowed {
+			return ErrRecipientNotAllowed
+		}
+	}
+
+	return err
+}
@@ -0,0 +1,62 @@
+package scheduler
+
+import (
+	"sync"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+// Lock elects the instance which runs a job.
+// Acquire returns true when the lock is free, expired or already held by this instance,
+// and holds it for ttl from now.
+type Lock interface {
+	Acquire(name string, ttl time.Duration) (bool, error)
+}
+
+// newOwner identifies the instance holding a lock
+func newOwner() string {
+	uid, err := uuid.NewV4()
+	if err != nil {
+		return time.Now().String()
+	}
+
+	return uid.String()
+}
+
+type lockLease struct {
+	owner       string
+	expiresDate time.Time
+}
+
+// LockInMemory is the lock of a single instance. It is used with the inmemory persistence engine,
+// where the data can't be shared between instances anyway.
+type LockInMemory struct {
+	Owner string
+
+	mutex  sync.Mutex
+	leases map[string]lockLease
+}
+
+func NewLockInMemory() *LockInMemory {
+	return &LockInMemory{
+		Owner:  newOwner(),
+		leases: make(map[string]lockLease),
+	}
+}
+
+func (l *LockInMemory) Acquire(name string, ttl time.Duration) (bool, error) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	now := time.Now()
+
+	lease, ok := l.leases[name]
+	if ok && lease.owner != l.Owner && lease.expiresDate.After(now) {
+		return false, nil
+	}
+
+	l.leases[name] = lockLease{owner: l.Owner, expiresDate: now.Add(ttl)}
+
+	return true, nil
+}
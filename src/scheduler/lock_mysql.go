@@ -0,0 +1,47 @@
+package scheduler
+
+import (
+	"database/sql"
+	"time"
+)
+
+// LockMysql keeps the lock lease in the SCHEDULER_LOCK table,
+// so only one of the instances sharing the database runs a job.
+// It relies on the clientFoundRows DSN parameter to count the renewed leases.
+type LockMysql struct {
+	DB    *sql.DB
+	Owner string
+}
+
+func NewLockMysql(db *sql.DB) *LockMysql {
+	return &LockMysql{DB: db, Owner: newOwner()}
+}
+
+func (l *LockMysql) Acquire(name string, ttl time.Duration) (bool, error) {
+	now := time.Now().UTC()
+	expiresDate := now.Add(ttl)
+
+	res, err := l.DB.Exec(`INSERT IGNORE INTO SCHEDULER_LOCK (NAME, OWNER, EXPIRES_DATE)
+		VALUES (?, ?, ?)`, name, l.Owner, expiresDate)
+	if err != nil {
+		return false, err
+	}
+
+	if rows, err := res.RowsAffected(); err != nil || rows == 1 {
+		return err == nil, err
+	}
+
+	res, err = l.DB.Exec(`UPDATE SCHEDULER_LOCK SET OWNER = ?, EXPIRES_DATE = ?
+		WHERE NAME = ? AND (OWNER = ? OR EXPIRES_DATE < ?)`,
+		l.Owner, expiresDate, name, l.Owner, now)
+	if err != nil {
+		return false, err
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rows == 1, nil
+}
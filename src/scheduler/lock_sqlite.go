@@ -0,0 +1,46 @@
+package scheduler
+
+import (
+	"database/sql"
+	"time"
+)
+
+// LockSqlite keeps the lock lease in the SCHEDULER_LOCK table
+type LockSqlite struct {
+	DB    *sql.DB
+	Owner string
+}
+
+func NewLockSqlite(db *sql.DB) *LockSqlite {
+	return &LockSqlite{DB: db, Owner: newOwner()}
+}
+
+func (l *LockSqlite) Acquire(name string, ttl time.Duration) (bool, error) {
+	now := time.Now().UTC()
+	expiresDate := now.Add(ttl).Format(time.RFC3339)
+
+	res, err := l.DB.Exec(`INSERT OR IGNORE INTO SCHEDULER_LOCK (NAME, OWNER, EXPIRES_DATE)
+		VALUES (?, ?, ?)`, name, l.Owner, expiresDate)
+	if err != nil {
+		return false, err
+	}
+
+	if rows, err := res.RowsAffected(); err != nil || rows == 1 {
+		return err == nil, err
+	}
+
+	// The dates are stored in UTC with the same format, so they can be compared as text
+	res, err = l.DB.Exec(`UPDATE SCHEDULER_LOCK SET OWNER = ?, EXPIRES_DATE = ?
+		WHERE NAME = ? AND (OWNER = ? OR EXPIRES_DATE < ?)`,
+		l.Owner, expiresDate, name, l.Owner, now.Format(time.RFC3339))
+	if err != nil {
+		return false, err
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rows == 1, nil
+}
@@ -0,0 +1,94 @@
+package scheduler
+
+import (
+	"sync"
+	"time"
+
+	"github.com/labstack/gommon/log"
+)
+
+// JobFunc is the work of a periodic job
+type JobFunc func() error
+
+type job struct {
+	name     string
+	interval time.Duration
+	run      JobFunc
+}
+
+// Scheduler runs the periodic jobs in the background of the server process.
+// When several instances share the same database, each run of a job is only done
+// by the instance holding the job lock, so the jobs must not rely on running on every instance.
+type Scheduler struct {
+	Lock Lock
+
+	jobs []job
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+func NewScheduler(lock Lock) *Scheduler {
+	return &Scheduler{
+		Lock: lock,
+		stop: make(chan struct{}),
+	}
+}
+
+// Every adds a job run every interval. A job with an interval of zero or less is not run,
+// so it can be disabled from the configuration. It must be called before Start.
+func (s *Scheduler) Every(name string, interval time.Duration, run JobFunc) {
+	if interval <= 0 {
+		log.Infof("Scheduler job %s is disabled", name)
+		return
+	}
+
+	s.jobs = append(s.jobs, job{name: name, interval: interval, run: run})
+}
+
+// Start runs every job in its own goroutine until Stop is called
+func (s *Scheduler) Start() {
+	for _, j := range s.jobs {
+		s.wg.Add(1)
+
+		go func(j job) {
+			defer s.wg.Done()
+
+			ticker := time.NewTicker(j.interval)
+			defer ticker.Stop()
+
+			for {
+				select {
+				case <-ticker.C:
+					s.RunJob(j.name, j.interval, j.run)
+				case <-s.stop:
+					return
+				}
+			}
+		}(j)
+	}
+}
+
+// Stop stops the jobs and waits for the running ones to finish
+func (s *Scheduler) Stop() {
+	close(s.stop)
+	s.wg.Wait()
+}
+
+// RunJob runs the job once if this instance gets its lock.
+// The lock is held for two intervals, so another instance takes over when this one stops renewing it.
+func (s *Scheduler) RunJob(name string, interval time.Duration, run JobFunc) {
+	acquired, err := s.Lock.Acquire(name, 2*interval)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+
+	if !acquired {
+		return
+	}
+
+	err = run()
+	if err != nil {
+		log.Errorf("Scheduler job %s failed. Err %v", name, err)
+	}
+}
@@ -0,0 +1,68 @@
+package scheduler
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLockInMemory(t *testing.T) {
+	// Given
+	lock := NewLockInMemory()
+	other := NewLockInMemory()
+	other.leases = lock.leases
+
+	// When
+	acquired, err := lock.Acquire("job", time.Minute)
+
+	// Then
+	assert.Nil(t, err)
+	assert.True(t, acquired)
+
+	// When
+	acquired, _ = lock.Acquire("job", time.Minute)
+	otherAcquired, _ := other.Acquire("job", time.Minute)
+
+	// Then
+	assert.True(t, acquired)
+	assert.False(t, otherAcquired)
+
+	// When
+	lock.leases["job"] = lockLease{owner: lock.Owner, expiresDate: time.Now().Add(-time.Second)}
+	otherAcquired, _ = other.Acquire("job", time.Minute)
+
+	// Then
+	assert.True(t, otherAcquired)
+}
+
+func TestScheduler(t *testing.T) {
+	// Given
+	s := NewScheduler(NewLockInMemory())
+
+	runs := make(chan struct{}, 10)
+	s.Every("job", 10*time.Millisecond, func() error {
+		runs <- struct{}{}
+		return errors.New("failed runs are retried on the next tick")
+	})
+	s.Every("disabled", 0, func() error {
+		t.Error("disabled job must not run")
+		return nil
+	})
+
+	// When
+	s.Start()
+
+	// Then
+	for i := 0; i < 2; i++ {
+		select {
+		case <-runs:
+		case <-time.After(time.Second):
+			t.Fatal("job was not run")
+		}
+	}
+
+	s.Stop()
+	assert.Len(t, s.jobs, 1)
+}
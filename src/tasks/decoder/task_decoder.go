@@ -163,6 +163,166 @@ func (w *TaskEventWrapper) UnmarshalJSON(b []byte) error {
 
 		w.Data = e
 
+	case domain.TaskRecurrenceChangedCode:
+		e := domain.TaskRecurrenceChanged{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.Data = e
+
+	case domain.TaskAssignedCode:
+		e := domain.TaskAssigned{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.Data = e
+
+	case domain.TaskChecklistItemAddedCode:
+		e := domain.TaskChecklistItemAdded{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.Data = e
+
+	case domain.TaskChecklistItemCompletedCode:
+		e := domain.TaskChecklistItemCompleted{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.Data = e
+
+	case domain.TaskChecklistItemRemovedCode:
+		e := domain.TaskChecklistItemRemoved{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.Data = e
+
+	case domain.TaskBlockerAddedCode:
+		e := domain.TaskBlockerAdded{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.Data = e
+
+	case domain.TaskBlockerRemovedCode:
+		e := domain.TaskBlockerRemoved{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.Data = e
+
+	case domain.TaskCommentAddedCode:
+		e := domain.TaskCommentAdded{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.Data = e
+
+	case domain.TaskAttachmentAddedCode:
+		e := domain.TaskAttachmentAdded{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.Data = e
+
+	case domain.TaskAttachmentRemovedCode:
+		e := domain.TaskAttachmentRemoved{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.Data = e
+
+	case domain.TaskTimerStartedCode:
+		e := domain.TaskTimerStarted{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.Data = e
+
+	case domain.TaskTimerStoppedCode:
+		e := domain.TaskTimerStopped{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.Data = e
+
+	case domain.TaskTimeLoggedCode:
+		e := domain.TaskTimeLogged{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.Data = e
+
+	case domain.TaskTimeEntryRemovedCode:
+		e := domain.TaskTimeEntryRemoved{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.Data = e
+
+	case domain.TaskMaterialConsumedCode:
+		e := domain.TaskMaterialConsumed{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.Data = e
+
+	case domain.TaskFinancePostedCode:
+		e := domain.TaskFinancePosted{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.Data = e
+
 	}
 
 	return nil
@@ -224,7 +384,35 @@ func makeDomainDetails(v interface{}, domainCode string) (domain.TaskDomain, err
 
 		domainDetails = taskDomainCrop
 	case domain.TaskDomainFinanceCode:
-		domainDetails = domain.TaskDomainFinance{}
+		taskDomainFinance := domain.TaskDomainFinance{}
+
+		if v2, ok2 := mapped["amount"].(string); ok2 {
+			taskDomainFinance.Amount = v2
+		}
+		if v2, ok2 := mapped["currency_code"].(string); ok2 {
+			taskDomainFinance.CurrencyCode = v2
+		}
+		if v2, ok2 := mapped["direction"].(string); ok2 {
+			taskDomainFinance.Direction = v2
+		}
+		if v2, ok2 := mapped["counterparty"].(string); ok2 {
+			taskDomainFinance.Counterparty = v2
+		}
+		if v2, ok2 := mapped["material_id"]; ok2 {
+			val, ok2 := v2.(string)
+			if !ok2 {
+				return taskDomainFinance, nil
+			}
+
+			uid, err := uuid.FromString(val)
+			if err != nil {
+				return domain.TaskDomainFinance{}, err
+			}
+
+			taskDomainFinance.MaterialID = &uid
+		}
+
+		domainDetails = taskDomainFinance
 	case domain.TaskDomainGeneralCode:
 		domainDetails = domain.TaskDomainGeneral{}
 	case domain.TaskDomainInventoryCode:
@@ -3,16 +3,47 @@ package service
 import (
 	domain "github.com/Tanibox/tania-core/src/tasks/domain"
 	"github.com/Tanibox/tania-core/src/tasks/query"
+	"github.com/Tanibox/tania-core/src/tasks/storage"
 	uuid "github.com/satori/go.uuid"
 )
 
 // TaskService handles task behaviours that needs external interaction to be worked
 
 type TaskServiceSqlLite struct {
+	FarmQuery      query.FarmQuery
 	CropQuery      query.CropQuery
 	AreaQuery      query.AreaQuery
 	MaterialQuery  query.MaterialQuery
 	ReservoirQuery query.ReservoirQuery
+	UserQuery      query.UserQuery
+	TaskReadQuery  query.TaskReadQuery
+}
+
+func (s TaskServiceSqlLite) FindFarmByID(uid uuid.UUID) domain.ServiceResult {
+	result := <-s.FarmQuery.FindFarmByID(uid)
+
+	if result.Error != nil {
+		return domain.ServiceResult{
+			Error: result.Error,
+		}
+	}
+
+	farm, ok := result.Result.(query.TaskFarmQueryResult)
+	if !ok {
+		return domain.ServiceResult{
+			Error: domain.TaskError{Code: domain.TaskErrorInvalidAssetIDCode},
+		}
+	}
+
+	if farm == (query.TaskFarmQueryResult{}) {
+		return domain.ServiceResult{
+			Error: domain.TaskError{Code: domain.TaskErrorInvalidAssetIDCode},
+		}
+	}
+
+	return domain.ServiceResult{
+		Result: farm,
+	}
 }
 
 func (s TaskServiceSqlLite) FindAreaByID(uid uuid.UUID) domain.ServiceResult {
@@ -122,3 +153,56 @@ func (s TaskServiceSqlLite) FindReservoirByID(uid uuid.UUID) domain.ServiceResul
 		Result: reservoir,
 	}
 }
+
+func (s TaskServiceSqlLite) FindUserByID(uid uuid.UUID) domain.ServiceResult {
+	result := <-s.UserQuery.FindUserByID(uid)
+
+	if result.Error != nil {
+		return domain.ServiceResult{
+			Error: result.Error,
+		}
+	}
+
+	user, ok := result.Result.(query.TaskUserQueryResult)
+	if !ok {
+		return domain.ServiceResult{
+			Error: domain.TaskError{Code: domain.TaskErrorInvalidAssigneeCode},
+		}
+	}
+
+	if user == (query.TaskUserQueryResult{}) {
+		return domain.ServiceResult{
+			Error: domain.TaskError{Code: domain.TaskErrorInvalidAssigneeCode},
+		}
+	}
+
+	return domain.ServiceResult{
+		Result: user,
+	}
+}
+
+func (s TaskServiceSqlLite) FindTaskByID(uid uuid.UUID) domain.ServiceResult {
+	result := <-s.TaskReadQuery.FindByID(uid)
+
+	if result.Error != nil {
+		return domain.ServiceResult{
+			Error: result.Error,
+		}
+	}
+
+	task, ok := result.Result.(storage.TaskRead)
+	if !ok || task.UID != uid {
+		return domain.ServiceResult{
+			Error: domain.TaskError{Code: domain.TaskErrorInvalidBlockerCode},
+		}
+	}
+
+	return domain.ServiceResult{
+		Result: query.TaskDependencyQueryResult{
+			UID:       task.UID,
+			Title:     task.Title,
+			Status:    task.Status,
+			BlockedBy: task.BlockedBy,
+		},
+	}
+}
@@ -6,10 +6,13 @@ import (
 )
 
 type TaskService interface {
+	FindFarmByID(uid uuid.UUID) ServiceResult
 	FindAreaByID(uid uuid.UUID) ServiceResult
 	FindCropByID(uid uuid.UUID) ServiceResult
 	FindMaterialByID(uid uuid.UUID) ServiceResult
 	FindReservoirByID(uid uuid.UUID) ServiceResult
+	FindUserByID(uid uuid.UUID) ServiceResult
+	FindTaskByID(uid uuid.UUID) ServiceResult
 }
 
 // ServiceResult is the container for service result
@@ -34,6 +37,25 @@ type Task struct {
 	IsDue         bool       `json:"is_due"`
 	AssetID       *uuid.UUID `json:"asset_id"`
 
+	// RecurrenceRule is empty for the one-off tasks.
+	// The occurrences of a recurring task share the SeriesID of the first one.
+	RecurrenceRule string     `json:"recurrence_rule"`
+	SeriesID       *uuid.UUID `json:"series_id"`
+
+	// AssigneeID is the user whose job the task is
+	AssigneeID *uuid.UUID `json:"assignee_id"`
+
+	Checklist []ChecklistItem `json:"checklist"`
+	// BlockedBy are the tasks to complete before this one
+	BlockedBy []uuid.UUID `json:"blocked_by"`
+
+	Comments    []TaskComment    `json:"comments"`
+	Attachments []TaskAttachment `json:"attachments"`
+
+	TimeEntries []TaskTimeEntry `json:"time_entries"`
+
+	MaterialConsumption *TaskMaterialConsumption `json:"material_consumption"`
+
 	// Events
 	Version            int
 	UncommittedChanges []interface{}
@@ -73,6 +95,10 @@ func CreateTask(taskService TaskService, title string, description string, dueda
 		return &Task{}, err
 	}
 
+	if taskdomain.Code() == TaskDomainFinanceCode && assetid == nil {
+		return &Task{}, TaskError{TaskErrorAssetIDEmptyCode}
+	}
+
 	uid, err := uuid.NewV4()
 	if err != nil {
 		return &Task{}, err
@@ -197,8 +223,18 @@ func (t *Task) SetTaskAsDue(taskService TaskService) {
 	})
 }
 
-// CompleteTask
-func (t *Task) CompleteTask(taskService TaskService) {
+// CompleteTask is rejected while any of the tasks blocking it is still open,
+// and when the task is already completed or cancelled
+func (t *Task) CompleteTask(taskService TaskService) error {
+	if t.Status != TaskStatusCreated {
+		return TaskError{TaskErrorTaskClosedCode}
+	}
+
+	err := t.validateBlockers(taskService)
+	if err != nil {
+		return err
+	}
+
 	completedTime := time.Now()
 
 	t.TrackChange(taskService, TaskCompleted{
@@ -206,10 +242,32 @@ func (t *Task) CompleteTask(taskService TaskService) {
 		Status:        TaskCompletedCode,
 		CompletedDate: &completedTime,
 	})
+
+	// Only an open task gets here, so a finance task is posted once, when it is completed.
+	// A closed task can't be completed or cancelled again, so its ledger entry never moves.
+	finance, ok := t.DomainDetails.(TaskDomainFinance)
+	if ok && t.AssetID != nil {
+		t.TrackChange(taskService, TaskFinancePosted{
+			UID:          t.UID,
+			FarmID:       *t.AssetID,
+			Amount:       finance.Amount,
+			CurrencyCode: finance.CurrencyCode,
+			Direction:    finance.Direction,
+			Counterparty: finance.Counterparty,
+			MaterialID:   finance.MaterialID,
+			PostedDate:   completedTime,
+		})
+	}
+
+	return nil
 }
 
-// CompleteTask
-func (t *Task) CancelTask(taskService TaskService) {
+// CancelTask is rejected when the task is already completed or cancelled
+func (t *Task) CancelTask(taskService TaskService) error {
+	if t.Status != TaskStatusCreated {
+		return TaskError{TaskErrorTaskClosedCode}
+	}
+
 	cancelledTime := time.Now()
 
 	t.TrackChange(taskService, TaskCancelled{
@@ -217,6 +275,103 @@ func (t *Task) CancelTask(taskService TaskService) {
 		Status:        TaskCancelledCode,
 		CancelledDate: &cancelledTime,
 	})
+
+	return nil
+}
+
+// AssignTask gives the task to a user, or unassigns it when assigneeID is nil
+func (t *Task) AssignTask(taskService TaskService, assigneeID *uuid.UUID) (*Task, error) {
+	if assigneeID != nil {
+		serviceResult := taskService.FindUserByID(*assigneeID)
+		if serviceResult.Error != nil {
+			return &Task{}, serviceResult.Error
+		}
+	}
+
+	t.TrackChange(taskService, TaskAssigned{
+		UID:        t.UID,
+		AssigneeID: assigneeID,
+	})
+
+	return t, nil
+}
+
+// ChangeTaskRecurrence makes the task recur with the rule. An empty rule ends the recurrence.
+func (t *Task) ChangeTaskRecurrence(taskService TaskService, rule string) (*Task, error) {
+	seriesID := t.SeriesID
+
+	if rule != "" {
+		recurrence, err := ParseTaskRecurrence(rule)
+		if err != nil {
+			return &Task{}, err
+		}
+
+		if t.DueDate == nil {
+			return &Task{}, TaskError{TaskErrorRecurrenceDueDateEmptyCode}
+		}
+
+		rule = recurrence.String()
+
+		if seriesID == nil {
+			uid := t.UID
+			seriesID = &uid
+		}
+	}
+
+	t.TrackChange(taskService, TaskRecurrenceChanged{
+		UID:            t.UID,
+		RecurrenceRule: rule,
+		SeriesID:       seriesID,
+	})
+
+	return t, nil
+}
+
+// CreateNextOccurrence creates the next occurrence of a recurring task with the same details.
+// Its due date is the first occurrence after now, so the occurrences missed meanwhile are skipped.
+// It returns nil when the task doesn't recur anymore.
+func (t *Task) CreateNextOccurrence(taskService TaskService) (*Task, error) {
+	if t.RecurrenceRule == "" || t.DueDate == nil {
+		return nil, nil
+	}
+
+	recurrence, err := ParseTaskRecurrence(t.RecurrenceRule)
+	if err != nil {
+		return nil, err
+	}
+
+	dueDate, next, ok := recurrence.Next(*t.DueDate, time.Now())
+	if !ok {
+		return nil, nil
+	}
+
+	task, err := CreateTask(taskService, t.Title, t.Description, &dueDate, t.Priority, t.DomainDetails, t.Category, t.AssetID)
+	if err != nil {
+		return nil, err
+	}
+
+	task.TrackChange(taskService, TaskRecurrenceChanged{
+		UID:            task.UID,
+		RecurrenceRule: next.String(),
+		SeriesID:       t.SeriesID,
+	})
+
+	if t.AssigneeID != nil {
+		task.TrackChange(taskService, TaskAssigned{
+			UID:        task.UID,
+			AssigneeID: t.AssigneeID,
+		})
+	}
+
+	// The checklist starts again from its first step
+	for _, v := range t.Checklist {
+		_, err := task.AddChecklistItem(taskService, v.Title)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return task, nil
 }
 
 // Event Tracking
@@ -266,6 +421,105 @@ func (state *Task) Transition(taskService TaskService, event interface{}) error
 		state.Status = TaskStatusCompleted
 	case TaskDue:
 		state.IsDue = true
+	case TaskRecurrenceChanged:
+		state.RecurrenceRule = e.RecurrenceRule
+		state.SeriesID = e.SeriesID
+	case TaskAssigned:
+		state.AssigneeID = e.AssigneeID
+	case TaskChecklistItemAdded:
+		state.Checklist = append(state.Checklist, ChecklistItem{
+			UID:         e.ItemUID,
+			Title:       e.Title,
+			CreatedDate: e.CreatedDate,
+		})
+	case TaskChecklistItemCompleted:
+		if item := state.ChecklistItem(e.ItemUID); item != nil {
+			item.IsCompleted = true
+			item.CompletedDate = e.CompletedDate
+		}
+	case TaskChecklistItemRemoved:
+		checklist := []ChecklistItem{}
+		for _, v := range state.Checklist {
+			if v.UID != e.ItemUID {
+				checklist = append(checklist, v)
+			}
+		}
+		state.Checklist = checklist
+	case TaskBlockerAdded:
+		state.BlockedBy = append(state.BlockedBy, e.BlockerUID)
+	case TaskBlockerRemoved:
+		blockedBy := []uuid.UUID{}
+		for _, v := range state.BlockedBy {
+			if v != e.BlockerUID {
+				blockedBy = append(blockedBy, v)
+			}
+		}
+		state.BlockedBy = blockedBy
+	case TaskCommentAdded:
+		state.Comments = append(state.Comments, TaskComment{
+			UID:         e.CommentUID,
+			Content:     e.Content,
+			AuthorID:    e.AuthorID,
+			CreatedDate: e.CreatedDate,
+		})
+	case TaskAttachmentAdded:
+		state.Attachments = append(state.Attachments, TaskAttachment{
+			UID:         e.AttachmentUID,
+			Filename:    e.Filename,
+			MimeType:    e.MimeType,
+			Size:        e.Size,
+			Width:       e.Width,
+			Height:      e.Height,
+			UploaderID:  e.UploaderID,
+			CreatedDate: e.CreatedDate,
+		})
+	case TaskAttachmentRemoved:
+		attachments := []TaskAttachment{}
+		for _, v := range state.Attachments {
+			if v.UID != e.AttachmentUID {
+				attachments = append(attachments, v)
+			}
+		}
+		state.Attachments = attachments
+	case TaskTimerStarted:
+		state.TimeEntries = append(state.TimeEntries, TaskTimeEntry{
+			UID:        e.EntryUID,
+			WorkerID:   e.WorkerID,
+			HourlyRate: e.HourlyRate,
+			StartDate:  e.StartDate,
+		})
+	case TaskTimerStopped:
+		if entry := state.TimeEntry(e.EntryUID); entry != nil {
+			endDate := e.EndDate
+			entry.EndDate = &endDate
+			entry.Duration = e.Duration
+		}
+	case TaskTimeLogged:
+		endDate := e.EndDate
+		state.TimeEntries = append(state.TimeEntries, TaskTimeEntry{
+			UID:        e.EntryUID,
+			WorkerID:   e.WorkerID,
+			HourlyRate: e.HourlyRate,
+			StartDate:  e.StartDate,
+			EndDate:    &endDate,
+			Duration:   e.Duration,
+		})
+	case TaskTimeEntryRemoved:
+		timeEntries := []TaskTimeEntry{}
+		for _, v := range state.TimeEntries {
+			if v.UID != e.EntryUID {
+				timeEntries = append(timeEntries, v)
+			}
+		}
+		state.TimeEntries = timeEntries
+	case TaskMaterialConsumed:
+		state.MaterialConsumption = &TaskMaterialConsumption{
+			MaterialID:        e.MaterialID,
+			Quantity:          e.Quantity,
+			QuantityUnit:      e.QuantityUnit,
+			InsufficientStock: e.InsufficientStock,
+			ConsumedDate:      e.ConsumedDate,
+		}
 	}
 
 	return nil
@@ -378,6 +632,13 @@ func validateAssetID(taskService TaskService, assetid *uuid.UUID, taskdomain str
 		case TaskDomainReservoirCode:
 			serviceResult := taskService.FindReservoirByID(*assetid)
 
+			if serviceResult.Error != nil {
+				return serviceResult.Error
+			}
+		case TaskDomainFinanceCode:
+			// The asset of a finance task is the farm whose ledger it is posted to
+			serviceResult := taskService.FindFarmByID(*assetid)
+
 			if serviceResult.Error != nil {
 				return serviceResult.Error
 			}
@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"path/filepath"
+	"strings"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+// TaskAttachmentMimeTypePDF is the only file type attached besides the images
+const TaskAttachmentMimeTypePDF = "application/pdf"
+
+// TaskAttachment is a file attached to a task. Width and Height are only set for the images.
+// Filename is the name of the uploaded file, while the stored file is named after the UID.
+type TaskAttachment struct {
+	UID         uuid.UUID  `json:"uid"`
+	Filename    string     `json:"filename"`
+	MimeType    string     `json:"mime_type"`
+	Size        int        `json:"size"`
+	Width       int        `json:"width"`
+	Height      int        `json:"height"`
+	UploaderID  *uuid.UUID `json:"uploader_id"`
+	CreatedDate time.Time  `json:"created_date"`
+}
+
+// IsImage checks whether the attachment has thumbnails
+func (a TaskAttachment) IsImage() bool {
+	return IsTaskAttachmentImage(a.MimeType)
+}
+
+// StoredFilename is the name of the stored file, so the attachments with the same filename don't overwrite each other
+func (a TaskAttachment) StoredFilename() string {
+	return a.UID.String() + strings.ToLower(filepath.Ext(a.Filename))
+}
+
+func IsTaskAttachmentImage(mimeType string) bool {
+	return strings.HasPrefix(mimeType, "image/")
+}
+
+// Attachment returns the attachment with the UID, or nil when it is not in the task
+func (t *Task) Attachment(uid uuid.UUID) *TaskAttachment {
+	for i, v := range t.Attachments {
+		if v.UID == uid {
+			return &t.Attachments[i]
+		}
+	}
+
+	return nil
+}
+
+// AddAttachment is given the UID of the attachment, because the file is stored under it before the attachment is added
+func (t *Task) AddAttachment(taskService TaskService, uid uuid.UUID, filename, mimeType string, size, width, height int, uploaderID *uuid.UUID) (*Task, error) {
+	if filename == "" {
+		return &Task{}, TaskError{TaskErrorAttachmentInvalidFilenameCode}
+	}
+
+	if !IsTaskAttachmentImage(mimeType) && mimeType != TaskAttachmentMimeTypePDF {
+		return &Task{}, TaskError{TaskErrorAttachmentInvalidMimeTypeCode}
+	}
+
+	if size <= 0 {
+		return &Task{}, TaskError{TaskErrorAttachmentInvalidSizeCode}
+	}
+
+	t.TrackChange(taskService, TaskAttachmentAdded{
+		UID:           t.UID,
+		AttachmentUID: uid,
+		Filename:      filename,
+		MimeType:      mimeType,
+		Size:          size,
+		Width:         width,
+		Height:        height,
+		UploaderID:    uploaderID,
+		CreatedDate:   time.Now(),
+	})
+
+	return t, nil
+}
+
+func (t *Task) RemoveAttachment(taskService TaskService, attachmentUID uuid.UUID) (*Task, error) {
+	if t.Attachment(attachmentUID) == nil {
+		return &Task{}, TaskError{TaskErrorAttachmentNotFoundCode}
+	}
+
+	t.TrackChange(taskService, TaskAttachmentRemoved{
+		UID:           t.UID,
+		AttachmentUID: attachmentUID,
+	})
+
+	return t, nil
+}
@@ -0,0 +1,99 @@
+package domain
+
+import (
+	"github.com/Tanibox/tania-core/src/tasks/query"
+	uuid "github.com/satori/go.uuid"
+)
+
+// IsBlockedBy checks whether the task can't be completed before the other one
+func (t *Task) IsBlockedBy(uid uuid.UUID) bool {
+	for _, v := range t.BlockedBy {
+		if v == uid {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AddBlocker makes the task wait for the blocker task to be completed.
+// It is rejected when the blocker already waits for this task, directly or through other tasks.
+func (t *Task) AddBlocker(taskService TaskService, blockerUID uuid.UUID) (*Task, error) {
+	if blockerUID == t.UID {
+		return &Task{}, TaskError{TaskErrorBlockerCycleCode}
+	}
+
+	if t.IsBlockedBy(blockerUID) {
+		return t, nil
+	}
+
+	visited := make(map[uuid.UUID]bool)
+	uids := []uuid.UUID{blockerUID}
+
+	for len(uids) > 0 {
+		uid := uids[len(uids)-1]
+		uids = uids[:len(uids)-1]
+
+		if uid == t.UID {
+			return &Task{}, TaskError{TaskErrorBlockerCycleCode}
+		}
+
+		if visited[uid] {
+			continue
+		}
+		visited[uid] = true
+
+		serviceResult := taskService.FindTaskByID(uid)
+		if serviceResult.Error != nil {
+			return &Task{}, serviceResult.Error
+		}
+
+		blocker, ok := serviceResult.Result.(query.TaskDependencyQueryResult)
+		if !ok {
+			return &Task{}, TaskError{TaskErrorInvalidBlockerCode}
+		}
+
+		uids = append(uids, blocker.BlockedBy...)
+	}
+
+	t.TrackChange(taskService, TaskBlockerAdded{
+		UID:        t.UID,
+		BlockerUID: blockerUID,
+	})
+
+	return t, nil
+}
+
+func (t *Task) RemoveBlocker(taskService TaskService, blockerUID uuid.UUID) (*Task, error) {
+	if !t.IsBlockedBy(blockerUID) {
+		return &Task{}, TaskError{TaskErrorInvalidBlockerCode}
+	}
+
+	t.TrackChange(taskService, TaskBlockerRemoved{
+		UID:        t.UID,
+		BlockerUID: blockerUID,
+	})
+
+	return t, nil
+}
+
+// validateBlockers checks that the blockers of the task are not open anymore
+func (t *Task) validateBlockers(taskService TaskService) error {
+	for _, v := range t.BlockedBy {
+		serviceResult := taskService.FindTaskByID(v)
+		if serviceResult.Error != nil {
+			return serviceResult.Error
+		}
+
+		blocker, ok := serviceResult.Result.(query.TaskDependencyQueryResult)
+		if !ok {
+			return TaskError{TaskErrorInvalidBlockerCode}
+		}
+
+		if blocker.Status == TaskStatusCreated {
+			return TaskError{TaskErrorBlockedCode}
+		}
+	}
+
+	return nil
+}
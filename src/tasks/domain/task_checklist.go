@@ -0,0 +1,81 @@
+package domain
+
+import (
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+// ChecklistItem is a step of a task, completed on its own
+type ChecklistItem struct {
+	UID           uuid.UUID  `json:"uid"`
+	Title         string     `json:"title"`
+	IsCompleted   bool       `json:"is_completed"`
+	CreatedDate   time.Time  `json:"created_date"`
+	CompletedDate *time.Time `json:"completed_date"`
+}
+
+// ChecklistItem returns the checklist item with the UID, or nil when it is not in the task
+func (t *Task) ChecklistItem(uid uuid.UUID) *ChecklistItem {
+	for i, v := range t.Checklist {
+		if v.UID == uid {
+			return &t.Checklist[i]
+		}
+	}
+
+	return nil
+}
+
+func (t *Task) AddChecklistItem(taskService TaskService, title string) (*Task, error) {
+	if title == "" {
+		return &Task{}, TaskError{TaskErrorChecklistItemTitleEmptyCode}
+	}
+
+	uid, err := uuid.NewV4()
+	if err != nil {
+		return &Task{}, err
+	}
+
+	t.TrackChange(taskService, TaskChecklistItemAdded{
+		UID:         t.UID,
+		ItemUID:     uid,
+		Title:       title,
+		CreatedDate: time.Now(),
+	})
+
+	return t, nil
+}
+
+func (t *Task) CompleteChecklistItem(taskService TaskService, itemUID uuid.UUID) (*Task, error) {
+	item := t.ChecklistItem(itemUID)
+	if item == nil {
+		return &Task{}, TaskError{TaskErrorChecklistItemNotFoundCode}
+	}
+
+	if item.IsCompleted {
+		return &Task{}, TaskError{TaskErrorChecklistItemCompletedCode}
+	}
+
+	completedDate := time.Now()
+
+	t.TrackChange(taskService, TaskChecklistItemCompleted{
+		UID:           t.UID,
+		ItemUID:       itemUID,
+		CompletedDate: &completedDate,
+	})
+
+	return t, nil
+}
+
+func (t *Task) RemoveChecklistItem(taskService TaskService, itemUID uuid.UUID) (*Task, error) {
+	if t.ChecklistItem(itemUID) == nil {
+		return &Task{}, TaskError{TaskErrorChecklistItemNotFoundCode}
+	}
+
+	t.TrackChange(taskService, TaskChecklistItemRemoved{
+		UID:     t.UID,
+		ItemUID: itemUID,
+	})
+
+	return t, nil
+}
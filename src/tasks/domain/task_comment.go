@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+// TaskComment is a message of the discussion of a task.
+// AuthorID is nil when the comment is written without a signed in user, as in the demo mode.
+type TaskComment struct {
+	UID         uuid.UUID  `json:"uid"`
+	Content     string     `json:"content"`
+	AuthorID    *uuid.UUID `json:"author_id"`
+	CreatedDate time.Time  `json:"created_date"`
+}
+
+func (t *Task) AddComment(taskService TaskService, content string, authorID *uuid.UUID) (*Task, error) {
+	if content == "" {
+		return &Task{}, TaskError{TaskErrorCommentContentEmptyCode}
+	}
+
+	uid, err := uuid.NewV4()
+	if err != nil {
+		return &Task{}, err
+	}
+
+	t.TrackChange(taskService, TaskCommentAdded{
+		UID:         t.UID,
+		CommentUID:  uid,
+		Content:     content,
+		AuthorID:    authorID,
+		CreatedDate: time.Now(),
+	})
+
+	return t, nil
+}
@@ -1,6 +1,10 @@
 package domain
 
 import (
+	"regexp"
+	"strconv"
+	"strings"
+
 	uuid "github.com/satori/go.uuid"
 )
 
@@ -13,6 +17,17 @@ const (
 	TaskDomainReservoirCode = "RESERVOIR"
 )
 
+const (
+	TaskFinanceDirectionPayable    = "PAYABLE"
+	TaskFinanceDirectionReceivable = "RECEIVABLE"
+)
+
+// currencyCodePattern matches the ISO 4217 currency codes, such as EUR
+var currencyCodePattern = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// financeAmountPattern matches the decimal amounts with at most two decimals, such as 12.50
+var financeAmountPattern = regexp.MustCompile(`^\d+(\.\d{1,2})?$`)
+
 type TaskDomain interface {
 	Code() string
 }
@@ -37,7 +52,14 @@ func (d TaskDomainCrop) Code() string {
 }
 
 // FINANCE
+// The asset of a finance task is the farm whose ledger it is posted to, when it is completed.
 type TaskDomainFinance struct {
+	Amount       string `json:"amount"`
+	CurrencyCode string `json:"currency_code"`
+	Direction    string `json:"direction"`
+	Counterparty string `json:"counterparty"`
+	// MaterialID links the payment to a material purchase
+	MaterialID *uuid.UUID `json:"material_id"`
 }
 
 func (d TaskDomainFinance) Code() string {
@@ -118,8 +140,67 @@ func CreateTaskDomainCrop(taskService TaskService, category string, materialID *
 }
 
 // CreateTaskDomainFinance
-func CreateTaskDomainFinance() (TaskDomainFinance, error) {
-	return TaskDomainFinance{}, nil
+func CreateTaskDomainFinance(taskService TaskService, amount, currencyCode, direction, counterparty string, materialID *uuid.UUID) (TaskDomainFinance, error) {
+	value, err := ParseFinanceAmount(amount)
+	if err != nil || value <= 0 {
+		return TaskDomainFinance{}, TaskError{TaskErrorFinanceInvalidAmountCode}
+	}
+
+	currencyCode = strings.ToUpper(currencyCode)
+	if !currencyCodePattern.MatchString(currencyCode) {
+		return TaskDomainFinance{}, TaskError{TaskErrorFinanceInvalidCurrencyCode}
+	}
+
+	if direction != TaskFinanceDirectionPayable && direction != TaskFinanceDirectionReceivable {
+		return TaskDomainFinance{}, TaskError{TaskErrorFinanceInvalidDirectionCode}
+	}
+
+	if strings.TrimSpace(counterparty) == "" {
+		return TaskDomainFinance{}, TaskError{TaskErrorFinanceCounterpartyEmptyCode}
+	}
+
+	if materialID != nil {
+		err := validateAssetID(taskService, materialID, TaskDomainInventoryCode)
+		if err != nil {
+			return TaskDomainFinance{}, err
+		}
+	}
+
+	return TaskDomainFinance{
+		Amount:       amount,
+		CurrencyCode: currencyCode,
+		Direction:    direction,
+		Counterparty: counterparty,
+		MaterialID:   materialID,
+	}, nil
+}
+
+// ParseFinanceAmount parses a finance amount into its minor units, so 12.5 is 1250.
+// The amounts are summed in minor units to keep them exact.
+func ParseFinanceAmount(amount string) (int64, error) {
+	if !financeAmountPattern.MatchString(amount) {
+		return 0, TaskError{TaskErrorFinanceInvalidAmountCode}
+	}
+
+	parts := strings.SplitN(amount, ".", 2)
+
+	minorUnits, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return 0, TaskError{TaskErrorFinanceInvalidAmountCode}
+	}
+
+	minorUnits *= 100
+
+	if len(parts) == 2 {
+		cents, err := strconv.ParseInt((parts[1] + "0")[:2], 10, 64)
+		if err != nil {
+			return 0, TaskError{TaskErrorFinanceInvalidAmountCode}
+		}
+
+		minorUnits += cents
+	}
+
+	return minorUnits, nil
 }
 
 // CreateTaskDomainGeneral
@@ -41,6 +41,58 @@ const (
 
 	// Task General Errors
 	TaskErrorTaskNotFoundCode
+
+	// Recurrence Errors
+	TaskErrorInvalidRecurrenceCode
+	TaskErrorRecurrenceDueDateEmptyCode
+
+	// Assignee Errors
+	TaskErrorInvalidAssigneeCode
+
+	// Checklist Errors
+	TaskErrorChecklistItemTitleEmptyCode
+	TaskErrorChecklistItemNotFoundCode
+	TaskErrorChecklistItemCompletedCode
+
+	// Blocker Errors
+	TaskErrorInvalidBlockerCode
+	TaskErrorBlockerCycleCode
+	TaskErrorBlockedCode
+
+	// Comment Errors
+	TaskErrorCommentContentEmptyCode
+
+	// Attachment Errors
+	TaskErrorAttachmentInvalidFilenameCode
+	TaskErrorAttachmentInvalidMimeTypeCode
+	TaskErrorAttachmentInvalidSizeCode
+	TaskErrorAttachmentNotFoundCode
+
+	// Time Tracking Errors
+	TaskErrorInvalidWorkerCode
+	TaskErrorInvalidHourlyRateCode
+	TaskErrorInvalidTimeDurationCode
+	TaskErrorTimerAlreadyStartedCode
+	TaskErrorTimerNotStartedCode
+	TaskErrorTimeEntryNotFoundCode
+
+	// Material Consumption Errors
+	TaskErrorNoMaterialCode
+	TaskErrorInvalidConsumedQuantityCode
+	TaskErrorNotEnoughStockCode
+	TaskErrorMaterialConsumedCode
+
+	// Finance Errors
+	TaskErrorFinanceInvalidAmountCode
+	TaskErrorFinanceInvalidCurrencyCode
+	TaskErrorFinanceInvalidDirectionCode
+	TaskErrorFinanceCounterpartyEmptyCode
+
+	// Reminder Errors
+	TaskErrorInvalidRemindBeforeCode
+
+	// Status Transition Errors
+	TaskErrorTaskClosedCode
 )
 
 // TaskError is a custom error from Go built-in error
@@ -88,6 +140,66 @@ func (e TaskError) Error() string {
 		return "Task area reference is invalid."
 	case TaskErrorTaskNotFoundCode:
 		return "Task not found"
+	case TaskErrorInvalidRecurrenceCode:
+		return "Task recurrence rule is invalid."
+	case TaskErrorRecurrenceDueDateEmptyCode:
+		return "A recurring task requires a due date."
+	case TaskErrorInvalidAssigneeCode:
+		return "Task assignee is invalid."
+	case TaskErrorChecklistItemTitleEmptyCode:
+		return "Checklist item title is required."
+	case TaskErrorChecklistItemNotFoundCode:
+		return "Checklist item not found."
+	case TaskErrorChecklistItemCompletedCode:
+		return "Checklist item is already completed."
+	case TaskErrorInvalidBlockerCode:
+		return "Task blocker is invalid."
+	case TaskErrorBlockerCycleCode:
+		return "Task blocker would make the tasks wait for each other."
+	case TaskErrorBlockedCode:
+		return "Task cannot be completed while the tasks blocking it are open."
+	case TaskErrorCommentContentEmptyCode:
+		return "Comment content is required."
+	case TaskErrorAttachmentInvalidFilenameCode:
+		return "Attachment filename is invalid."
+	case TaskErrorAttachmentInvalidMimeTypeCode:
+		return "Attachment must be an image or a PDF file."
+	case TaskErrorAttachmentInvalidSizeCode:
+		return "Attachment size is invalid."
+	case TaskErrorAttachmentNotFoundCode:
+		return "Attachment not found."
+	case TaskErrorInvalidWorkerCode:
+		return "Time entry worker is invalid."
+	case TaskErrorInvalidHourlyRateCode:
+		return "Hourly rate cannot be negative."
+	case TaskErrorInvalidTimeDurationCode:
+		return "Time entry duration must be positive."
+	case TaskErrorTimerAlreadyStartedCode:
+		return "The timer of this worker is already running on this task."
+	case TaskErrorTimerNotStartedCode:
+		return "The timer of this worker is not running on this task."
+	case TaskErrorTimeEntryNotFoundCode:
+		return "Time entry not found."
+	case TaskErrorNoMaterialCode:
+		return "Task has no material to consume."
+	case TaskErrorInvalidConsumedQuantityCode:
+		return "Consumed quantity must be positive."
+	case TaskErrorNotEnoughStockCode:
+		return "Material stock is not enough for the consumed quantity."
+	case TaskErrorMaterialConsumedCode:
+		return "The material of this task is already consumed."
+	case TaskErrorFinanceInvalidAmountCode:
+		return "Finance amount must be a positive number with at most two decimals."
+	case TaskErrorFinanceInvalidCurrencyCode:
+		return "Finance currency code is invalid."
+	case TaskErrorFinanceInvalidDirectionCode:
+		return "Finance direction must be payable or receivable."
+	case TaskErrorFinanceCounterpartyEmptyCode:
+		return "Finance counterparty is required."
+	case TaskErrorInvalidRemindBeforeCode:
+		return "Reminder time before the due date can't be negative."
+	case TaskErrorTaskClosedCode:
+		return "Task is already completed or cancelled."
 	default:
 		return "Unrecognized Task Error Code"
 	}
@@ -7,17 +7,33 @@ import (
 )
 
 const (
-	TaskCreatedCode            = "TaskCreated"
-	TaskTitleChangedCode       = "TaskTitleChanged"
-	TaskDescriptionChangedCode = "TaskDescriptionChanged"
-	TaskPriorityChangedCode    = "TaskPriorityChanged"
-	TaskDueDateChangedCode     = "TaskDueDateChanged"
-	TaskCategoryChangedCode    = "TaskCategoryChanged"
-	TaskDetailsChangedCode     = "TaskDetailsChanged"
-	TaskAssetIDChangedCode     = "TaskAssetIDChanged"
-	TaskCompletedCode          = "TaskCompleted"
-	TaskCancelledCode          = "TaskCancelled"
-	TaskDueCode                = "TaskDue"
+	TaskCreatedCode                = "TaskCreated"
+	TaskTitleChangedCode           = "TaskTitleChanged"
+	TaskDescriptionChangedCode     = "TaskDescriptionChanged"
+	TaskPriorityChangedCode        = "TaskPriorityChanged"
+	TaskDueDateChangedCode         = "TaskDueDateChanged"
+	TaskCategoryChangedCode        = "TaskCategoryChanged"
+	TaskDetailsChangedCode         = "TaskDetailsChanged"
+	TaskAssetIDChangedCode         = "TaskAssetIDChanged"
+	TaskCompletedCode              = "TaskCompleted"
+	TaskCancelledCode              = "TaskCancelled"
+	TaskDueCode                    = "TaskDue"
+	TaskRecurrenceChangedCode      = "TaskRecurrenceChanged"
+	TaskAssignedCode               = "TaskAssigned"
+	TaskChecklistItemAddedCode     = "TaskChecklistItemAdded"
+	TaskChecklistItemCompletedCode = "TaskChecklistItemCompleted"
+	TaskChecklistItemRemovedCode   = "TaskChecklistItemRemoved"
+	TaskBlockerAddedCode           = "TaskBlockerAdded"
+	TaskBlockerRemovedCode         = "TaskBlockerRemoved"
+	TaskCommentAddedCode           = "TaskCommentAdded"
+	TaskAttachmentAddedCode        = "TaskAttachmentAdded"
+	TaskAttachmentRemovedCode      = "TaskAttachmentRemoved"
+	TaskTimerStartedCode           = "TaskTimerStarted"
+	TaskTimerStoppedCode           = "TaskTimerStopped"
+	TaskTimeLoggedCode             = "TaskTimeLogged"
+	TaskTimeEntryRemovedCode       = "TaskTimeEntryRemoved"
+	TaskMaterialConsumedCode       = "TaskMaterialConsumed"
+	TaskFinancePostedCode          = "TaskFinancePosted"
 )
 
 type TaskCreated struct {
@@ -85,3 +101,121 @@ type TaskCancelled struct {
 type TaskDue struct {
 	UID uuid.UUID `json:"uid"`
 }
+
+type TaskRecurrenceChanged struct {
+	UID            uuid.UUID  `json:"uid"`
+	RecurrenceRule string     `json:"recurrence_rule"`
+	SeriesID       *uuid.UUID `json:"series_id"`
+}
+
+// TaskAssigned is also used to reassign the task, and to unassign it with a nil AssigneeID
+type TaskAssigned struct {
+	UID        uuid.UUID  `json:"uid"`
+	AssigneeID *uuid.UUID `json:"assignee_id"`
+}
+
+type TaskChecklistItemAdded struct {
+	UID         uuid.UUID `json:"uid"`
+	ItemUID     uuid.UUID `json:"item_uid"`
+	Title       string    `json:"title"`
+	CreatedDate time.Time `json:"created_date"`
+}
+
+type TaskChecklistItemCompleted struct {
+	UID           uuid.UUID  `json:"uid"`
+	ItemUID       uuid.UUID  `json:"item_uid"`
+	CompletedDate *time.Time `json:"completed_date"`
+}
+
+type TaskChecklistItemRemoved struct {
+	UID     uuid.UUID `json:"uid"`
+	ItemUID uuid.UUID `json:"item_uid"`
+}
+
+type TaskBlockerAdded struct {
+	UID        uuid.UUID `json:"uid"`
+	BlockerUID uuid.UUID `json:"blocker_uid"`
+}
+
+type TaskBlockerRemoved struct {
+	UID        uuid.UUID `json:"uid"`
+	BlockerUID uuid.UUID `json:"blocker_uid"`
+}
+
+type TaskCommentAdded struct {
+	UID         uuid.UUID  `json:"uid"`
+	CommentUID  uuid.UUID  `json:"comment_uid"`
+	Content     string     `json:"content"`
+	AuthorID    *uuid.UUID `json:"author_id"`
+	CreatedDate time.Time  `json:"created_date"`
+}
+
+type TaskAttachmentAdded struct {
+	UID           uuid.UUID  `json:"uid"`
+	AttachmentUID uuid.UUID  `json:"attachment_uid"`
+	Filename      string     `json:"filename"`
+	MimeType      string     `json:"mime_type"`
+	Size          int        `json:"size"`
+	Width         int        `json:"width"`
+	Height        int        `json:"height"`
+	UploaderID    *uuid.UUID `json:"uploader_id"`
+	CreatedDate   time.Time  `json:"created_date"`
+}
+
+type TaskAttachmentRemoved struct {
+	UID           uuid.UUID `json:"uid"`
+	AttachmentUID uuid.UUID `json:"attachment_uid"`
+}
+
+type TaskTimerStarted struct {
+	UID        uuid.UUID  `json:"uid"`
+	EntryUID   uuid.UUID  `json:"entry_uid"`
+	WorkerID   *uuid.UUID `json:"worker_id"`
+	HourlyRate float64    `json:"hourly_rate"`
+	StartDate  time.Time  `json:"start_date"`
+}
+
+type TaskTimerStopped struct {
+	UID      uuid.UUID     `json:"uid"`
+	EntryUID uuid.UUID     `json:"entry_uid"`
+	EndDate  time.Time     `json:"end_date"`
+	Duration time.Duration `json:"duration"`
+}
+
+// TaskTimeLogged is a time entry added after the work, without a timer
+type TaskTimeLogged struct {
+	UID        uuid.UUID     `json:"uid"`
+	EntryUID   uuid.UUID     `json:"entry_uid"`
+	WorkerID   *uuid.UUID    `json:"worker_id"`
+	HourlyRate float64       `json:"hourly_rate"`
+	StartDate  time.Time     `json:"start_date"`
+	EndDate    time.Time     `json:"end_date"`
+	Duration   time.Duration `json:"duration"`
+}
+
+type TaskTimeEntryRemoved struct {
+	UID      uuid.UUID `json:"uid"`
+	EntryUID uuid.UUID `json:"entry_uid"`
+}
+
+// TaskFinancePosted is the completed finance task posted to the ledger of its farm
+type TaskFinancePosted struct {
+	UID          uuid.UUID  `json:"uid"`
+	FarmID       uuid.UUID  `json:"farm_id"`
+	Amount       string     `json:"amount"`
+	CurrencyCode string     `json:"currency_code"`
+	Direction    string     `json:"direction"`
+	Counterparty string     `json:"counterparty"`
+	MaterialID   *uuid.UUID `json:"material_id"`
+	PostedDate   time.Time  `json:"posted_date"`
+}
+
+// TaskMaterialConsumed is listened by the assets to deduct the quantity from the material stock
+type TaskMaterialConsumed struct {
+	UID               uuid.UUID `json:"uid"`
+	MaterialID        uuid.UUID `json:"material_id"`
+	Quantity          float32   `json:"quantity"`
+	QuantityUnit      string    `json:"quantity_unit"`
+	InsufficientStock bool      `json:"insufficient_stock"`
+	ConsumedDate      time.Time `json:"consumed_date"`
+}
@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/Tanibox/tania-core/src/tasks/query"
+	uuid "github.com/satori/go.uuid"
+)
+
+// TaskMaterialConsumption is the quantity of the task's material used to do the task,
+// in the unit of the material stock
+type TaskMaterialConsumption struct {
+	MaterialID   uuid.UUID `json:"material_id"`
+	Quantity     float32   `json:"quantity"`
+	QuantityUnit string    `json:"quantity_unit"`
+	// InsufficientStock flags a quantity more than the material stock, when the configuration allows it
+	InsufficientStock bool      `json:"insufficient_stock"`
+	ConsumedDate      time.Time `json:"consumed_date"`
+}
+
+// TaskDomainMaterialID returns the material of the area, crop and reservoir tasks, or nil
+func TaskDomainMaterialID(details TaskDomain) *uuid.UUID {
+	switch d := details.(type) {
+	case TaskDomainArea:
+		return d.MaterialID
+	case TaskDomainCrop:
+		return d.MaterialID
+	case TaskDomainReservoir:
+		return d.MaterialID
+	}
+
+	return nil
+}
+
+// ConsumeMaterial records the quantity of the task's material used, which is deducted from the material stock.
+// A quantity more than the stock is rejected, unless allowInsufficientStock is true. Then it is flagged.
+func (t *Task) ConsumeMaterial(taskService TaskService, quantity float32, allowInsufficientStock bool) error {
+	if t.MaterialConsumption != nil {
+		return TaskError{TaskErrorMaterialConsumedCode}
+	}
+
+	materialID := TaskDomainMaterialID(t.DomainDetails)
+	if materialID == nil {
+		return TaskError{TaskErrorNoMaterialCode}
+	}
+
+	if quantity <= 0 {
+		return TaskError{TaskErrorInvalidConsumedQuantityCode}
+	}
+
+	serviceResult := taskService.FindMaterialByID(*materialID)
+	if serviceResult.Error != nil {
+		return serviceResult.Error
+	}
+
+	material, ok := serviceResult.Result.(query.TaskMaterialQueryResult)
+	if !ok || material.UID != *materialID {
+		return TaskError{TaskErrorInvalidInventoryIDCode}
+	}
+
+	insufficientStock := quantity > material.Quantity
+	if insufficientStock && !allowInsufficientStock {
+		return TaskError{TaskErrorNotEnoughStockCode}
+	}
+
+	t.TrackChange(taskService, TaskMaterialConsumed{
+		UID:               t.UID,
+		MaterialID:        material.UID,
+		Quantity:          quantity,
+		QuantityUnit:      material.QuantityUnit,
+		InsufficientStock: insufficientStock,
+		ConsumedDate:      time.Now(),
+	})
+
+	return nil
+}
@@ -0,0 +1,292 @@
+package domain
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	TaskRecurrenceDaily   = "DAILY"
+	TaskRecurrenceWeekly  = "WEEKLY"
+	TaskRecurrenceMonthly = "MONTHLY"
+)
+
+// Scopes of a change made to an occurrence of a recurring task
+const (
+	// TaskRecurrenceScopeThis changes only this occurrence, which leaves the series
+	TaskRecurrenceScopeThis = "THIS"
+	// TaskRecurrenceScopeFollowing changes this occurrence and the ones created after it
+	TaskRecurrenceScopeFollowing = "FOLLOWING"
+)
+
+// TaskRecurrenceNone is the rule given to end the recurrence of a task
+const TaskRecurrenceNone = "NONE"
+
+// taskRecurrenceSearchDays limits how far the next occurrence is searched for
+const taskRecurrenceSearchDays = 3660
+
+var taskRecurrenceWeekdays = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+// TaskRecurrence is the subset of the RFC 5545 recurrence rule supported by the tasks:
+// FREQ (DAILY, WEEKLY or MONTHLY), INTERVAL, BYDAY, and UNTIL or COUNT.
+// The rule starts from the due date of the task, and COUNT includes the task itself.
+type TaskRecurrence struct {
+	Frequency string
+	Interval  int
+	ByDay     []TaskRecurrenceDay
+	// Until is kept as written in the rule, either a date (20060102) or a UTC time (20060102T150405Z)
+	Until string
+	Count int
+}
+
+// TaskRecurrenceDay is a BYDAY value. Ordinal is only used by the monthly rules,
+// where 2TU is the second tuesday and -1FR the last friday of the month.
+type TaskRecurrenceDay struct {
+	Ordinal int
+	Weekday time.Weekday
+}
+
+// ParseTaskRecurrence parses a rule such as FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TH;COUNT=10
+func ParseTaskRecurrence(rule string) (TaskRecurrence, error) {
+	invalid := TaskError{TaskErrorInvalidRecurrenceCode}
+
+	recurrence := TaskRecurrence{Interval: 1}
+
+	rule = strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(rule)), "RRULE:")
+	if rule == "" {
+		return TaskRecurrence{}, invalid
+	}
+
+	for _, part := range strings.Split(rule, ";") {
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 || kv[1] == "" {
+			return TaskRecurrence{}, invalid
+		}
+
+		switch kv[0] {
+		case "FREQ":
+			if kv[1] != TaskRecurrenceDaily && kv[1] != TaskRecurrenceWeekly && kv[1] != TaskRecurrenceMonthly {
+				return TaskRecurrence{}, invalid
+			}
+			recurrence.Frequency = kv[1]
+
+		case "INTERVAL":
+			interval, err := strconv.Atoi(kv[1])
+			if err != nil || interval <= 0 {
+				return TaskRecurrence{}, invalid
+			}
+			recurrence.Interval = interval
+
+		case "COUNT":
+			count, err := strconv.Atoi(kv[1])
+			if err != nil || count <= 0 {
+				return TaskRecurrence{}, invalid
+			}
+			recurrence.Count = count
+
+		case "UNTIL":
+			_, err := time.Parse("20060102", kv[1])
+			if err != nil {
+				_, err = time.Parse("20060102T150405Z", kv[1])
+			}
+			if err != nil {
+				return TaskRecurrence{}, invalid
+			}
+			recurrence.Until = kv[1]
+
+		case "BYDAY":
+			for _, v := range strings.Split(kv[1], ",") {
+				if len(v) < 2 {
+					return TaskRecurrence{}, invalid
+				}
+
+				weekday, ok := taskRecurrenceWeekdays[v[len(v)-2:]]
+				if !ok {
+					return TaskRecurrence{}, invalid
+				}
+
+				ordinal := 0
+				if len(v) > 2 {
+					n, err := strconv.Atoi(v[:len(v)-2])
+					if err != nil || n == 0 || n < -5 || n > 5 {
+						return TaskRecurrence{}, invalid
+					}
+					ordinal = n
+				}
+
+				recurrence.ByDay = append(recurrence.ByDay, TaskRecurrenceDay{Ordinal: ordinal, Weekday: weekday})
+			}
+
+		default:
+			return TaskRecurrence{}, invalid
+		}
+	}
+
+	if recurrence.Frequency == "" {
+		return TaskRecurrence{}, invalid
+	}
+
+	if recurrence.Count > 0 && recurrence.Until != "" {
+		return TaskRecurrence{}, invalid
+	}
+
+	for _, v := range recurrence.ByDay {
+		if recurrence.Frequency == TaskRecurrenceDaily || (recurrence.Frequency == TaskRecurrenceWeekly && v.Ordinal != 0) {
+			return TaskRecurrence{}, invalid
+		}
+	}
+
+	return recurrence, nil
+}
+
+// String writes the rule back in the RFC 5545 format
+func (r TaskRecurrence) String() string {
+	parts := []string{"FREQ=" + r.Frequency}
+
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+
+	if len(r.ByDay) > 0 {
+		days := []string{}
+		for _, v := range r.ByDay {
+			day := strings.ToUpper(v.Weekday.String()[:2])
+			if v.Ordinal != 0 {
+				day = strconv.Itoa(v.Ordinal) + day
+			}
+
+			days = append(days, day)
+		}
+
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+
+	if r.Until != "" {
+		parts = append(parts, "UNTIL="+r.Until)
+	}
+
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+
+	return strings.Join(parts, ";")
+}
+
+// Next returns the first occurrence of the rule starting at start which is also after the given date,
+// with the rule of the occurrences left from it. It returns false when the rule has ended.
+// The occurrences skipped because they are not after the date are counted as done.
+func (r TaskRecurrence) Next(start, after time.Time) (time.Time, TaskRecurrence, bool) {
+	occurrences := 0
+
+	for i := 1; i <= taskRecurrenceSearchDays; i++ {
+		date := start.AddDate(0, 0, i)
+
+		if !r.matches(start, date, i) {
+			continue
+		}
+
+		if r.isAfterUntil(date) {
+			return time.Time{}, TaskRecurrence{}, false
+		}
+
+		occurrences++
+
+		if r.Count > 0 && occurrences >= r.Count {
+			return time.Time{}, TaskRecurrence{}, false
+		}
+
+		if date.After(after) {
+			next := r
+			if r.Count > 0 {
+				next.Count = r.Count - occurrences
+			}
+
+			return date, next, true
+		}
+	}
+
+	return time.Time{}, TaskRecurrence{}, false
+}
+
+// matches checks the date, which is the day-th day after start
+func (r TaskRecurrence) matches(start, date time.Time, day int) bool {
+	switch r.Frequency {
+	case TaskRecurrenceDaily:
+		return day%r.Interval == 0
+
+	case TaskRecurrenceWeekly:
+		// The weeks start on monday
+		mondayOffset := (int(start.Weekday()) + 6) % 7
+		if ((day+mondayOffset)/7)%r.Interval != 0 {
+			return false
+		}
+
+		if len(r.ByDay) == 0 {
+			return date.Weekday() == start.Weekday()
+		}
+
+		return r.matchesByDay(date)
+
+	case TaskRecurrenceMonthly:
+		months := (date.Year()-start.Year())*12 + int(date.Month()) - int(start.Month())
+		if months%r.Interval != 0 {
+			return false
+		}
+
+		if len(r.ByDay) == 0 {
+			return date.Day() == start.Day()
+		}
+
+		return r.matchesByDay(date)
+	}
+
+	return false
+}
+
+func (r TaskRecurrence) matchesByDay(date time.Time) bool {
+	daysInMonth := time.Date(date.Year(), date.Month()+1, 0, 0, 0, 0, 0, date.Location()).Day()
+
+	for _, v := range r.ByDay {
+		if v.Weekday != date.Weekday() {
+			continue
+		}
+
+		switch {
+		case v.Ordinal == 0:
+			return true
+		case v.Ordinal > 0 && (date.Day()-1)/7+1 == v.Ordinal:
+			return true
+		case v.Ordinal < 0 && (daysInMonth-date.Day())/7+1 == -v.Ordinal:
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r TaskRecurrence) isAfterUntil(date time.Time) bool {
+	if r.Until == "" {
+		return false
+	}
+
+	// A date only UNTIL includes the whole day, in the time zone of the due date
+	if len(r.Until) == len("20060102") {
+		return date.Format("20060102") > r.Until
+	}
+
+	until, err := time.Parse("20060102T150405Z", r.Until)
+	if err != nil {
+		return false
+	}
+
+	return date.After(until)
+}
@@ -0,0 +1,141 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTaskRecurrence(t *testing.T) {
+	var tests = []struct {
+		rule     string
+		expected string
+		err      error
+	}{
+		{"FREQ=DAILY", "FREQ=DAILY", nil},
+		{"RRULE:freq=weekly;interval=2;byday=MO,TH;count=10", "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TH;COUNT=10", nil},
+		{"FREQ=MONTHLY;BYDAY=-1FR;UNTIL=20181231", "FREQ=MONTHLY;BYDAY=-1FR;UNTIL=20181231", nil},
+		{"", "", TaskError{TaskErrorInvalidRecurrenceCode}},
+		{"FREQ=YEARLY", "", TaskError{TaskErrorInvalidRecurrenceCode}},
+		{"FREQ=DAILY;INTERVAL=0", "", TaskError{TaskErrorInvalidRecurrenceCode}},
+		{"FREQ=DAILY;BYDAY=MO", "", TaskError{TaskErrorInvalidRecurrenceCode}},
+		{"FREQ=WEEKLY;BYDAY=1MO", "", TaskError{TaskErrorInvalidRecurrenceCode}},
+		{"FREQ=WEEKLY;COUNT=2;UNTIL=20181231", "", TaskError{TaskErrorInvalidRecurrenceCode}},
+		{"INTERVAL=2", "", TaskError{TaskErrorInvalidRecurrenceCode}},
+	}
+
+	for _, test := range tests {
+		recurrence, err := ParseTaskRecurrence(test.rule)
+
+		assert.Equal(t, test.err, err, test.rule)
+		if err == nil {
+			assert.Equal(t, test.expected, recurrence.String())
+		}
+	}
+}
+
+func TestTaskRecurrenceNext(t *testing.T) {
+	// Monday
+	start := time.Date(2018, time.October, 1, 8, 0, 0, 0, time.UTC)
+
+	var tests = []struct {
+		rule     string
+		after    time.Time
+		expected time.Time
+		left     string
+	}{
+		{"FREQ=DAILY;INTERVAL=3", start, time.Date(2018, time.October, 4, 8, 0, 0, 0, time.UTC), "FREQ=DAILY;INTERVAL=3"},
+		{"FREQ=WEEKLY;BYDAY=MO,TH;COUNT=4", start, time.Date(2018, time.October, 4, 8, 0, 0, 0, time.UTC), "FREQ=WEEKLY;BYDAY=MO,TH;COUNT=3"},
+		{"FREQ=WEEKLY;INTERVAL=2;BYDAY=MO", start, time.Date(2018, time.October, 15, 8, 0, 0, 0, time.UTC), "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO"},
+		{"FREQ=MONTHLY", start, time.Date(2018, time.November, 1, 8, 0, 0, 0, time.UTC), "FREQ=MONTHLY"},
+		{"FREQ=MONTHLY;BYDAY=-1FR", start, time.Date(2018, time.October, 26, 8, 0, 0, 0, time.UTC), "FREQ=MONTHLY;BYDAY=-1FR"},
+		// The occurrences missed before the date are counted as done
+		{"FREQ=DAILY;COUNT=10", start.AddDate(0, 0, 3), time.Date(2018, time.October, 5, 8, 0, 0, 0, time.UTC), "FREQ=DAILY;COUNT=6"},
+	}
+
+	for _, test := range tests {
+		recurrence, _ := ParseTaskRecurrence(test.rule)
+
+		next, left, ok := recurrence.Next(start, test.after)
+
+		assert.True(t, ok, test.rule)
+		assert.Equal(t, test.expected, next, test.rule)
+		assert.Equal(t, test.left, left.String(), test.rule)
+	}
+
+	// The rules which have ended
+	for _, rule := range []string{"FREQ=DAILY;COUNT=1", "FREQ=WEEKLY;UNTIL=20181007"} {
+		recurrence, _ := ParseTaskRecurrence(rule)
+
+		_, _, ok := recurrence.Next(start, start)
+
+		assert.False(t, ok, rule)
+	}
+}
+
+func TestCreateNextOccurrence(t *testing.T) {
+	// Given
+	taskServiceMock := new(TaskServiceMock)
+
+	dueDate := time.Now().Add(time.Hour)
+	task, _ := CreateTask(taskServiceMock, "Clean the reservoir", "Weekly cleaning", &dueDate, "NORMAL", TaskDomainGeneral{}, "SANITATION", nil)
+
+	// When
+	_, err := task.ChangeTaskRecurrence(taskServiceMock, "FREQ=WEEKLY;COUNT=2")
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, "FREQ=WEEKLY;COUNT=2", task.RecurrenceRule)
+	assert.Equal(t, task.UID, *task.SeriesID)
+
+	// When
+	next, err := task.CreateNextOccurrence(taskServiceMock)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, dueDate.AddDate(0, 0, 7), *next.DueDate)
+	assert.Equal(t, "FREQ=WEEKLY;COUNT=1", next.RecurrenceRule)
+	assert.Equal(t, task.SeriesID, next.SeriesID)
+	assert.Equal(t, task.Title, next.Title)
+	assert.Equal(t, task.DomainDetails, next.DomainDetails)
+
+	// When
+	err = task.CompleteTask(taskServiceMock)
+	assert.Nil(t, err)
+
+	err = task.CompleteTask(taskServiceMock)
+
+	// Then
+	assert.Equal(t, TaskError{TaskErrorTaskClosedCode}, err)
+
+	// When
+	err = task.CancelTask(taskServiceMock)
+
+	// Then
+	assert.Equal(t, TaskError{TaskErrorTaskClosedCode}, err)
+
+	// When
+	err = next.CancelTask(taskServiceMock)
+	assert.Nil(t, err)
+
+	err = next.CancelTask(taskServiceMock)
+
+	// Then
+	assert.Equal(t, TaskError{TaskErrorTaskClosedCode}, err)
+
+	// When
+	last, err := next.CreateNextOccurrence(taskServiceMock)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Nil(t, last)
+
+	// When
+	noDueDate, _ := CreateTask(taskServiceMock, "Clean the reservoir", "Weekly cleaning", nil, "NORMAL", TaskDomainGeneral{}, "SANITATION", nil)
+	_, err = noDueDate.ChangeTaskRecurrence(taskServiceMock, "FREQ=WEEKLY")
+
+	// Then
+	assert.Equal(t, TaskError{TaskErrorRecurrenceDueDateEmptyCode}, err)
+
+}
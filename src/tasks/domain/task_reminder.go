@@ -0,0 +1,90 @@
+package domain
+
+import "time"
+
+// The reminders sent for a task with a due date
+const (
+	TaskReminderDueSoon = "DUE_SOON"
+	TaskReminderOverdue = "OVERDUE"
+)
+
+// TaskReminderRule tells which tasks a user is reminded of, and when.
+// Empty categories or priorities match all of them.
+type TaskReminderRule struct {
+	Categories    []string      `json:"categories"`
+	Priorities    []string      `json:"priorities"`
+	RemindBefore  time.Duration `json:"remind_before"`
+	RemindOverdue bool          `json:"remind_overdue"`
+}
+
+func CreateTaskReminderRule(categories, priorities []string, remindBefore time.Duration, remindOverdue bool) (TaskReminderRule, error) {
+	for _, v := range categories {
+		_, err := FindTaskCategoryByCode(v)
+		if err != nil {
+			return TaskReminderRule{}, err
+		}
+	}
+
+	for _, v := range priorities {
+		_, err := FindTaskPriorityByCode(v)
+		if err != nil {
+			return TaskReminderRule{}, err
+		}
+	}
+
+	if remindBefore < 0 {
+		return TaskReminderRule{}, TaskError{TaskErrorInvalidRemindBeforeCode}
+	}
+
+	if categories == nil {
+		categories = []string{}
+	}
+
+	if priorities == nil {
+		priorities = []string{}
+	}
+
+	return TaskReminderRule{
+		Categories:    categories,
+		Priorities:    priorities,
+		RemindBefore:  remindBefore,
+		RemindOverdue: remindOverdue,
+	}, nil
+}
+
+// Matches checks whether the rule covers the tasks of the category and the priority
+func (r TaskReminderRule) Matches(category, priority string) bool {
+	return matchesCode(r.Categories, category) && matchesCode(r.Priorities, priority)
+}
+
+// ReminderKind returns the reminder due at now for a task due at dueDate,
+// or an empty string when there is none. A RemindBefore of zero only sends the overdue reminder.
+func (r TaskReminderRule) ReminderKind(dueDate, now time.Time) string {
+	if !now.Before(dueDate) {
+		if r.RemindOverdue {
+			return TaskReminderOverdue
+		}
+
+		return ""
+	}
+
+	if r.RemindBefore > 0 && !now.Before(dueDate.Add(-r.RemindBefore)) {
+		return TaskReminderDueSoon
+	}
+
+	return ""
+}
+
+func matchesCode(codes []string, code string) bool {
+	if len(codes) == 0 {
+		return true
+	}
+
+	for _, v := range codes {
+		if v == code {
+			return true
+		}
+	}
+
+	return false
+}
@@ -13,6 +13,10 @@ type TaskServiceMock struct {
 	mock.Mock
 }
 
+func (m TaskServiceMock) FindFarmByID(uid uuid.UUID) ServiceResult {
+	args := m.Called(uid)
+	return args.Get(0).(ServiceResult)
+}
 func (m TaskServiceMock) FindAreaByID(uid uuid.UUID) ServiceResult {
 	args := m.Called(uid)
 	return args.Get(0).(ServiceResult)
@@ -29,6 +33,14 @@ func (m TaskServiceMock) FindReservoirByID(uid uuid.UUID) ServiceResult {
 	args := m.Called(uid)
 	return args.Get(0).(ServiceResult)
 }
+func (m TaskServiceMock) FindUserByID(uid uuid.UUID) ServiceResult {
+	args := m.Called(uid)
+	return args.Get(0).(ServiceResult)
+}
+func (m TaskServiceMock) FindTaskByID(uid uuid.UUID) ServiceResult {
+	args := m.Called(uid)
+	return args.Get(0).(ServiceResult)
+}
 
 func TestCreateTask(t *testing.T) {
 	taskServiceMock := new(TaskServiceMock)
@@ -112,3 +124,476 @@ func TestCreateTask(t *testing.T) {
 
 	assert.Equal(t, TaskError{TaskErrorInvalidAssetIDCode}, err)
 }
+
+func TestAssignTask(t *testing.T) {
+	// Given
+	taskServiceMock := new(TaskServiceMock)
+
+	userID, _ := uuid.NewV4()
+	taskServiceMock.On("FindUserByID", userID).Return(ServiceResult{Result: query.TaskUserQueryResult{UID: userID, Username: "tania"}})
+
+	unknownUserID, _ := uuid.NewV4()
+	taskServiceMock.On("FindUserByID", unknownUserID).Return(ServiceResult{Error: TaskError{TaskErrorInvalidAssigneeCode}})
+
+	task, _ := CreateTask(taskServiceMock, "Clean the reservoir", "Weekly cleaning", nil, "NORMAL", TaskDomainGeneral{}, "SANITATION", nil)
+
+	// When
+	_, err := task.AssignTask(taskServiceMock, &userID)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, userID, *task.AssigneeID)
+
+	// When
+	_, err = task.AssignTask(taskServiceMock, &unknownUserID)
+
+	// Then
+	assert.Equal(t, TaskError{TaskErrorInvalidAssigneeCode}, err)
+	assert.Equal(t, userID, *task.AssigneeID)
+
+	// When
+	_, err = task.AssignTask(taskServiceMock, nil)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Nil(t, task.AssigneeID)
+	assert.Len(t, task.UncommittedChanges, 3)
+}
+
+func TestTaskChecklist(t *testing.T) {
+	// Given
+	taskServiceMock := new(TaskServiceMock)
+
+	task, _ := CreateTask(taskServiceMock, "Prepare the seedling trays", "Before seeding", nil, "NORMAL", TaskDomainGeneral{}, "SANITATION", nil)
+
+	// When
+	_, err := task.AddChecklistItem(taskServiceMock, "")
+
+	// Then
+	assert.Equal(t, TaskError{TaskErrorChecklistItemTitleEmptyCode}, err)
+
+	// When
+	_, err = task.AddChecklistItem(taskServiceMock, "Wash the trays")
+
+	// Then
+	assert.Nil(t, err)
+	assert.Len(t, task.Checklist, 1)
+	assert.False(t, task.Checklist[0].IsCompleted)
+
+	itemUID := task.Checklist[0].UID
+
+	// When
+	_, err = task.CompleteChecklistItem(taskServiceMock, itemUID)
+
+	// Then
+	assert.Nil(t, err)
+	assert.True(t, task.Checklist[0].IsCompleted)
+	assert.NotNil(t, task.Checklist[0].CompletedDate)
+
+	// When
+	_, err = task.CompleteChecklistItem(taskServiceMock, itemUID)
+
+	// Then
+	assert.Equal(t, TaskError{TaskErrorChecklistItemCompletedCode}, err)
+
+	// When
+	_, err = task.RemoveChecklistItem(taskServiceMock, itemUID)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Len(t, task.Checklist, 0)
+
+	// When
+	_, err = task.RemoveChecklistItem(taskServiceMock, itemUID)
+
+	// Then
+	assert.Equal(t, TaskError{TaskErrorChecklistItemNotFoundCode}, err)
+}
+
+func TestTaskBlocker(t *testing.T) {
+	// Given
+	taskServiceMock := new(TaskServiceMock)
+
+	task, _ := CreateTask(taskServiceMock, "Transplant the seedlings", "To the greenhouse", nil, "NORMAL", TaskDomainGeneral{}, "SAFETY", nil)
+
+	blockerID, _ := uuid.NewV4()
+	taskServiceMock.On("FindTaskByID", blockerID).Return(ServiceResult{Result: query.TaskDependencyQueryResult{UID: blockerID, Status: TaskStatusCreated}})
+
+	// The other task already waits for this one through the blocker
+	otherID, _ := uuid.NewV4()
+	taskServiceMock.On("FindTaskByID", otherID).Return(ServiceResult{Result: query.TaskDependencyQueryResult{UID: otherID, Status: TaskStatusCreated, BlockedBy: []uuid.UUID{task.UID}}})
+
+	cyclicID, _ := uuid.NewV4()
+	taskServiceMock.On("FindTaskByID", cyclicID).Return(ServiceResult{Result: query.TaskDependencyQueryResult{UID: cyclicID, Status: TaskStatusCreated, BlockedBy: []uuid.UUID{otherID}}})
+
+	// When
+	_, err := task.AddBlocker(taskServiceMock, task.UID)
+
+	// Then
+	assert.Equal(t, TaskError{TaskErrorBlockerCycleCode}, err)
+
+	// When
+	_, err = task.AddBlocker(taskServiceMock, cyclicID)
+
+	// Then
+	assert.Equal(t, TaskError{TaskErrorBlockerCycleCode}, err)
+	assert.Len(t, task.BlockedBy, 0)
+
+	// When
+	_, err = task.AddBlocker(taskServiceMock, blockerID)
+
+	// Then
+	assert.Nil(t, err)
+	assert.True(t, task.IsBlockedBy(blockerID))
+
+	// When
+	err = task.CompleteTask(taskServiceMock)
+
+	// Then
+	assert.Equal(t, TaskError{TaskErrorBlockedCode}, err)
+	assert.Equal(t, TaskStatusCreated, task.Status)
+
+	// When
+	_, err = task.RemoveBlocker(taskServiceMock, blockerID)
+
+	// Then
+	assert.Nil(t, err)
+
+	err = task.CompleteTask(taskServiceMock)
+	assert.Nil(t, err)
+	assert.Equal(t, TaskStatusCompleted, task.Status)
+}
+
+func TestTaskCommentsAndAttachments(t *testing.T) {
+	// Given
+	taskServiceMock := new(TaskServiceMock)
+
+	authorID, _ := uuid.NewV4()
+
+	task, _ := CreateTask(taskServiceMock, "Fix the pump", "The reservoir pump is leaking", nil, "URGENT", TaskDomainGeneral{}, "SAFETY", nil)
+
+	// When
+	_, err := task.AddComment(taskServiceMock, "", &authorID)
+
+	// Then
+	assert.Equal(t, TaskError{TaskErrorCommentContentEmptyCode}, err)
+
+	// When
+	_, err = task.AddComment(taskServiceMock, "Pump leaking, photo attached", &authorID)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Len(t, task.Comments, 1)
+	assert.Equal(t, "Pump leaking, photo attached", task.Comments[0].Content)
+	assert.Equal(t, authorID, *task.Comments[0].AuthorID)
+
+	// When
+	attachmentID, _ := uuid.NewV4()
+	_, err = task.AddAttachment(taskServiceMock, attachmentID, "notes.txt", "text/plain", 120, 0, 0, &authorID)
+
+	// Then
+	assert.Equal(t, TaskError{TaskErrorAttachmentInvalidMimeTypeCode}, err)
+
+	// When
+	_, err = task.AddAttachment(taskServiceMock, attachmentID, "pump.JPG", "image/jpeg", 2048, 640, 480, &authorID)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Len(t, task.Attachments, 1)
+	assert.True(t, task.Attachments[0].IsImage())
+	assert.Equal(t, attachmentID, task.Attachments[0].UID)
+	assert.Equal(t, attachmentID.String()+".jpg", task.Attachments[0].StoredFilename())
+
+	// When
+	secondID, _ := uuid.NewV4()
+	_, err = task.AddAttachment(taskServiceMock, secondID, "pump.JPG", "image/jpeg", 1024, 640, 480, &authorID)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Len(t, task.Attachments, 2)
+	assert.NotEqual(t, task.Attachments[0].StoredFilename(), task.Attachments[1].StoredFilename())
+
+	// When
+	_, err = task.RemoveAttachment(taskServiceMock, secondID)
+	assert.Nil(t, err)
+
+	manualID, _ := uuid.NewV4()
+	_, err = task.AddAttachment(taskServiceMock, manualID, "manual.pdf", TaskAttachmentMimeTypePDF, 4096, 0, 0, nil)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Len(t, task.Attachments, 2)
+	assert.False(t, task.Attachments[1].IsImage())
+
+	// When
+	_, err = task.RemoveAttachment(taskServiceMock, task.Attachments[0].UID)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Len(t, task.Attachments, 1)
+	assert.Equal(t, "manual.pdf", task.Attachments[0].Filename)
+
+	// When
+	unknownID, _ := uuid.NewV4()
+	_, err = task.RemoveAttachment(taskServiceMock, unknownID)
+
+	// Then
+	assert.Equal(t, TaskError{TaskErrorAttachmentNotFoundCode}, err)
+}
+
+func TestTaskTimeEntries(t *testing.T) {
+	// Given
+	taskServiceMock := new(TaskServiceMock)
+
+	workerID, _ := uuid.NewV4()
+	taskServiceMock.On("FindUserByID", workerID).Return(ServiceResult{Result: query.TaskUserQueryResult{UID: workerID, Username: "tania"}})
+
+	unknownWorkerID, _ := uuid.NewV4()
+	taskServiceMock.On("FindUserByID", unknownWorkerID).Return(ServiceResult{Error: TaskError{TaskErrorInvalidAssigneeCode}})
+
+	task, _ := CreateTask(taskServiceMock, "Weed the beds", "Weed the lettuce beds", nil, "NORMAL", TaskDomainGeneral{}, "GENERAL", nil)
+
+	// When
+	_, err := task.StartTimer(taskServiceMock, &unknownWorkerID, 10)
+
+	// Then
+	assert.Equal(t, TaskError{TaskErrorInvalidWorkerCode}, err)
+
+	// When
+	_, err = task.StartTimer(taskServiceMock, &workerID, -1)
+
+	// Then
+	assert.Equal(t, TaskError{TaskErrorInvalidHourlyRateCode}, err)
+
+	// When
+	_, err = task.StopTimer(taskServiceMock, &workerID)
+
+	// Then
+	assert.Equal(t, TaskError{TaskErrorTimerNotStartedCode}, err)
+
+	// When
+	_, err = task.StartTimer(taskServiceMock, &workerID, 12.5)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Len(t, task.TimeEntries, 1)
+	assert.True(t, task.TimeEntries[0].IsRunning())
+
+	// When
+	_, err = task.StartTimer(taskServiceMock, &workerID, 12.5)
+
+	// Then
+	assert.Equal(t, TaskError{TaskErrorTimerAlreadyStartedCode}, err)
+
+	// When
+	_, err = task.StopTimer(taskServiceMock, &workerID)
+
+	// Then
+	assert.Nil(t, err)
+	assert.False(t, task.TimeEntries[0].IsRunning())
+	assert.Equal(t, 12.5, task.TimeEntries[0].HourlyRate)
+
+	// When
+	startDate := time.Date(2018, time.March, 1, 8, 0, 0, 0, time.UTC)
+	_, err = task.LogTime(taskServiceMock, &workerID, 10, startDate, 0)
+
+	// Then
+	assert.Equal(t, TaskError{TaskErrorInvalidTimeDurationCode}, err)
+
+	// When
+	_, err = task.LogTime(taskServiceMock, &workerID, 10, startDate, 90*time.Minute)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Len(t, task.TimeEntries, 2)
+	assert.Equal(t, startDate.Add(90*time.Minute), *task.TimeEntries[1].EndDate)
+	assert.Equal(t, 1.5, task.TimeEntries[1].Duration.Hours())
+
+	// When
+	_, err = task.RemoveTimeEntry(taskServiceMock, task.TimeEntries[0].UID)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Len(t, task.TimeEntries, 1)
+	assert.Equal(t, startDate, task.TimeEntries[0].StartDate)
+
+	// When
+	unknownID, _ := uuid.NewV4()
+	_, err = task.RemoveTimeEntry(taskServiceMock, unknownID)
+
+	// Then
+	assert.Equal(t, TaskError{TaskErrorTimeEntryNotFoundCode}, err)
+}
+
+func TestTaskConsumeMaterial(t *testing.T) {
+	// Given
+	taskServiceMock := new(TaskServiceMock)
+
+	materialID, _ := uuid.NewV4()
+	taskServiceMock.On("FindMaterialByID", materialID).Return(ServiceResult{Result: query.TaskMaterialQueryResult{UID: materialID, Name: "Compost", TypeCode: "AGROCHEMICAL", Quantity: 10, QuantityUnit: "KILOGRAM"}})
+
+	areaID, _ := uuid.NewV4()
+	taskServiceMock.On("FindAreaByID", areaID).Return(ServiceResult{Result: query.TaskAreaQueryResult{UID: areaID, Name: "Bed 1"}})
+
+	general, _ := CreateTask(taskServiceMock, "Clean the tools", "Clean the pruning tools", nil, "NORMAL", TaskDomainGeneral{}, "GENERAL", nil)
+	task, _ := CreateTask(taskServiceMock, "Feed the beds", "Spread compost on the beds", nil, "NORMAL", TaskDomainArea{MaterialID: &materialID}, "NUTRIENT", &areaID)
+
+	// When
+	err := general.ConsumeMaterial(taskServiceMock, 2, false)
+
+	// Then
+	assert.Equal(t, TaskError{TaskErrorNoMaterialCode}, err)
+
+	// When
+	err = task.ConsumeMaterial(taskServiceMock, 0, false)
+
+	// Then
+	assert.Equal(t, TaskError{TaskErrorInvalidConsumedQuantityCode}, err)
+
+	// When
+	err = task.ConsumeMaterial(taskServiceMock, 12, false)
+
+	// Then
+	assert.Equal(t, TaskError{TaskErrorNotEnoughStockCode}, err)
+	assert.Nil(t, task.MaterialConsumption)
+
+	// When
+	err = task.ConsumeMaterial(taskServiceMock, 12, true)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, materialID, task.MaterialConsumption.MaterialID)
+	assert.Equal(t, "KILOGRAM", task.MaterialConsumption.QuantityUnit)
+	assert.True(t, task.MaterialConsumption.InsufficientStock)
+
+	event, ok := task.UncommittedChanges[len(task.UncommittedChanges)-1].(TaskMaterialConsumed)
+	assert.True(t, ok)
+	assert.Equal(t, float32(12), event.Quantity)
+
+	// When
+	err = task.ConsumeMaterial(taskServiceMock, 1, true)
+
+	// Then
+	assert.Equal(t, TaskError{TaskErrorMaterialConsumedCode}, err)
+}
+
+func TestTaskFinance(t *testing.T) {
+	// Given
+	taskServiceMock := new(TaskServiceMock)
+
+	farmID, _ := uuid.NewV4()
+	taskServiceMock.On("FindFarmByID", farmID).Return(ServiceResult{Result: query.TaskFarmQueryResult{UID: farmID, Name: "Green Farm"}})
+
+	// When
+	_, err := CreateTaskDomainFinance(taskServiceMock, "-5", "USD", TaskFinanceDirectionPayable, "Seed Co", nil)
+
+	// Then
+	assert.Equal(t, TaskError{TaskErrorFinanceInvalidAmountCode}, err)
+
+	for _, amount := range []string{"0", "0.00", "NaN", "Inf", "1e3", "12.345", ".5", "5.", " 5"} {
+		// When
+		_, err = CreateTaskDomainFinance(taskServiceMock, amount, "USD", TaskFinanceDirectionPayable, "Seed Co", nil)
+
+		// Then
+		assert.Equal(t, TaskError{TaskErrorFinanceInvalidAmountCode}, err, amount)
+	}
+
+	// When
+	minorUnits, err := ParseFinanceAmount("12.5")
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1250), minorUnits)
+
+	// When
+	_, err = CreateTaskDomainFinance(taskServiceMock, "5", "DOLLAR", TaskFinanceDirectionPayable, "Seed Co", nil)
+
+	// Then
+	assert.Equal(t, TaskError{TaskErrorFinanceInvalidCurrencyCode}, err)
+
+	// When
+	_, err = CreateTaskDomainFinance(taskServiceMock, "5", "USD", "OWED", "Seed Co", nil)
+
+	// Then
+	assert.Equal(t, TaskError{TaskErrorFinanceInvalidDirectionCode}, err)
+
+	// When
+	_, err = CreateTaskDomainFinance(taskServiceMock, "5", "USD", TaskFinanceDirectionPayable, " ", nil)
+
+	// Then
+	assert.Equal(t, TaskError{TaskErrorFinanceCounterpartyEmptyCode}, err)
+
+	// When
+	details, err := CreateTaskDomainFinance(taskServiceMock, "12.50", "usd", TaskFinanceDirectionPayable, "Seed Co", nil)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, "USD", details.CurrencyCode)
+
+	// When
+	_, err = CreateTask(taskServiceMock, "Pay the seeds", "Pay the seed invoice", nil, "NORMAL", details, "FINANCE", nil)
+
+	// Then
+	assert.Equal(t, TaskError{TaskErrorAssetIDEmptyCode}, err)
+
+	// When
+	task, err := CreateTask(taskServiceMock, "Pay the seeds", "Pay the seed invoice", nil, "NORMAL", details, "FINANCE", &farmID)
+	assert.Nil(t, err)
+
+	err = task.CompleteTask(taskServiceMock)
+
+	// Then
+	assert.Nil(t, err)
+
+	event, ok := task.UncommittedChanges[len(task.UncommittedChanges)-1].(TaskFinancePosted)
+	assert.True(t, ok)
+	assert.Equal(t, farmID, event.FarmID)
+	assert.Equal(t, "12.50", event.Amount)
+	assert.Equal(t, TaskFinanceDirectionPayable, event.Direction)
+
+	// When
+	changes := len(task.UncommittedChanges)
+	err = task.CompleteTask(taskServiceMock)
+	cancelErr := task.CancelTask(taskServiceMock)
+
+	// Then
+	assert.Equal(t, TaskError{TaskErrorTaskClosedCode}, err)
+	assert.Equal(t, TaskError{TaskErrorTaskClosedCode}, cancelErr)
+	assert.Len(t, task.UncommittedChanges, changes)
+}
+
+func TestTaskReminderRule(t *testing.T) {
+	// When
+	_, err := CreateTaskReminderRule([]string{"HARVEST"}, nil, time.Hour, false)
+
+	// Then
+	assert.Equal(t, TaskError{TaskErrorInvalidCategoryCode}, err)
+
+	// When
+	_, err = CreateTaskReminderRule(nil, nil, -time.Hour, false)
+
+	// Then
+	assert.Equal(t, TaskError{TaskErrorInvalidRemindBeforeCode}, err)
+
+	// When
+	rule, err := CreateTaskReminderRule([]string{TaskCategoryCrop}, []string{TaskPriorityUrgent}, 24*time.Hour, true)
+
+	// Then
+	assert.Nil(t, err)
+	assert.True(t, rule.Matches(TaskCategoryCrop, TaskPriorityUrgent))
+	assert.False(t, rule.Matches(TaskCategoryCrop, TaskPriorityNormal))
+	assert.False(t, rule.Matches(TaskCategoryGeneral, TaskPriorityUrgent))
+
+	dueDate := time.Now()
+	assert.Equal(t, "", rule.ReminderKind(dueDate, dueDate.Add(-48*time.Hour)))
+	assert.Equal(t, TaskReminderDueSoon, rule.ReminderKind(dueDate, dueDate.Add(-2*time.Hour)))
+	assert.Equal(t, TaskReminderOverdue, rule.ReminderKind(dueDate, dueDate.Add(time.Minute)))
+
+	// When
+	rule, _ = CreateTaskReminderRule(nil, nil, 0, false)
+
+	// Then
+	assert.True(t, rule.Matches(TaskCategoryGeneral, TaskPriorityNormal))
+	assert.Equal(t, "", rule.ReminderKind(dueDate, dueDate.Add(-time.Minute)))
+	assert.Equal(t, "", rule.ReminderKind(dueDate, dueDate.Add(time.Minute)))
+}
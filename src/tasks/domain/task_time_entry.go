@@ -0,0 +1,164 @@
+package domain
+
+import (
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+// TaskTimeEntry is the time a worker spent on a task.
+// A running timer has no EndDate yet, and its Duration is only known when it is stopped.
+type TaskTimeEntry struct {
+	UID      uuid.UUID  `json:"uid"`
+	WorkerID *uuid.UUID `json:"worker_id"`
+	// HourlyRate is the cost of an hour of the worker, zero when it is not tracked
+	HourlyRate float64       `json:"hourly_rate"`
+	StartDate  time.Time     `json:"start_date"`
+	EndDate    *time.Time    `json:"end_date"`
+	Duration   time.Duration `json:"duration"`
+}
+
+// IsRunning checks whether the entry is a timer which is not stopped yet
+func (e TaskTimeEntry) IsRunning() bool {
+	return e.EndDate == nil
+}
+
+// TimeEntry returns the time entry with the UID, or nil when it is not in the task
+func (t *Task) TimeEntry(uid uuid.UUID) *TaskTimeEntry {
+	for i, v := range t.TimeEntries {
+		if v.UID == uid {
+			return &t.TimeEntries[i]
+		}
+	}
+
+	return nil
+}
+
+// RunningTimer returns the timer of the worker which is not stopped yet, or nil
+func (t *Task) RunningTimer(workerID *uuid.UUID) *TaskTimeEntry {
+	for i, v := range t.TimeEntries {
+		if v.IsRunning() && isSameWorker(v.WorkerID, workerID) {
+			return &t.TimeEntries[i]
+		}
+	}
+
+	return nil
+}
+
+// StartTimer starts counting the time of the worker on the task. A worker has one running timer per task.
+func (t *Task) StartTimer(taskService TaskService, workerID *uuid.UUID, hourlyRate float64) (*Task, error) {
+	err := validateTimeEntryWorker(taskService, workerID, hourlyRate)
+	if err != nil {
+		return &Task{}, err
+	}
+
+	if t.RunningTimer(workerID) != nil {
+		return &Task{}, TaskError{TaskErrorTimerAlreadyStartedCode}
+	}
+
+	uid, err := uuid.NewV4()
+	if err != nil {
+		return &Task{}, err
+	}
+
+	t.TrackChange(taskService, TaskTimerStarted{
+		UID:        t.UID,
+		EntryUID:   uid,
+		WorkerID:   workerID,
+		HourlyRate: hourlyRate,
+		StartDate:  time.Now(),
+	})
+
+	return t, nil
+}
+
+// StopTimer stops the running timer of the worker
+func (t *Task) StopTimer(taskService TaskService, workerID *uuid.UUID) (*Task, error) {
+	timer := t.RunningTimer(workerID)
+	if timer == nil {
+		return &Task{}, TaskError{TaskErrorTimerNotStartedCode}
+	}
+
+	endDate := time.Now()
+
+	t.TrackChange(taskService, TaskTimerStopped{
+		UID:      t.UID,
+		EntryUID: timer.UID,
+		EndDate:  endDate,
+		Duration: endDate.Sub(timer.StartDate),
+	})
+
+	return t, nil
+}
+
+// LogTime adds the time the worker spent on the task, starting at startDate
+func (t *Task) LogTime(taskService TaskService, workerID *uuid.UUID, hourlyRate float64, startDate time.Time, duration time.Duration) (*Task, error) {
+	err := validateTimeEntryWorker(taskService, workerID, hourlyRate)
+	if err != nil {
+		return &Task{}, err
+	}
+
+	if duration <= 0 {
+		return &Task{}, TaskError{TaskErrorInvalidTimeDurationCode}
+	}
+
+	uid, err := uuid.NewV4()
+	if err != nil {
+		return &Task{}, err
+	}
+
+	t.TrackChange(taskService, TaskTimeLogged{
+		UID:        t.UID,
+		EntryUID:   uid,
+		WorkerID:   workerID,
+		HourlyRate: hourlyRate,
+		StartDate:  startDate,
+		EndDate:    startDate.Add(duration),
+		Duration:   duration,
+	})
+
+	return t, nil
+}
+
+func (t *Task) RemoveTimeEntry(taskService TaskService, entryUID uuid.UUID) (*Task, error) {
+	if t.TimeEntry(entryUID) == nil {
+		return &Task{}, TaskError{TaskErrorTimeEntryNotFoundCode}
+	}
+
+	t.TrackChange(taskService, TaskTimeEntryRemoved{
+		UID:      t.UID,
+		EntryUID: entryUID,
+	})
+
+	return t, nil
+}
+
+// validateTimeEntryWorker checks the worker, which can be nil when there is no signed in user
+func validateTimeEntryWorker(taskService TaskService, workerID *uuid.UUID, hourlyRate float64) error {
+	if hourlyRate < 0 {
+		return TaskError{TaskErrorInvalidHourlyRateCode}
+	}
+
+	if workerID == nil {
+		return nil
+	}
+
+	serviceResult := taskService.FindUserByID(*workerID)
+	if serviceResult.Error != nil {
+		if _, ok := serviceResult.Error.(TaskError); ok {
+			return TaskError{TaskErrorInvalidWorkerCode}
+		}
+
+		return serviceResult.Error
+	}
+
+	return nil
+}
+
+func isSameWorker(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
@@ -26,6 +26,7 @@ func (s AreaQueryInMemory) FindByID(uid uuid.UUID) <-chan query.QueryResult {
 			if val.UID == uid {
 				area.UID = uid
 				area.Name = val.Name
+				area.FarmID = val.Farm.UID
 			}
 		}
 
@@ -27,6 +27,7 @@ func (s CropQueryInMemory) FindCropByID(uid uuid.UUID) <-chan query.QueryResult
 			if val.UID == uid {
 				crop.UID = uid
 				crop.BatchID = val.BatchID
+				crop.FarmID = val.FarmUID
 			}
 		}
 		result <- query.QueryResult{Result: crop}
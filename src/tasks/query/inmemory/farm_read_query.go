@@ -0,0 +1,36 @@
+package inmemory
+
+import (
+	"github.com/Tanibox/tania-core/src/assets/storage"
+	"github.com/Tanibox/tania-core/src/tasks/query"
+	uuid "github.com/satori/go.uuid"
+)
+
+type FarmQueryInMemory struct {
+	Storage *storage.FarmReadStorage
+}
+
+func NewFarmQueryInMemory(s *storage.FarmReadStorage) query.FarmQuery {
+	return FarmQueryInMemory{Storage: s}
+}
+
+func (s FarmQueryInMemory) FindFarmByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		s.Storage.Lock.RLock()
+		defer s.Storage.Lock.RUnlock()
+
+		farm := query.TaskFarmQueryResult{}
+		if val, ok := s.Storage.FarmReadMap[uid]; ok {
+			farm.UID = val.UID
+			farm.Name = val.Name
+		}
+
+		result <- query.QueryResult{Result: farm}
+
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,53 @@
+package inmemory
+
+import (
+	"sort"
+
+	"github.com/Tanibox/tania-core/src/tasks/query"
+	"github.com/Tanibox/tania-core/src/tasks/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type FinanceLedgerQueryInMemory struct {
+	Storage *storage.FinanceLedgerStorage
+}
+
+func NewFinanceLedgerQueryInMemory(s *storage.FinanceLedgerStorage) query.FinanceLedgerQuery {
+	return FinanceLedgerQueryInMemory{Storage: s}
+}
+
+func (s FinanceLedgerQueryInMemory) FindAllByFarmID(farmUID uuid.UUID, filter query.FinanceLedgerFilter) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		s.Storage.Lock.RLock()
+		defer s.Storage.Lock.RUnlock()
+
+		entries := []storage.FinanceLedgerEntry{}
+		for _, val := range s.Storage.FinanceLedgerEntryMap {
+			if val.FarmUID != farmUID {
+				continue
+			}
+
+			if filter.StartDate != nil && val.PostedDate.Before(*filter.StartDate) {
+				continue
+			}
+
+			if filter.EndDate != nil && !val.PostedDate.Before(*filter.EndDate) {
+				continue
+			}
+
+			entries = append(entries, val)
+		}
+
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].PostedDate.Before(entries[j].PostedDate)
+		})
+
+		result <- query.QueryResult{Result: entries}
+
+		close(result)
+	}()
+
+	return result
+}
@@ -29,6 +29,8 @@ func (s MaterialQueryInMemory) FindMaterialByID(inventoryUID uuid.UUID) <-chan q
 				ci.UID = val.UID
 				ci.Name = val.Name
 				ci.TypeCode = val.Type.Code()
+				ci.Quantity = val.Quantity.Value
+				ci.QuantityUnit = val.Quantity.Unit.Code
 
 				switch v := val.Type.(type) {
 				case assetsdomain.MaterialTypeSeed:
@@ -28,6 +28,7 @@ func (s ReservoirQueryInMemory) FindReservoirByID(reservoirUID uuid.UUID) <-chan
 			if val.UID == reservoirUID {
 				ci.UID = val.UID
 				ci.Name = val.Name
+				ci.FarmID = val.Farm.UID
 			}
 		}
 
@@ -0,0 +1,53 @@
+package inmemory
+
+import (
+	"github.com/Tanibox/tania-core/src/tasks/query"
+	"github.com/Tanibox/tania-core/src/tasks/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type SuccessionPlanTaskQueryInMemory struct {
+	Storage *storage.SuccessionPlanTaskStorage
+}
+
+func NewSuccessionPlanTaskQueryInMemory(s *storage.SuccessionPlanTaskStorage) query.SuccessionPlanTaskQuery {
+	return SuccessionPlanTaskQueryInMemory{Storage: s}
+}
+
+// FindByPlannedBatchID returns an empty link when the planned crop batch has no task
+func (s SuccessionPlanTaskQueryInMemory) FindByPlannedBatchID(plannedBatchUID uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		s.Storage.Lock.RLock()
+		defer s.Storage.Lock.RUnlock()
+
+		result <- query.QueryResult{Result: s.Storage.SuccessionPlanTaskMap[plannedBatchUID]}
+
+		close(result)
+	}()
+
+	return result
+}
+
+func (s SuccessionPlanTaskQueryInMemory) FindAllBySuccessionPlanID(successionPlanUID uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		s.Storage.Lock.RLock()
+		defer s.Storage.Lock.RUnlock()
+
+		successionPlanTasks := []storage.SuccessionPlanTask{}
+		for _, v := range s.Storage.SuccessionPlanTaskMap {
+			if v.SuccessionPlanUID == successionPlanUID {
+				successionPlanTasks = append(successionPlanTasks, v)
+			}
+		}
+
+		result <- query.QueryResult{Result: successionPlanTasks}
+
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,52 @@
+package inmemory
+
+import (
+	"github.com/Tanibox/tania-core/src/tasks/query"
+	"github.com/Tanibox/tania-core/src/tasks/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type TaskCalendarFeedQueryInMemory struct {
+	Storage *storage.TaskCalendarFeedStorage
+}
+
+func NewTaskCalendarFeedQueryInMemory(s *storage.TaskCalendarFeedStorage) query.TaskCalendarFeedQuery {
+	return TaskCalendarFeedQueryInMemory{Storage: s}
+}
+
+func (s TaskCalendarFeedQueryInMemory) FindByUserID(userUID uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		s.Storage.Lock.RLock()
+		defer s.Storage.Lock.RUnlock()
+
+		result <- query.QueryResult{Result: s.Storage.TaskCalendarFeedMap[userUID]}
+
+		close(result)
+	}()
+
+	return result
+}
+
+func (s TaskCalendarFeedQueryInMemory) FindByToken(token string) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		s.Storage.Lock.RLock()
+		defer s.Storage.Lock.RUnlock()
+
+		feed := storage.TaskCalendarFeed{}
+		for _, val := range s.Storage.TaskCalendarFeedMap {
+			if val.Token == token {
+				feed = val
+			}
+		}
+
+		result <- query.QueryResult{Result: feed}
+
+		close(result)
+	}()
+
+	return result
+}
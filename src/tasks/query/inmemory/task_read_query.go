@@ -1,6 +1,7 @@
 package inmemory
 
 import (
+	"github.com/Tanibox/tania-core/src/tasks/domain"
 	"github.com/Tanibox/tania-core/src/tasks/query"
 	"github.com/Tanibox/tania-core/src/tasks/storage"
 	uuid "github.com/satori/go.uuid"
@@ -135,6 +136,13 @@ func (s TaskReadQueryInMemory) FindTasksWithFilter(params map[string]string, pag
 					}
 				}
 			}
+			// Assignee
+			if value, _ := params["assignee"]; value != "" && is_match {
+				assigneeID, _ := uuid.FromString(value)
+				if val.AssigneeID == nil || *val.AssigneeID != assigneeID {
+					is_match = false
+				}
+			}
 			if is_match {
 				tasks = append(tasks, val)
 			}
@@ -243,6 +251,13 @@ func (s TaskReadQueryInMemory) CountTasksWithFilter(params map[string]string) <-
           }
         }
       }
+      // Assignee
+      if value, _ := params["assignee"]; value != "" && is_match {
+        assigneeID, _ := uuid.FromString(value)
+        if val.AssigneeID == nil || *val.AssigneeID != assigneeID {
+          is_match = false
+        }
+      }
       if is_match {
         tasks = append(tasks, val)
       }
@@ -263,3 +278,51 @@ func checkWithinTimeRange(start time.Time, end time.Time, check time.Time) bool
 	is_between := check.After(start) && check.Before(end)
 	return is_start || is_end || is_between
 }
+
+// FindAllDueBefore returns the open tasks which are not marked as due yet
+// and whose due date is before the date
+func (s TaskReadQueryInMemory) FindAllDueBefore(date time.Time) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		s.Storage.Lock.RLock()
+		defer s.Storage.Lock.RUnlock()
+
+		tasks := []storage.TaskRead{}
+		for _, val := range s.Storage.TaskReadMap {
+			if val.Status == domain.TaskStatusCreated && !val.IsDue && val.DueDate != nil && val.DueDate.Before(date) {
+				tasks = append(tasks, val)
+			}
+		}
+
+		result <- query.QueryResult{Result: tasks}
+
+		close(result)
+	}()
+
+	return result
+}
+
+// FindAllOpenDueBefore returns the open tasks whose due date is before the date,
+// including the ones already marked as due
+func (s TaskReadQueryInMemory) FindAllOpenDueBefore(date time.Time) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		s.Storage.Lock.RLock()
+		defer s.Storage.Lock.RUnlock()
+
+		tasks := []storage.TaskRead{}
+		for _, val := range s.Storage.TaskReadMap {
+			if val.Status == domain.TaskStatusCreated && val.DueDate != nil && val.DueDate.Before(date) {
+				tasks = append(tasks, val)
+			}
+		}
+
+		result <- query.QueryResult{Result: tasks}
+
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,50 @@
+package inmemory
+
+import (
+	"github.com/Tanibox/tania-core/src/tasks/query"
+	"github.com/Tanibox/tania-core/src/tasks/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type TaskReminderPreferenceQueryInMemory struct {
+	Storage *storage.TaskReminderPreferenceStorage
+}
+
+func NewTaskReminderPreferenceQueryInMemory(s *storage.TaskReminderPreferenceStorage) query.TaskReminderPreferenceQuery {
+	return TaskReminderPreferenceQueryInMemory{Storage: s}
+}
+
+func (s TaskReminderPreferenceQueryInMemory) FindByUserID(userUID uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		s.Storage.Lock.RLock()
+		defer s.Storage.Lock.RUnlock()
+
+		result <- query.QueryResult{Result: s.Storage.TaskReminderPreferenceMap[userUID]}
+
+		close(result)
+	}()
+
+	return result
+}
+
+func (s TaskReminderPreferenceQueryInMemory) FindAll() <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		s.Storage.Lock.RLock()
+		defer s.Storage.Lock.RUnlock()
+
+		preferences := []storage.TaskReminderPreference{}
+		for _, val := range s.Storage.TaskReminderPreferenceMap {
+			preferences = append(preferences, val)
+		}
+
+		result <- query.QueryResult{Result: preferences}
+
+		close(result)
+	}()
+
+	return result
+}
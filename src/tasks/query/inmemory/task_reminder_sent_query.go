@@ -0,0 +1,32 @@
+package inmemory
+
+import (
+	"github.com/Tanibox/tania-core/src/tasks/query"
+	"github.com/Tanibox/tania-core/src/tasks/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type TaskReminderSentQueryInMemory struct {
+	Storage *storage.TaskReminderSentStorage
+}
+
+func NewTaskReminderSentQueryInMemory(s *storage.TaskReminderSentStorage) query.TaskReminderSentQuery {
+	return TaskReminderSentQueryInMemory{Storage: s}
+}
+
+func (s TaskReminderSentQueryInMemory) FindByID(taskUID, userUID uuid.UUID, kind string) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		s.Storage.Lock.RLock()
+		defer s.Storage.Lock.RUnlock()
+
+		key := storage.TaskReminderSentKey{TaskUID: taskUID, UserUID: userUID, Kind: kind}
+
+		result <- query.QueryResult{Result: s.Storage.TaskReminderSentMap[key]}
+
+		close(result)
+	}()
+
+	return result
+}
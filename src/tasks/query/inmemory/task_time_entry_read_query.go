@@ -0,0 +1,68 @@
+package inmemory
+
+import (
+	"sort"
+
+	"github.com/Tanibox/tania-core/src/tasks/query"
+	"github.com/Tanibox/tania-core/src/tasks/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type TaskTimeEntryReadQueryInMemory struct {
+	Storage *storage.TaskTimeEntryReadStorage
+}
+
+func NewTaskTimeEntryReadQueryInMemory(s *storage.TaskTimeEntryReadStorage) query.TaskTimeEntryReadQuery {
+	return TaskTimeEntryReadQueryInMemory{Storage: s}
+}
+
+func (s TaskTimeEntryReadQueryInMemory) FindByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		s.Storage.Lock.RLock()
+		defer s.Storage.Lock.RUnlock()
+
+		result <- query.QueryResult{Result: s.Storage.TaskTimeEntryReadMap[uid]}
+
+		close(result)
+	}()
+
+	return result
+}
+
+func (s TaskTimeEntryReadQueryInMemory) FindAllWithFilter(filter query.TaskTimeEntryFilter) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		s.Storage.Lock.RLock()
+		defer s.Storage.Lock.RUnlock()
+
+		entries := []storage.TaskTimeEntryRead{}
+		for _, val := range s.Storage.TaskTimeEntryReadMap {
+			if filter.TaskUID != nil && val.TaskUID != *filter.TaskUID {
+				continue
+			}
+
+			if filter.StartDate != nil && val.StartDate.Before(*filter.StartDate) {
+				continue
+			}
+
+			if filter.EndDate != nil && !val.StartDate.Before(*filter.EndDate) {
+				continue
+			}
+
+			entries = append(entries, val)
+		}
+
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].StartDate.Before(entries[j].StartDate)
+		})
+
+		result <- query.QueryResult{Result: entries}
+
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,25 @@
+package inmemory
+
+import (
+	"github.com/Tanibox/tania-core/src/tasks/query"
+	uuid "github.com/satori/go.uuid"
+)
+
+// UserQueryInMemory finds no user, because the users are only stored by the database engines
+type UserQueryInMemory struct{}
+
+func NewUserQueryInMemory() query.UserQuery {
+	return UserQueryInMemory{}
+}
+
+func (s UserQueryInMemory) FindUserByID(userUID uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		result <- query.QueryResult{Result: query.TaskUserQueryResult{}}
+
+		close(result)
+	}()
+
+	return result
+}
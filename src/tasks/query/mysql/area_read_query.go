@@ -20,13 +20,14 @@ func (s AreaQueryMysql) FindByID(uid uuid.UUID) <-chan query.QueryResult {
 
 	go func() {
 		rowsData := struct {
-			UID  []byte
-			Name string
+			UID     []byte
+			Name    string
+			FarmUID []byte
 		}{}
 		area := query.TaskAreaQueryResult{}
 
-		err := s.DB.QueryRow(`SELECT UID, NAME
-			FROM AREA_READ WHERE UID = ?`, uid.Bytes()).Scan(&rowsData.UID, &rowsData.Name)
+		err := s.DB.QueryRow(`SELECT UID, NAME, FARM_UID
+			FROM AREA_READ WHERE UID = ?`, uid.Bytes()).Scan(&rowsData.UID, &rowsData.Name, &rowsData.FarmUID)
 
 		areaUID, err := uuid.FromBytes(rowsData.UID)
 		if err != nil {
@@ -36,6 +37,13 @@ func (s AreaQueryMysql) FindByID(uid uuid.UUID) <-chan query.QueryResult {
 		area.UID = areaUID
 		area.Name = rowsData.Name
 
+		if rowsData.FarmUID != nil {
+			farmUID, err := uuid.FromBytes(rowsData.FarmUID)
+			if err == nil {
+				area.FarmID = farmUID
+			}
+		}
+
 		result <- query.QueryResult{Result: area}
 
 		close(result)
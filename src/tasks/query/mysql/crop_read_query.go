@@ -22,11 +22,12 @@ func (s CropQueryMysql) FindCropByID(uid uuid.UUID) <-chan query.QueryResult {
 		rowsData := struct {
 			UID     []byte
 			BatchID string
+			FarmUID []byte
 		}{}
 		crop := query.TaskCropQueryResult{}
 
-		err := s.DB.QueryRow(`SELECT UID, BATCH_ID
-			FROM CROP_READ WHERE UID = ?`, uid.Bytes()).Scan(&rowsData.UID, &rowsData.BatchID)
+		err := s.DB.QueryRow(`SELECT UID, BATCH_ID, FARM_UID
+			FROM CROP_READ WHERE UID = ?`, uid.Bytes()).Scan(&rowsData.UID, &rowsData.BatchID, &rowsData.FarmUID)
 
 		cropUID, err := uuid.FromBytes(rowsData.UID)
 		if err != nil {
@@ -36,6 +37,13 @@ func (s CropQueryMysql) FindCropByID(uid uuid.UUID) <-chan query.QueryResult {
 		crop.UID = cropUID
 		crop.BatchID = rowsData.BatchID
 
+		if rowsData.FarmUID != nil {
+			farmUID, err := uuid.FromBytes(rowsData.FarmUID)
+			if err == nil {
+				crop.FarmID = farmUID
+			}
+		}
+
 		result <- query.QueryResult{Result: crop}
 
 		close(result)
@@ -0,0 +1,59 @@
+package mysql
+
+import (
+	"database/sql"
+
+	"github.com/Tanibox/tania-core/src/tasks/query"
+	uuid "github.com/satori/go.uuid"
+)
+
+type FarmQueryMysql struct {
+	DB *sql.DB
+}
+
+func NewFarmQueryMysql(db *sql.DB) query.FarmQuery {
+	return FarmQueryMysql{DB: db}
+}
+
+func (s FarmQueryMysql) FindFarmByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		rowsData := struct {
+			UID  []byte
+			Name string
+		}{}
+		farm := query.TaskFarmQueryResult{}
+
+		err := s.DB.QueryRow(`SELECT UID, NAME
+			FROM FARM_READ WHERE UID = ?`, uid.Bytes()).Scan(&rowsData.UID, &rowsData.Name)
+
+		if err == sql.ErrNoRows {
+			result <- query.QueryResult{Result: farm}
+			close(result)
+			return
+		}
+
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		farmUID, err := uuid.FromBytes(rowsData.UID)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		farm.UID = farmUID
+		farm.Name = rowsData.Name
+
+		result <- query.QueryResult{Result: farm}
+
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,113 @@
+package mysql
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/tasks/query"
+	"github.com/Tanibox/tania-core/src/tasks/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type FinanceLedgerQueryMysql struct {
+	DB *sql.DB
+}
+
+func NewFinanceLedgerQueryMysql(db *sql.DB) query.FinanceLedgerQuery {
+	return FinanceLedgerQueryMysql{DB: db}
+}
+
+func (s FinanceLedgerQueryMysql) FindAllByFarmID(farmUID uuid.UUID, filter query.FinanceLedgerFilter) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		sqlQuery := `SELECT TASK_UID, FARM_UID, TITLE, AMOUNT, CURRENCY_CODE, DIRECTION, COUNTERPARTY, MATERIAL_UID, POSTED_DATE
+			FROM FINANCE_LEDGER_READ WHERE FARM_UID = ?`
+		params := []interface{}{farmUID.Bytes()}
+
+		if filter.StartDate != nil {
+			sqlQuery += " AND POSTED_DATE >= ?"
+			params = append(params, *filter.StartDate)
+		}
+
+		if filter.EndDate != nil {
+			sqlQuery += " AND POSTED_DATE < ?"
+			params = append(params, *filter.EndDate)
+		}
+
+		sqlQuery += " ORDER BY POSTED_DATE ASC"
+
+		rows, err := s.DB.Query(sqlQuery, params...)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+		defer rows.Close()
+
+		entries := []storage.FinanceLedgerEntry{}
+		for rows.Next() {
+			rowsData := struct {
+				TaskUID      []byte
+				FarmUID      []byte
+				Title        string
+				Amount       string
+				CurrencyCode string
+				Direction    string
+				Counterparty string
+				MaterialUID  []byte
+				PostedDate   time.Time
+			}{}
+
+			err = rows.Scan(
+				&rowsData.TaskUID, &rowsData.FarmUID, &rowsData.Title, &rowsData.Amount, &rowsData.CurrencyCode,
+				&rowsData.Direction, &rowsData.Counterparty, &rowsData.MaterialUID, &rowsData.PostedDate)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+				close(result)
+				return
+			}
+
+			entry := storage.FinanceLedgerEntry{
+				Title:        rowsData.Title,
+				Amount:       rowsData.Amount,
+				CurrencyCode: rowsData.CurrencyCode,
+				Direction:    rowsData.Direction,
+				Counterparty: rowsData.Counterparty,
+				PostedDate:   rowsData.PostedDate,
+			}
+
+			entry.TaskUID, err = uuid.FromBytes(rowsData.TaskUID)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+				close(result)
+				return
+			}
+
+			entry.FarmUID, err = uuid.FromBytes(rowsData.FarmUID)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+				close(result)
+				return
+			}
+
+			if len(rowsData.MaterialUID) > 0 {
+				materialUID, err := uuid.FromBytes(rowsData.MaterialUID)
+				if err != nil {
+					result <- query.QueryResult{Error: err}
+					close(result)
+					return
+				}
+
+				entry.MaterialID = &materialUID
+			}
+
+			entries = append(entries, entry)
+		}
+
+		result <- query.QueryResult{Result: entries}
+		close(result)
+	}()
+
+	return result
+}
@@ -20,15 +20,18 @@ func (s MaterialQueryMysql) FindMaterialByID(uid uuid.UUID) <-chan query.QueryRe
 
 	go func() {
 		rowsData := struct {
-			UID      []byte
-			Name     string
-			Type     string
-			TypeData string
+			UID          []byte
+			Name         string
+			Type         string
+			TypeData     string
+			Quantity     float32
+			QuantityUnit string
 		}{}
 		material := query.TaskMaterialQueryResult{}
 
-		err := s.DB.QueryRow(`SELECT UID, NAME, TYPE, TYPE_DATA
-			FROM MATERIAL_READ WHERE UID = ?`, uid.Bytes()).Scan(&rowsData.UID, &rowsData.Name, &rowsData.Type, &rowsData.TypeData)
+		err := s.DB.QueryRow(`SELECT UID, NAME, TYPE, TYPE_DATA, QUANTITY, QUANTITY_UNIT
+			FROM MATERIAL_READ WHERE UID = ?`, uid.Bytes()).Scan(&rowsData.UID, &rowsData.Name, &rowsData.Type, &rowsData.TypeData,
+			&rowsData.Quantity, &rowsData.QuantityUnit)
 
 		materialUID, err := uuid.FromBytes(rowsData.UID)
 		if err != nil {
@@ -39,6 +42,8 @@ func (s MaterialQueryMysql) FindMaterialByID(uid uuid.UUID) <-chan query.QueryRe
 		material.Name = rowsData.Name
 		material.TypeCode = rowsData.Type
 		material.DetailedTypeCode = rowsData.TypeData
+		material.Quantity = rowsData.Quantity
+		material.QuantityUnit = rowsData.QuantityUnit
 
 		result <- query.QueryResult{Result: material}
 
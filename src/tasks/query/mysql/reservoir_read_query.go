@@ -20,13 +20,14 @@ func (s ReservoirQueryMysql) FindReservoirByID(uid uuid.UUID) <-chan query.Query
 
 	go func() {
 		rowsData := struct {
-			UID  []byte
-			Name string
+			UID     []byte
+			Name    string
+			FarmUID []byte
 		}{}
 		reservoir := query.TaskReservoirQueryResult{}
 
-		err := s.DB.QueryRow(`SELECT UID, NAME
-			FROM RESERVOIR_READ WHERE UID = ?`, uid.Bytes()).Scan(&rowsData.UID, &rowsData.Name)
+		err := s.DB.QueryRow(`SELECT UID, NAME, FARM_UID
+			FROM RESERVOIR_READ WHERE UID = ?`, uid.Bytes()).Scan(&rowsData.UID, &rowsData.Name, &rowsData.FarmUID)
 
 		reservoirUID, err := uuid.FromBytes(rowsData.UID)
 		if err != nil {
@@ -36,6 +37,13 @@ func (s ReservoirQueryMysql) FindReservoirByID(uid uuid.UUID) <-chan query.Query
 		reservoir.UID = reservoirUID
 		reservoir.Name = rowsData.Name
 
+		if rowsData.FarmUID != nil {
+			farmUID, err := uuid.FromBytes(rowsData.FarmUID)
+			if err == nil {
+				reservoir.FarmID = farmUID
+			}
+		}
+
 		result <- query.QueryResult{Result: reservoir}
 
 		close(result)
@@ -0,0 +1,72 @@
+package mysql
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/tasks/query"
+	"github.com/Tanibox/tania-core/src/tasks/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type TaskCalendarFeedQueryMysql struct {
+	DB *sql.DB
+}
+
+func NewTaskCalendarFeedQueryMysql(db *sql.DB) query.TaskCalendarFeedQuery {
+	return TaskCalendarFeedQueryMysql{DB: db}
+}
+
+func (s TaskCalendarFeedQueryMysql) FindByUserID(userUID uuid.UUID) <-chan query.QueryResult {
+	return s.findOne(`SELECT USER_UID, TOKEN, CREATED_DATE
+		FROM TASK_CALENDAR_FEED WHERE USER_UID = ?`, userUID.Bytes())
+}
+
+func (s TaskCalendarFeedQueryMysql) FindByToken(token string) <-chan query.QueryResult {
+	return s.findOne(`SELECT USER_UID, TOKEN, CREATED_DATE
+		FROM TASK_CALENDAR_FEED WHERE TOKEN = ?`, token)
+}
+
+// findOne returns an empty feed when there is no row
+func (s TaskCalendarFeedQueryMysql) findOne(sqlQuery string, arg interface{}) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		rowsData := struct {
+			UserUID     []byte
+			Token       string
+			CreatedDate time.Time
+		}{}
+		feed := storage.TaskCalendarFeed{}
+
+		err := s.DB.QueryRow(sqlQuery, arg).Scan(&rowsData.UserUID, &rowsData.Token, &rowsData.CreatedDate)
+
+		if err == sql.ErrNoRows {
+			result <- query.QueryResult{Result: feed}
+			close(result)
+			return
+		}
+
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		userUID, err := uuid.FromBytes(rowsData.UserUID)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		feed.UserUID = userUID
+		feed.Token = rowsData.Token
+		feed.CreatedDate = rowsData.CreatedDate
+
+		result <- query.QueryResult{Result: feed}
+		close(result)
+	}()
+
+	return result
+}
@@ -2,6 +2,7 @@ package mysql
 
 import (
 	"database/sql"
+	"encoding/json"
 	"strconv"
 	"time"
 
@@ -37,6 +38,15 @@ type taskReadQueryResult struct {
 	Category             string
 	IsDue                int
 	AssetID              uuid.NullUUID
+	RecurrenceRule       sql.NullString
+	SeriesUID            uuid.NullUUID
+	AssigneeUID          uuid.NullUUID
+	Checklist            sql.NullString
+	BlockedBy            sql.NullString
+	Comments             sql.NullString
+	Attachments          sql.NullString
+	MaterialConsumption  sql.NullString
+	DomainDataFinance    sql.NullString
 }
 
 func (r TaskReadQueryMysql) FindAll(page, limit int) <-chan query.QueryResult {
@@ -146,6 +156,11 @@ func (s TaskReadQueryMysql) FindTasksWithFilter(params map[string]string, page,
 			sql += " AND ASSET_ID = ? "
 			args = append(args, assetID.Bytes())
 		}
+		if value, _ := params["assignee"]; value != "" {
+			assigneeID, _ := uuid.FromString(value)
+			sql += " AND ASSIGNEE_UID = ? "
+			args = append(args, assigneeID.Bytes())
+		}
 
 		if page != 0 && limit != 0 {
 			sql += " LIMIT ? OFFSET ?"
@@ -238,6 +253,11 @@ func (q TaskReadQueryMysql) CountTasksWithFilter(params map[string]string) <-cha
 			sql += " AND ASSET_ID = ? "
 			args = append(args, assetID.Bytes())
 		}
+		if value, _ := params["assignee"]; value != "" {
+			assigneeID, _ := uuid.FromString(value)
+			sql += " AND ASSIGNEE_UID = ? "
+			args = append(args, assigneeID.Bytes())
+		}
 
 		err := q.DB.QueryRow(sql, args...).Scan(&total)
 		if err != nil {
@@ -259,6 +279,10 @@ func (s TaskReadQueryMysql) populateQueryResult(rows *sql.Rows) (storage.TaskRea
 		&rowsData.DueDate, &rowsData.CompletedDate, &rowsData.CancelledDate,
 		&rowsData.Priority, &rowsData.Status, &rowsData.DomainCode, &rowsData.DomainDataMaterialID,
 		&rowsData.DomainDataAreaID, &rowsData.DomainDataCropID, &rowsData.Category, &rowsData.IsDue, &rowsData.AssetID,
+		&rowsData.RecurrenceRule, &rowsData.SeriesUID, &rowsData.AssigneeUID,
+		&rowsData.Checklist, &rowsData.BlockedBy,
+		&rowsData.Comments, &rowsData.Attachments,
+		&rowsData.MaterialConsumption, &rowsData.DomainDataFinance,
 	)
 
 	if err != nil {
@@ -291,7 +315,15 @@ func (s TaskReadQueryMysql) populateQueryResult(rows *sql.Rows) (storage.TaskRea
 		}
 
 	case domain.TaskDomainFinanceCode:
-		domainDetails = domain.TaskDomainFinance{}
+		finance := domain.TaskDomainFinance{}
+		if rowsData.DomainDataFinance.Valid && rowsData.DomainDataFinance.String != "" {
+			err = json.Unmarshal([]byte(rowsData.DomainDataFinance.String), &finance)
+			if err != nil {
+				return storage.TaskRead{}, err
+			}
+		}
+
+		domainDetails = finance
 	case domain.TaskDomainGeneralCode:
 		domainDetails = domain.TaskDomainGeneral{}
 	case domain.TaskDomainInventoryCode:
@@ -310,6 +342,56 @@ func (s TaskReadQueryMysql) populateQueryResult(rows *sql.Rows) (storage.TaskRea
 		isDue = true
 	}
 
+	var seriesUID *uuid.UUID
+	if rowsData.SeriesUID.Valid {
+		seriesUID = &rowsData.SeriesUID.UUID
+	}
+
+	var assigneeUID *uuid.UUID
+	if rowsData.AssigneeUID.Valid {
+		assigneeUID = &rowsData.AssigneeUID.UUID
+	}
+
+	checklist := []domain.ChecklistItem{}
+	if rowsData.Checklist.Valid && rowsData.Checklist.String != "" {
+		err = json.Unmarshal([]byte(rowsData.Checklist.String), &checklist)
+		if err != nil {
+			return storage.TaskRead{}, err
+		}
+	}
+
+	blockedBy := []uuid.UUID{}
+	if rowsData.BlockedBy.Valid && rowsData.BlockedBy.String != "" {
+		err = json.Unmarshal([]byte(rowsData.BlockedBy.String), &blockedBy)
+		if err != nil {
+			return storage.TaskRead{}, err
+		}
+	}
+
+	comments := []domain.TaskComment{}
+	if rowsData.Comments.Valid && rowsData.Comments.String != "" {
+		err = json.Unmarshal([]byte(rowsData.Comments.String), &comments)
+		if err != nil {
+			return storage.TaskRead{}, err
+		}
+	}
+
+	attachments := []domain.TaskAttachment{}
+	if rowsData.Attachments.Valid && rowsData.Attachments.String != "" {
+		err = json.Unmarshal([]byte(rowsData.Attachments.String), &attachments)
+		if err != nil {
+			return storage.TaskRead{}, err
+		}
+	}
+
+	var materialConsumption *domain.TaskMaterialConsumption
+	if rowsData.MaterialConsumption.Valid && rowsData.MaterialConsumption.String != "" {
+		err = json.Unmarshal([]byte(rowsData.MaterialConsumption.String), &materialConsumption)
+		if err != nil {
+			return storage.TaskRead{}, err
+		}
+	}
+
 	return storage.TaskRead{
 		UID:           taskUID,
 		Title:         rowsData.Title,
@@ -325,5 +407,88 @@ func (s TaskReadQueryMysql) populateQueryResult(rows *sql.Rows) (storage.TaskRea
 		Category:      rowsData.Category,
 		IsDue:         isDue,
 		AssetID:       assetUID,
+
+		RecurrenceRule: rowsData.RecurrenceRule.String,
+		SeriesID:       seriesUID,
+
+		AssigneeID: assigneeUID,
+
+		Checklist: checklist,
+		BlockedBy: blockedBy,
+
+		Comments:    comments,
+		Attachments: attachments,
+
+		MaterialConsumption: materialConsumption,
 	}, nil
 }
+
+// FindAllDueBefore returns the open tasks which are not marked as due yet
+// and whose due date is before the date
+func (s TaskReadQueryMysql) FindAllDueBefore(date time.Time) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		tasks := []storage.TaskRead{}
+
+		rows, err := s.DB.Query(`SELECT * FROM TASK_READ WHERE STATUS = ? AND IS_DUE = ? AND DUE_DATE < ?`,
+			domain.TaskStatusCreated, false, date)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			taskRead, err := s.populateQueryResult(rows)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+				close(result)
+				return
+			}
+
+			tasks = append(tasks, taskRead)
+		}
+
+		result <- query.QueryResult{Result: tasks}
+		close(result)
+	}()
+
+	return result
+}
+
+// FindAllOpenDueBefore returns the open tasks whose due date is before the date,
+// including the ones already marked as due
+func (s TaskReadQueryMysql) FindAllOpenDueBefore(date time.Time) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		tasks := []storage.TaskRead{}
+
+		rows, err := s.DB.Query(`SELECT * FROM TASK_READ WHERE STATUS = ? AND DUE_DATE < ?`,
+			domain.TaskStatusCreated, date)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			taskRead, err := s.populateQueryResult(rows)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+				close(result)
+				return
+			}
+
+			tasks = append(tasks, taskRead)
+		}
+
+		result <- query.QueryResult{Result: tasks}
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,120 @@
+package mysql
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/tasks/query"
+	"github.com/Tanibox/tania-core/src/tasks/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type TaskReminderPreferenceQueryMysql struct {
+	DB *sql.DB
+}
+
+func NewTaskReminderPreferenceQueryMysql(db *sql.DB) query.TaskReminderPreferenceQuery {
+	return TaskReminderPreferenceQueryMysql{DB: db}
+}
+
+// FindByUserID returns an empty preference when the user has none
+func (s TaskReminderPreferenceQueryMysql) FindByUserID(userUID uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		preferences, err := s.findAll(`SELECT USER_UID, CHANNEL, RECIPIENT, CATEGORIES, PRIORITIES, REMIND_BEFORE, REMIND_OVERDUE, LAST_UPDATED
+			FROM TASK_REMINDER_PREFERENCE WHERE USER_UID = ?`, userUID.Bytes())
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		preference := storage.TaskReminderPreference{}
+		if len(preferences) > 0 {
+			preference = preferences[0]
+		}
+
+		result <- query.QueryResult{Result: preference}
+		close(result)
+	}()
+
+	return result
+}
+
+func (s TaskReminderPreferenceQueryMysql) FindAll() <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		preferences, err := s.findAll(`SELECT USER_UID, CHANNEL, RECIPIENT, CATEGORIES, PRIORITIES, REMIND_BEFORE, REMIND_OVERDUE, LAST_UPDATED
+			FROM TASK_REMINDER_PREFERENCE`)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		result <- query.QueryResult{Result: preferences}
+		close(result)
+	}()
+
+	return result
+}
+
+func (s TaskReminderPreferenceQueryMysql) findAll(sqlQuery string, args ...interface{}) ([]storage.TaskReminderPreference, error) {
+	preferences := []storage.TaskReminderPreference{}
+
+	rows, err := s.DB.Query(sqlQuery, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		rowsData := struct {
+			UserUID       []byte
+			Channel       string
+			Recipient     string
+			Categories    string
+			Priorities    string
+			RemindBefore  int64
+			RemindOverdue bool
+			LastUpdated   time.Time
+		}{}
+
+		err = rows.Scan(&rowsData.UserUID, &rowsData.Channel, &rowsData.Recipient, &rowsData.Categories,
+			&rowsData.Priorities, &rowsData.RemindBefore, &rowsData.RemindOverdue, &rowsData.LastUpdated)
+		if err != nil {
+			return nil, err
+		}
+
+		preference := storage.TaskReminderPreference{
+			Channel:     rowsData.Channel,
+			Recipient:   rowsData.Recipient,
+			LastUpdated: rowsData.LastUpdated,
+		}
+
+		preference.UserUID, err = uuid.FromBytes(rowsData.UserUID)
+		if err != nil {
+			return nil, err
+		}
+
+		err = json.Unmarshal([]byte(rowsData.Categories), &preference.Categories)
+		if err != nil {
+			return nil, err
+		}
+
+		err = json.Unmarshal([]byte(rowsData.Priorities), &preference.Priorities)
+		if err != nil {
+			return nil, err
+		}
+
+		preference.RemindBefore = time.Duration(rowsData.RemindBefore) * time.Second
+		preference.RemindOverdue = rowsData.RemindOverdue
+
+		preferences = append(preferences, preference)
+	}
+
+	return preferences, rows.Err()
+}
@@ -0,0 +1,51 @@
+package mysql
+
+import (
+	"database/sql"
+
+	"github.com/Tanibox/tania-core/src/tasks/query"
+	"github.com/Tanibox/tania-core/src/tasks/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type TaskReminderSentQueryMysql struct {
+	DB *sql.DB
+}
+
+func NewTaskReminderSentQueryMysql(db *sql.DB) query.TaskReminderSentQuery {
+	return TaskReminderSentQueryMysql{DB: db}
+}
+
+// FindByID returns an empty reminder when it was never sent
+func (s TaskReminderSentQueryMysql) FindByID(taskUID, userUID uuid.UUID, kind string) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		sent := storage.TaskReminderSent{}
+
+		err := s.DB.QueryRow(`SELECT DUE_DATE, SENT_DATE FROM TASK_REMINDER_SENT
+			WHERE TASK_UID = ? AND USER_UID = ? AND KIND = ?`, taskUID.Bytes(), userUID.Bytes(), kind).
+			Scan(&sent.DueDate, &sent.SentDate)
+
+		if err == sql.ErrNoRows {
+			result <- query.QueryResult{Result: storage.TaskReminderSent{}}
+			close(result)
+			return
+		}
+
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		sent.TaskUID = taskUID
+		sent.UserUID = userUID
+		sent.Kind = kind
+
+		result <- query.QueryResult{Result: sent}
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,161 @@
+package mysql
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/tasks/query"
+	"github.com/Tanibox/tania-core/src/tasks/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type TaskTimeEntryReadQueryMysql struct {
+	DB *sql.DB
+}
+
+type taskTimeEntryReadResult struct {
+	UID        []byte
+	TaskUID    []byte
+	WorkerUID  []byte
+	HourlyRate float64
+	StartDate  time.Time
+	EndDate    *time.Time
+	Duration   int64
+}
+
+func NewTaskTimeEntryReadQueryMysql(db *sql.DB) query.TaskTimeEntryReadQuery {
+	return TaskTimeEntryReadQueryMysql{DB: db}
+}
+
+func (s TaskTimeEntryReadQueryMysql) FindByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		rowsData := taskTimeEntryReadResult{}
+		timeEntry := storage.TaskTimeEntryRead{}
+
+		err := s.DB.QueryRow(`SELECT UID, TASK_UID, WORKER_UID, HOURLY_RATE, START_DATE, END_DATE, DURATION
+			FROM TASK_TIME_ENTRY_READ WHERE UID = ?`, uid.Bytes()).Scan(
+			&rowsData.UID, &rowsData.TaskUID, &rowsData.WorkerUID, &rowsData.HourlyRate,
+			&rowsData.StartDate, &rowsData.EndDate, &rowsData.Duration)
+
+		if err == sql.ErrNoRows {
+			result <- query.QueryResult{Result: timeEntry}
+			close(result)
+			return
+		}
+
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		timeEntry, err = rowsData.toTimeEntryRead()
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		result <- query.QueryResult{Result: timeEntry}
+		close(result)
+	}()
+
+	return result
+}
+
+func (s TaskTimeEntryReadQueryMysql) FindAllWithFilter(filter query.TaskTimeEntryFilter) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		sqlQuery := `SELECT UID, TASK_UID, WORKER_UID, HOURLY_RATE, START_DATE, END_DATE, DURATION
+			FROM TASK_TIME_ENTRY_READ WHERE 1 = 1`
+		var params []interface{}
+
+		if filter.TaskUID != nil {
+			sqlQuery += " AND TASK_UID = ?"
+			params = append(params, filter.TaskUID.Bytes())
+		}
+
+		if filter.StartDate != nil {
+			sqlQuery += " AND START_DATE >= ?"
+			params = append(params, *filter.StartDate)
+		}
+
+		if filter.EndDate != nil {
+			sqlQuery += " AND START_DATE < ?"
+			params = append(params, *filter.EndDate)
+		}
+
+		sqlQuery += " ORDER BY START_DATE ASC"
+
+		rows, err := s.DB.Query(sqlQuery, params...)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+		defer rows.Close()
+
+		entries := []storage.TaskTimeEntryRead{}
+		for rows.Next() {
+			rowsData := taskTimeEntryReadResult{}
+
+			err = rows.Scan(
+				&rowsData.UID, &rowsData.TaskUID, &rowsData.WorkerUID, &rowsData.HourlyRate,
+				&rowsData.StartDate, &rowsData.EndDate, &rowsData.Duration)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+				close(result)
+				return
+			}
+
+			timeEntry, err := rowsData.toTimeEntryRead()
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+				close(result)
+				return
+			}
+
+			entries = append(entries, timeEntry)
+		}
+
+		result <- query.QueryResult{Result: entries}
+		close(result)
+	}()
+
+	return result
+}
+
+func (r taskTimeEntryReadResult) toTimeEntryRead() (storage.TaskTimeEntryRead, error) {
+	uid, err := uuid.FromBytes(r.UID)
+	if err != nil {
+		return storage.TaskTimeEntryRead{}, err
+	}
+
+	taskUID, err := uuid.FromBytes(r.TaskUID)
+	if err != nil {
+		return storage.TaskTimeEntryRead{}, err
+	}
+
+	var workerID *uuid.UUID
+	if len(r.WorkerUID) > 0 {
+		w, err := uuid.FromBytes(r.WorkerUID)
+		if err != nil {
+			return storage.TaskTimeEntryRead{}, err
+		}
+
+		workerID = &w
+	}
+
+	return storage.TaskTimeEntryRead{
+		UID:        uid,
+		TaskUID:    taskUID,
+		WorkerID:   workerID,
+		HourlyRate: r.HourlyRate,
+		StartDate:  r.StartDate,
+		EndDate:    r.EndDate,
+		Duration:   time.Duration(r.Duration) * time.Second,
+	}, nil
+}
@@ -0,0 +1,58 @@
+package mysql
+
+import (
+	"database/sql"
+
+	"github.com/Tanibox/tania-core/src/tasks/query"
+	uuid "github.com/satori/go.uuid"
+)
+
+type UserQueryMysql struct {
+	DB *sql.DB
+}
+
+func NewUserQueryMysql(db *sql.DB) query.UserQuery {
+	return UserQueryMysql{DB: db}
+}
+
+func (s UserQueryMysql) FindUserByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		rowsData := struct {
+			UID      []byte
+			Username string
+		}{}
+		user := query.TaskUserQueryResult{}
+
+		err := s.DB.QueryRow(`SELECT UID, USERNAME
+			FROM USER_READ WHERE UID = ?`, uid.Bytes()).Scan(&rowsData.UID, &rowsData.Username)
+
+		if err == sql.ErrNoRows {
+			result <- query.QueryResult{Result: user}
+			close(result)
+			return
+		}
+
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		userUID, err := uuid.FromBytes(rowsData.UID)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		user.UID = userUID
+		user.Username = rowsData.Username
+
+		result <- query.QueryResult{Result: user}
+		close(result)
+	}()
+
+	return result
+}
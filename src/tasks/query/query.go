@@ -1,6 +1,8 @@
 package query
 
 import (
+	"time"
+
 	//assetsdomain "github.com/Tanibox/tania-core/src/assets/domain"
 	uuid "github.com/satori/go.uuid"
 )
@@ -21,6 +23,10 @@ type AreaQuery interface {
 	FindByID(areaUID uuid.UUID) <-chan QueryResult
 }
 
+type FarmQuery interface {
+	FindFarmByID(farmUID uuid.UUID) <-chan QueryResult
+}
+
 type CropQuery interface {
 	FindCropByID(cropUID uuid.UUID) <-chan QueryResult
 }
@@ -39,12 +45,61 @@ type TaskReadQuery interface {
 	FindTasksWithFilter(params map[string]string, page, limit int) <-chan QueryResult
   CountAll() <-chan QueryResult
   CountTasksWithFilter(params map[string]string) <-chan QueryResult
+	FindAllDueBefore(date time.Time) <-chan QueryResult
+	FindAllOpenDueBefore(date time.Time) <-chan QueryResult
 }
 
 type ReservoirQuery interface {
 	FindReservoirByID(reservoirUID uuid.UUID) <-chan QueryResult
 }
 
+type UserQuery interface {
+	FindUserByID(userUID uuid.UUID) <-chan QueryResult
+}
+
+type TaskTimeEntryReadQuery interface {
+	FindByID(uid uuid.UUID) <-chan QueryResult
+	FindAllWithFilter(filter TaskTimeEntryFilter) <-chan QueryResult
+}
+
+// TaskTimeEntryFilter selects the time entries of a task, started from StartDate and before EndDate.
+// A nil field is not filtered on.
+type TaskTimeEntryFilter struct {
+	TaskUID   *uuid.UUID
+	StartDate *time.Time
+	EndDate   *time.Time
+}
+
+type TaskCalendarFeedQuery interface {
+	FindByUserID(userUID uuid.UUID) <-chan QueryResult
+	FindByToken(token string) <-chan QueryResult
+}
+
+type FinanceLedgerQuery interface {
+	FindAllByFarmID(farmUID uuid.UUID, filter FinanceLedgerFilter) <-chan QueryResult
+}
+
+// FinanceLedgerFilter selects the entries posted from StartDate and before EndDate.
+// A nil date leaves that side of the range open.
+type FinanceLedgerFilter struct {
+	StartDate *time.Time
+	EndDate   *time.Time
+}
+
+type TaskReminderPreferenceQuery interface {
+	FindByUserID(userUID uuid.UUID) <-chan QueryResult
+	FindAll() <-chan QueryResult
+}
+
+type TaskReminderSentQuery interface {
+	FindByID(taskUID, userUID uuid.UUID, kind string) <-chan QueryResult
+}
+
+type SuccessionPlanTaskQuery interface {
+	FindByPlannedBatchID(plannedBatchUID uuid.UUID) <-chan QueryResult
+	FindAllBySuccessionPlanID(successionPlanUID uuid.UUID) <-chan QueryResult
+}
+
 /*
 TODO
 
@@ -52,15 +107,17 @@ type DeviceQuery interface {
 	FindDeviceByID(deviceUID uuid.UUID) <-chan QueryResult
 }
 
-type FinanceQuery interface {
-	FindFinanceByID(financeUID uuid.UUID) <-chan QueryResult
-}
-
 */
 
 // QUERY RESULTS
 
 type TaskAreaQueryResult struct {
+	UID    uuid.UUID `json:"uid"`
+	Name   string    `json:"name"`
+	FarmID uuid.UUID `json:"farm_id"`
+}
+
+type TaskFarmQueryResult struct {
 	UID  uuid.UUID `json:"uid"`
 	Name string    `json:"name"`
 }
@@ -68,6 +125,7 @@ type TaskAreaQueryResult struct {
 type TaskCropQueryResult struct {
 	UID     uuid.UUID `json:"uid"`
 	BatchID string    `json:"batch_id"`
+	FarmID  uuid.UUID `json:"farm_id"`
 }
 
 type TaskMaterialQueryResult struct {
@@ -75,9 +133,24 @@ type TaskMaterialQueryResult struct {
 	TypeCode         string    `json:"type"`
 	DetailedTypeCode string    `json:"detailed_type"`
 	Name             string    `json:"name"`
+	Quantity         float32   `json:"quantity"`
+	QuantityUnit     string    `json:"quantity_unit"`
 }
 
 type TaskReservoirQueryResult struct {
-	UID  uuid.UUID `json:"uid"`
-	Name string    `json:"name"`
+	UID    uuid.UUID `json:"uid"`
+	Name   string    `json:"name"`
+	FarmID uuid.UUID `json:"farm_id"`
+}
+
+type TaskUserQueryResult struct {
+	UID      uuid.UUID `json:"uid"`
+	Username string    `json:"username"`
+}
+
+type TaskDependencyQueryResult struct {
+	UID       uuid.UUID   `json:"uid"`
+	Title     string      `json:"title"`
+	Status    string      `json:"status"`
+	BlockedBy []uuid.UUID `json:"blocked_by"`
 }
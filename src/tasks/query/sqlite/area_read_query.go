@@ -20,13 +20,14 @@ func (s AreaQuerySqlite) FindByID(uid uuid.UUID) <-chan query.QueryResult {
 
 	go func() {
 		rowsData := struct {
-			UID  string
-			Name string
+			UID     string
+			Name    string
+			FarmUID sql.NullString
 		}{}
 		area := query.TaskAreaQueryResult{}
 
-		err := s.DB.QueryRow(`SELECT UID, NAME
-			FROM AREA_READ WHERE UID = ?`, uid).Scan(&rowsData.UID, &rowsData.Name)
+		err := s.DB.QueryRow(`SELECT UID, NAME, FARM_UID
+			FROM AREA_READ WHERE UID = ?`, uid).Scan(&rowsData.UID, &rowsData.Name, &rowsData.FarmUID)
 
 		areaUID, err := uuid.FromString(rowsData.UID)
 		if err != nil {
@@ -36,6 +37,13 @@ func (s AreaQuerySqlite) FindByID(uid uuid.UUID) <-chan query.QueryResult {
 		area.UID = areaUID
 		area.Name = rowsData.Name
 
+		if rowsData.FarmUID.Valid {
+			farmUID, err := uuid.FromString(rowsData.FarmUID.String)
+			if err == nil {
+				area.FarmID = farmUID
+			}
+		}
+
 		result <- query.QueryResult{Result: area}
 
 		close(result)
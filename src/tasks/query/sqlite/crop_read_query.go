@@ -22,11 +22,12 @@ func (s CropQuerySqlite) FindCropByID(uid uuid.UUID) <-chan query.QueryResult {
 		rowsData := struct {
 			UID     string
 			BatchID string
+			FarmUID sql.NullString
 		}{}
 		crop := query.TaskCropQueryResult{}
 
-		err := s.DB.QueryRow(`SELECT UID, BATCH_ID
-			FROM CROP_READ WHERE UID = ?`, uid).Scan(&rowsData.UID, &rowsData.BatchID)
+		err := s.DB.QueryRow(`SELECT UID, BATCH_ID, FARM_UID
+			FROM CROP_READ WHERE UID = ?`, uid).Scan(&rowsData.UID, &rowsData.BatchID, &rowsData.FarmUID)
 
 		cropUID, err := uuid.FromString(rowsData.UID)
 		if err != nil {
@@ -36,6 +37,13 @@ func (s CropQuerySqlite) FindCropByID(uid uuid.UUID) <-chan query.QueryResult {
 		crop.UID = cropUID
 		crop.BatchID = rowsData.BatchID
 
+		if rowsData.FarmUID.Valid {
+			farmUID, err := uuid.FromString(rowsData.FarmUID.String)
+			if err == nil {
+				crop.FarmID = farmUID
+			}
+		}
+
 		result <- query.QueryResult{Result: crop}
 
 		close(result)
@@ -0,0 +1,59 @@
+package sqlite
+
+import (
+	"database/sql"
+
+	"github.com/Tanibox/tania-core/src/tasks/query"
+	uuid "github.com/satori/go.uuid"
+)
+
+type FarmQuerySqlite struct {
+	DB *sql.DB
+}
+
+func NewFarmQuerySqlite(db *sql.DB) query.FarmQuery {
+	return FarmQuerySqlite{DB: db}
+}
+
+func (s FarmQuerySqlite) FindFarmByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		rowsData := struct {
+			UID  string
+			Name string
+		}{}
+		farm := query.TaskFarmQueryResult{}
+
+		err := s.DB.QueryRow(`SELECT UID, NAME
+			FROM FARM_READ WHERE UID = ?`, uid).Scan(&rowsData.UID, &rowsData.Name)
+
+		if err == sql.ErrNoRows {
+			result <- query.QueryResult{Result: farm}
+			close(result)
+			return
+		}
+
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		farmUID, err := uuid.FromString(rowsData.UID)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		farm.UID = farmUID
+		farm.Name = rowsData.Name
+
+		result <- query.QueryResult{Result: farm}
+
+		close(result)
+	}()
+
+	return result
+}
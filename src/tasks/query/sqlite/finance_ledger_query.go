@@ -0,0 +1,119 @@
+package sqlite
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/tasks/query"
+	"github.com/Tanibox/tania-core/src/tasks/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type FinanceLedgerQuerySqlite struct {
+	DB *sql.DB
+}
+
+func NewFinanceLedgerQuerySqlite(db *sql.DB) query.FinanceLedgerQuery {
+	return FinanceLedgerQuerySqlite{DB: db}
+}
+
+func (s FinanceLedgerQuerySqlite) FindAllByFarmID(farmUID uuid.UUID, filter query.FinanceLedgerFilter) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		sqlQuery := `SELECT TASK_UID, FARM_UID, TITLE, AMOUNT, CURRENCY_CODE, DIRECTION, COUNTERPARTY, MATERIAL_UID, POSTED_DATE
+			FROM FINANCE_LEDGER_READ WHERE FARM_UID = ?`
+		params := []interface{}{farmUID}
+
+		if filter.StartDate != nil {
+			sqlQuery += " AND POSTED_DATE >= ?"
+			params = append(params, filter.StartDate.UTC().Format(time.RFC3339))
+		}
+
+		if filter.EndDate != nil {
+			sqlQuery += " AND POSTED_DATE < ?"
+			params = append(params, filter.EndDate.UTC().Format(time.RFC3339))
+		}
+
+		sqlQuery += " ORDER BY POSTED_DATE ASC"
+
+		rows, err := s.DB.Query(sqlQuery, params...)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+		defer rows.Close()
+
+		entries := []storage.FinanceLedgerEntry{}
+		for rows.Next() {
+			rowsData := struct {
+				TaskUID      string
+				FarmUID      string
+				Title        string
+				Amount       string
+				CurrencyCode string
+				Direction    string
+				Counterparty string
+				MaterialUID  sql.NullString
+				PostedDate   string
+			}{}
+
+			err = rows.Scan(
+				&rowsData.TaskUID, &rowsData.FarmUID, &rowsData.Title, &rowsData.Amount, &rowsData.CurrencyCode,
+				&rowsData.Direction, &rowsData.Counterparty, &rowsData.MaterialUID, &rowsData.PostedDate)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+				close(result)
+				return
+			}
+
+			entry := storage.FinanceLedgerEntry{
+				Title:        rowsData.Title,
+				Amount:       rowsData.Amount,
+				CurrencyCode: rowsData.CurrencyCode,
+				Direction:    rowsData.Direction,
+				Counterparty: rowsData.Counterparty,
+			}
+
+			entry.TaskUID, err = uuid.FromString(rowsData.TaskUID)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+				close(result)
+				return
+			}
+
+			entry.FarmUID, err = uuid.FromString(rowsData.FarmUID)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+				close(result)
+				return
+			}
+
+			if rowsData.MaterialUID.Valid && rowsData.MaterialUID.String != "" {
+				materialUID, err := uuid.FromString(rowsData.MaterialUID.String)
+				if err != nil {
+					result <- query.QueryResult{Error: err}
+					close(result)
+					return
+				}
+
+				entry.MaterialID = &materialUID
+			}
+
+			entry.PostedDate, err = time.Parse(time.RFC3339, rowsData.PostedDate)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+				close(result)
+				return
+			}
+
+			entries = append(entries, entry)
+		}
+
+		result <- query.QueryResult{Result: entries}
+		close(result)
+	}()
+
+	return result
+}
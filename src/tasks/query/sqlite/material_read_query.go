@@ -19,15 +19,18 @@ func (s MaterialQuerySqlite) FindMaterialByID(uid uuid.UUID) <-chan query.QueryR
 
 	go func() {
 		rowsData := struct {
-			UID      string
-			Name     string
-			Type     string
-			TypeData string
+			UID          string
+			Name         string
+			Type         string
+			TypeData     string
+			Quantity     float32
+			QuantityUnit string
 		}{}
 		material := query.TaskMaterialQueryResult{}
 
-		err := s.DB.QueryRow(`SELECT UID, NAME, TYPE, TYPE_DATA 
-			FROM MATERIAL_READ WHERE UID = ?`, uid).Scan(&rowsData.UID, &rowsData.Name, &rowsData.Type, &rowsData.TypeData)
+		err := s.DB.QueryRow(`SELECT UID, NAME, TYPE, TYPE_DATA, QUANTITY, QUANTITY_UNIT
+			FROM MATERIAL_READ WHERE UID = ?`, uid).Scan(&rowsData.UID, &rowsData.Name, &rowsData.Type, &rowsData.TypeData,
+			&rowsData.Quantity, &rowsData.QuantityUnit)
 
 		materialUID, err := uuid.FromString(rowsData.UID)
 		if err != nil {
@@ -38,6 +41,8 @@ func (s MaterialQuerySqlite) FindMaterialByID(uid uuid.UUID) <-chan query.QueryR
 		material.Name = rowsData.Name
 		material.TypeCode = rowsData.Type
 		material.DetailedTypeCode = rowsData.TypeData
+		material.Quantity = rowsData.Quantity
+		material.QuantityUnit = rowsData.QuantityUnit
 
 		result <- query.QueryResult{Result: material}
 
@@ -20,13 +20,14 @@ func (s ReservoirQuerySqlite) FindReservoirByID(uid uuid.UUID) <-chan query.Quer
 
 	go func() {
 		rowsData := struct {
-			UID  string
-			Name string
+			UID     string
+			Name    string
+			FarmUID sql.NullString
 		}{}
 		reservoir := query.TaskReservoirQueryResult{}
 
-		err := s.DB.QueryRow(`SELECT UID, NAME
-			FROM RESERVOIR_READ WHERE UID = ?`, uid).Scan(&rowsData.UID, &rowsData.Name)
+		err := s.DB.QueryRow(`SELECT UID, NAME, FARM_UID
+			FROM RESERVOIR_READ WHERE UID = ?`, uid).Scan(&rowsData.UID, &rowsData.Name, &rowsData.FarmUID)
 
 		reservoirUID, err := uuid.FromString(rowsData.UID)
 		if err != nil {
@@ -36,6 +37,13 @@ func (s ReservoirQuerySqlite) FindReservoirByID(uid uuid.UUID) <-chan query.Quer
 		reservoir.UID = reservoirUID
 		reservoir.Name = rowsData.Name
 
+		if rowsData.FarmUID.Valid {
+			farmUID, err := uuid.FromString(rowsData.FarmUID.String)
+			if err == nil {
+				reservoir.FarmID = farmUID
+			}
+		}
+
 		result <- query.QueryResult{Result: reservoir}
 
 		close(result)
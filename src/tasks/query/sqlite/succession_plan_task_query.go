@@ -0,0 +1,124 @@
+package sqlite
+
+import (
+	"database/sql"
+
+	"github.com/Tanibox/tania-core/src/tasks/query"
+	"github.com/Tanibox/tania-core/src/tasks/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type SuccessionPlanTaskQuerySqlite struct {
+	DB *sql.DB
+}
+
+func NewSuccessionPlanTaskQuerySqlite(db *sql.DB) query.SuccessionPlanTaskQuery {
+	return SuccessionPlanTaskQuerySqlite{DB: db}
+}
+
+// FindByPlannedBatchID returns an empty link when the planned crop batch has no task
+func (s SuccessionPlanTaskQuerySqlite) FindByPlannedBatchID(plannedBatchUID uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		rowsData := struct {
+			SuccessionPlanUID string
+			TaskUID           string
+		}{}
+		successionPlanTask := storage.SuccessionPlanTask{}
+
+		err := s.DB.QueryRow(`SELECT SUCCESSION_PLAN_UID, TASK_UID FROM SUCCESSION_PLAN_TASK
+			WHERE PLANNED_BATCH_UID = ?`, plannedBatchUID).
+			Scan(&rowsData.SuccessionPlanUID, &rowsData.TaskUID)
+
+		if err == sql.ErrNoRows {
+			result <- query.QueryResult{Result: successionPlanTask}
+			close(result)
+			return
+		}
+
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		successionPlanUID, err := uuid.FromString(rowsData.SuccessionPlanUID)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		taskUID, err := uuid.FromString(rowsData.TaskUID)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		successionPlanTask.PlannedBatchUID = plannedBatchUID
+		successionPlanTask.SuccessionPlanUID = successionPlanUID
+		successionPlanTask.TaskUID = taskUID
+
+		result <- query.QueryResult{Result: successionPlanTask}
+		close(result)
+	}()
+
+	return result
+}
+
+func (s SuccessionPlanTaskQuerySqlite) FindAllBySuccessionPlanID(successionPlanUID uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		rowsData := struct {
+			PlannedBatchUID string
+			TaskUID         string
+		}{}
+		successionPlanTasks := []storage.SuccessionPlanTask{}
+
+		rows, err := s.DB.Query(`SELECT PLANNED_BATCH_UID, TASK_UID FROM SUCCESSION_PLAN_TASK
+			WHERE SUCCESSION_PLAN_UID = ?`, successionPlanUID)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			err = rows.Scan(&rowsData.PlannedBatchUID, &rowsData.TaskUID)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+				close(result)
+				return
+			}
+
+			plannedBatchUID, err := uuid.FromString(rowsData.PlannedBatchUID)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+				close(result)
+				return
+			}
+
+			taskUID, err := uuid.FromString(rowsData.TaskUID)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+				close(result)
+				return
+			}
+
+			successionPlanTasks = append(successionPlanTasks, storage.SuccessionPlanTask{
+				PlannedBatchUID:   plannedBatchUID,
+				SuccessionPlanUID: successionPlanUID,
+				TaskUID:           taskUID,
+			})
+		}
+
+		result <- query.QueryResult{Result: successionPlanTasks}
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,79 @@
+package sqlite
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/tasks/query"
+	"github.com/Tanibox/tania-core/src/tasks/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type TaskCalendarFeedQuerySqlite struct {
+	DB *sql.DB
+}
+
+func NewTaskCalendarFeedQuerySqlite(db *sql.DB) query.TaskCalendarFeedQuery {
+	return TaskCalendarFeedQuerySqlite{DB: db}
+}
+
+func (s TaskCalendarFeedQuerySqlite) FindByUserID(userUID uuid.UUID) <-chan query.QueryResult {
+	return s.findOne(`SELECT USER_UID, TOKEN, CREATED_DATE
+		FROM TASK_CALENDAR_FEED WHERE USER_UID = ?`, userUID)
+}
+
+func (s TaskCalendarFeedQuerySqlite) FindByToken(token string) <-chan query.QueryResult {
+	return s.findOne(`SELECT USER_UID, TOKEN, CREATED_DATE
+		FROM TASK_CALENDAR_FEED WHERE TOKEN = ?`, token)
+}
+
+// findOne returns an empty feed when there is no row
+func (s TaskCalendarFeedQuerySqlite) findOne(sqlQuery string, arg interface{}) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		rowsData := struct {
+			UserUID     string
+			Token       string
+			CreatedDate string
+		}{}
+		feed := storage.TaskCalendarFeed{}
+
+		err := s.DB.QueryRow(sqlQuery, arg).Scan(&rowsData.UserUID, &rowsData.Token, &rowsData.CreatedDate)
+
+		if err == sql.ErrNoRows {
+			result <- query.QueryResult{Result: feed}
+			close(result)
+			return
+		}
+
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		userUID, err := uuid.FromString(rowsData.UserUID)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		createdDate, err := time.Parse(time.RFC3339, rowsData.CreatedDate)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		feed.UserUID = userUID
+		feed.Token = rowsData.Token
+		feed.CreatedDate = createdDate
+
+		result <- query.QueryResult{Result: feed}
+		close(result)
+	}()
+
+	return result
+}
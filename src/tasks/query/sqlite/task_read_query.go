@@ -2,6 +2,7 @@ package sqlite
 
 import (
 	"database/sql"
+	"encoding/json"
 	"strconv"
 	"time"
 
@@ -36,6 +37,15 @@ type taskReadQueryResult struct {
 	Category             string
 	IsDue                bool
 	AssetID              sql.NullString
+	RecurrenceRule       sql.NullString
+	SeriesUID            sql.NullString
+	AssigneeUID          sql.NullString
+	Checklist            sql.NullString
+	BlockedBy            sql.NullString
+	Comments             sql.NullString
+	Attachments          sql.NullString
+	MaterialConsumption  sql.NullString
+	DomainDataFinance    sql.NullString
 }
 
 func (r TaskReadQuerySqlite) FindAll(page, limit int) <-chan query.QueryResult {
@@ -146,6 +156,11 @@ func (s TaskReadQuerySqlite) FindTasksWithFilter(params map[string]string, page,
 			sql += " AND ASSET_ID = ? "
 			args = append(args, assetID)
 		}
+		if value, _ := params["assignee"]; value != "" {
+			assigneeID, _ := uuid.FromString(value)
+			sql += " AND ASSIGNEE_UID = ? "
+			args = append(args, assigneeID)
+		}
 
     if page != 0 && limit != 0 {
       sql += " LIMIT ? OFFSET ?"
@@ -240,6 +255,11 @@ func (q TaskReadQuerySqlite) CountTasksWithFilter(params map[string]string) <-ch
       sql += " AND ASSET_ID = ? "
       args = append(args, assetID)
     }
+    if value, _ := params["assignee"]; value != "" {
+      assigneeID, _ := uuid.FromString(value)
+      sql += " AND ASSIGNEE_UID = ? "
+      args = append(args, assigneeID)
+    }
 
     err := q.DB.QueryRow(sql, args...).Scan(&total)
     if err != nil {
@@ -262,6 +282,10 @@ func (s TaskReadQuerySqlite) populateQueryResult(rows *sql.Rows) (storage.TaskRe
 		&rowsData.Priority, &rowsData.Status, &rowsData.DomainCode, &rowsData.DomainDataMaterialID,
 		&rowsData.DomainDataAreaID,
 		&rowsData.Category, &rowsData.IsDue, &rowsData.AssetID,
+		&rowsData.RecurrenceRule, &rowsData.SeriesUID, &rowsData.AssigneeUID,
+		&rowsData.Checklist, &rowsData.BlockedBy,
+		&rowsData.Comments, &rowsData.Attachments,
+		&rowsData.MaterialConsumption, &rowsData.DomainDataFinance,
 	)
 
 	if err != nil {
@@ -345,7 +369,15 @@ func (s TaskReadQuerySqlite) populateQueryResult(rows *sql.Rows) (storage.TaskRe
 			MaterialID: materialID,
 			AreaID:     areaID}
 	case domain.TaskDomainFinanceCode:
-		domainDetails = domain.TaskDomainFinance{}
+		finance := domain.TaskDomainFinance{}
+		if rowsData.DomainDataFinance.Valid && rowsData.DomainDataFinance.String != "" {
+			err = json.Unmarshal([]byte(rowsData.DomainDataFinance.String), &finance)
+			if err != nil {
+				return storage.TaskRead{}, err
+			}
+		}
+
+		domainDetails = finance
 	case domain.TaskDomainGeneralCode:
 		domainDetails = domain.TaskDomainGeneral{}
 	case domain.TaskDomainInventoryCode:
@@ -375,6 +407,66 @@ func (s TaskReadQuerySqlite) populateQueryResult(rows *sql.Rows) (storage.TaskRe
 		assetUID = &uid
 	}
 
+	var seriesUID *uuid.UUID
+	if rowsData.SeriesUID.Valid && rowsData.SeriesUID.String != "" {
+		uid, err := uuid.FromString(rowsData.SeriesUID.String)
+		if err != nil {
+			return storage.TaskRead{}, err
+		}
+
+		seriesUID = &uid
+	}
+
+	var assigneeUID *uuid.UUID
+	if rowsData.AssigneeUID.Valid && rowsData.AssigneeUID.String != "" {
+		uid, err := uuid.FromString(rowsData.AssigneeUID.String)
+		if err != nil {
+			return storage.TaskRead{}, err
+		}
+
+		assigneeUID = &uid
+	}
+
+	checklist := []domain.ChecklistItem{}
+	if rowsData.Checklist.Valid && rowsData.Checklist.String != "" {
+		err = json.Unmarshal([]byte(rowsData.Checklist.String), &checklist)
+		if err != nil {
+			return storage.TaskRead{}, err
+		}
+	}
+
+	blockedBy := []uuid.UUID{}
+	if rowsData.BlockedBy.Valid && rowsData.BlockedBy.String != "" {
+		err = json.Unmarshal([]byte(rowsData.BlockedBy.String), &blockedBy)
+		if err != nil {
+			return storage.TaskRead{}, err
+		}
+	}
+
+	comments := []domain.TaskComment{}
+	if rowsData.Comments.Valid && rowsData.Comments.String != "" {
+		err = json.Unmarshal([]byte(rowsData.Comments.String), &comments)
+		if err != nil {
+			return storage.TaskRead{}, err
+		}
+	}
+
+	attachments := []domain.TaskAttachment{}
+	if rowsData.Attachments.Valid && rowsData.Attachments.String != "" {
+		err = json.Unmarshal([]byte(rowsData.Attachments.String), &attachments)
+		if err != nil {
+			return storage.TaskRead{}, err
+		}
+	}
+
+	var materialConsumption *domain.TaskMaterialConsumption
+	if rowsData.MaterialConsumption.Valid && rowsData.MaterialConsumption.String != "" {
+		err = json.Unmarshal([]byte(rowsData.MaterialConsumption.String), &materialConsumption)
+		if err != nil {
+			return storage.TaskRead{}, err
+		}
+	}
+
 	return storage.TaskRead{
 		UID:           taskUID,
 		Title:         rowsData.Title,
@@ -390,5 +482,95 @@ func (s TaskReadQuerySqlite) populateQueryResult(rows *sql.Rows) (storage.TaskRe
 		Category:      rowsData.Category,
 		IsDue:         rowsData.IsDue,
 		AssetID:       assetUID,
+
+		RecurrenceRule: rowsData.RecurrenceRule.String,
+		SeriesID:       seriesUID,
+
+		AssigneeID: assigneeUID,
+
+		Checklist: checklist,
+		BlockedBy: blockedBy,
+
+		Comments:    comments,
+		Attachments: attachments,
+
+		MaterialConsumption: materialConsumption,
 	}, nil
 }
+
+// FindAllDueBefore returns the open tasks which are not marked as due yet
+// and whose due date is before the date.
+// The due dates are compared after parsing, because they are stored with their time zone offset.
+func (s TaskReadQuerySqlite) FindAllDueBefore(date time.Time) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		tasks := []storage.TaskRead{}
+
+		rows, err := s.DB.Query(`SELECT * FROM TASK_READ
+			WHERE STATUS = ? AND IS_DUE = ? AND DUE_DATE IS NOT NULL AND DUE_DATE != ''`,
+			domain.TaskStatusCreated, false)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			taskRead, err := s.populateQueryResult(rows)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+				close(result)
+				return
+			}
+
+			if taskRead.DueDate != nil && taskRead.DueDate.Before(date) {
+				tasks = append(tasks, taskRead)
+			}
+		}
+
+		result <- query.QueryResult{Result: tasks}
+		close(result)
+	}()
+
+	return result
+}
+
+// FindAllOpenDueBefore returns the open tasks whose due date is before the date,
+// including the ones already marked as due
+func (s TaskReadQuerySqlite) FindAllOpenDueBefore(date time.Time) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		tasks := []storage.TaskRead{}
+
+		rows, err := s.DB.Query(`SELECT * FROM TASK_READ
+			WHERE STATUS = ? AND DUE_DATE IS NOT NULL AND DUE_DATE != ''`,
+			domain.TaskStatusCreated)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			taskRead, err := s.populateQueryResult(rows)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+				close(result)
+				return
+			}
+
+			if taskRead.DueDate != nil && taskRead.DueDate.Before(date) {
+				tasks = append(tasks, taskRead)
+			}
+		}
+
+		result <- query.QueryResult{Result: tasks}
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,124 @@
+package sqlite
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/tasks/query"
+	"github.com/Tanibox/tania-core/src/tasks/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type TaskReminderPreferenceQuerySqlite struct {
+	DB *sql.DB
+}
+
+func NewTaskReminderPreferenceQuerySqlite(db *sql.DB) query.TaskReminderPreferenceQuery {
+	return TaskReminderPreferenceQuerySqlite{DB: db}
+}
+
+// FindByUserID returns an empty preference when the user has none
+func (s TaskReminderPreferenceQuerySqlite) FindByUserID(userUID uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		preferences, err := s.findAll(`SELECT USER_UID, CHANNEL, RECIPIENT, CATEGORIES, PRIORITIES, REMIND_BEFORE, REMIND_OVERDUE, LAST_UPDATED
+			FROM TASK_REMINDER_PREFERENCE WHERE USER_UID = ?`, userUID)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		preference := storage.TaskReminderPreference{}
+		if len(preferences) > 0 {
+			preference = preferences[0]
+		}
+
+		result <- query.QueryResult{Result: preference}
+		close(result)
+	}()
+
+	return result
+}
+
+func (s TaskReminderPreferenceQuerySqlite) FindAll() <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		preferences, err := s.findAll(`SELECT USER_UID, CHANNEL, RECIPIENT, CATEGORIES, PRIORITIES, REMIND_BEFORE, REMIND_OVERDUE, LAST_UPDATED
+			FROM TASK_REMINDER_PREFERENCE`)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		result <- query.QueryResult{Result: preferences}
+		close(result)
+	}()
+
+	return result
+}
+
+func (s TaskReminderPreferenceQuerySqlite) findAll(sqlQuery string, args ...interface{}) ([]storage.TaskReminderPreference, error) {
+	preferences := []storage.TaskReminderPreference{}
+
+	rows, err := s.DB.Query(sqlQuery, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		rowsData := struct {
+			UserUID       string
+			Channel       string
+			Recipient     string
+			Categories    string
+			Priorities    string
+			RemindBefore  int64
+			RemindOverdue bool
+			LastUpdated   string
+		}{}
+
+		err = rows.Scan(&rowsData.UserUID, &rowsData.Channel, &rowsData.Recipient, &rowsData.Categories,
+			&rowsData.Priorities, &rowsData.RemindBefore, &rowsData.RemindOverdue, &rowsData.LastUpdated)
+		if err != nil {
+			return nil, err
+		}
+
+		preference := storage.TaskReminderPreference{
+			Channel:   rowsData.Channel,
+			Recipient: rowsData.Recipient,
+		}
+
+		preference.UserUID, err = uuid.FromString(rowsData.UserUID)
+		if err != nil {
+			return nil, err
+		}
+
+		err = json.Unmarshal([]byte(rowsData.Categories), &preference.Categories)
+		if err != nil {
+			return nil, err
+		}
+
+		err = json.Unmarshal([]byte(rowsData.Priorities), &preference.Priorities)
+		if err != nil {
+			return nil, err
+		}
+
+		preference.RemindBefore = time.Duration(rowsData.RemindBefore) * time.Second
+		preference.RemindOverdue = rowsData.RemindOverdue
+
+		preference.LastUpdated, err = time.Parse(time.RFC3339, rowsData.LastUpdated)
+		if err != nil {
+			return nil, err
+		}
+
+		preferences = append(preferences, preference)
+	}
+
+	return preferences, rows.Err()
+}
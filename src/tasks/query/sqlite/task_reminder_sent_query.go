@@ -0,0 +1,72 @@
+package sqlite
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/tasks/query"
+	"github.com/Tanibox/tania-core/src/tasks/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type TaskReminderSentQuerySqlite struct {
+	DB *sql.DB
+}
+
+func NewTaskReminderSentQuerySqlite(db *sql.DB) query.TaskReminderSentQuery {
+	return TaskReminderSentQuerySqlite{DB: db}
+}
+
+// FindByID returns an empty reminder when it was never sent
+func (s TaskReminderSentQuerySqlite) FindByID(taskUID, userUID uuid.UUID, kind string) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		rowsData := struct {
+			DueDate  string
+			SentDate string
+		}{}
+		sent := storage.TaskReminderSent{}
+
+		err := s.DB.QueryRow(`SELECT DUE_DATE, SENT_DATE FROM TASK_REMINDER_SENT
+			WHERE TASK_UID = ? AND USER_UID = ? AND KIND = ?`, taskUID, userUID, kind).
+			Scan(&rowsData.DueDate, &rowsData.SentDate)
+
+		if err == sql.ErrNoRows {
+			result <- query.QueryResult{Result: sent}
+			close(result)
+			return
+		}
+
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		dueDate, err := time.Parse(time.RFC3339, rowsData.DueDate)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		sentDate, err := time.Parse(time.RFC3339, rowsData.SentDate)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		sent.TaskUID = taskUID
+		sent.UserUID = userUID
+		sent.Kind = kind
+		sent.DueDate = dueDate
+		sent.SentDate = sentDate
+
+		result <- query.QueryResult{Result: sent}
+		close(result)
+	}()
+
+	return result
+}
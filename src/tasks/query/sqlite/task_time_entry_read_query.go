@@ -0,0 +1,176 @@
+package sqlite
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/tasks/query"
+	"github.com/Tanibox/tania-core/src/tasks/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type TaskTimeEntryReadQuerySqlite struct {
+	DB *sql.DB
+}
+
+type taskTimeEntryReadResult struct {
+	UID        string
+	TaskUID    string
+	WorkerUID  sql.NullString
+	HourlyRate float64
+	StartDate  string
+	EndDate    sql.NullString
+	Duration   int64
+}
+
+func NewTaskTimeEntryReadQuerySqlite(db *sql.DB) query.TaskTimeEntryReadQuery {
+	return TaskTimeEntryReadQuerySqlite{DB: db}
+}
+
+func (s TaskTimeEntryReadQuerySqlite) FindByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		rowsData := taskTimeEntryReadResult{}
+		timeEntry := storage.TaskTimeEntryRead{}
+
+		err := s.DB.QueryRow(`SELECT UID, TASK_UID, WORKER_UID, HOURLY_RATE, START_DATE, END_DATE, DURATION
+			FROM TASK_TIME_ENTRY_READ WHERE UID = ?`, uid).Scan(
+			&rowsData.UID, &rowsData.TaskUID, &rowsData.WorkerUID, &rowsData.HourlyRate,
+			&rowsData.StartDate, &rowsData.EndDate, &rowsData.Duration)
+
+		if err == sql.ErrNoRows {
+			result <- query.QueryResult{Result: timeEntry}
+			close(result)
+			return
+		}
+
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		timeEntry, err = rowsData.toTimeEntryRead()
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		result <- query.QueryResult{Result: timeEntry}
+		close(result)
+	}()
+
+	return result
+}
+
+func (s TaskTimeEntryReadQuerySqlite) FindAllWithFilter(filter query.TaskTimeEntryFilter) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		sqlQuery := `SELECT UID, TASK_UID, WORKER_UID, HOURLY_RATE, START_DATE, END_DATE, DURATION
+			FROM TASK_TIME_ENTRY_READ WHERE 1 = 1`
+		var params []interface{}
+
+		if filter.TaskUID != nil {
+			sqlQuery += " AND TASK_UID = ?"
+			params = append(params, *filter.TaskUID)
+		}
+
+		if filter.StartDate != nil {
+			sqlQuery += " AND START_DATE >= ?"
+			params = append(params, filter.StartDate.UTC().Format(time.RFC3339))
+		}
+
+		if filter.EndDate != nil {
+			sqlQuery += " AND START_DATE < ?"
+			params = append(params, filter.EndDate.UTC().Format(time.RFC3339))
+		}
+
+		sqlQuery += " ORDER BY START_DATE ASC"
+
+		rows, err := s.DB.Query(sqlQuery, params...)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+		defer rows.Close()
+
+		entries := []storage.TaskTimeEntryRead{}
+		for rows.Next() {
+			rowsData := taskTimeEntryReadResult{}
+
+			err = rows.Scan(
+				&rowsData.UID, &rowsData.TaskUID, &rowsData.WorkerUID, &rowsData.HourlyRate,
+				&rowsData.StartDate, &rowsData.EndDate, &rowsData.Duration)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+				close(result)
+				return
+			}
+
+			timeEntry, err := rowsData.toTimeEntryRead()
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+				close(result)
+				return
+			}
+
+			entries = append(entries, timeEntry)
+		}
+
+		result <- query.QueryResult{Result: entries}
+		close(result)
+	}()
+
+	return result
+}
+
+func (r taskTimeEntryReadResult) toTimeEntryRead() (storage.TaskTimeEntryRead, error) {
+	uid, err := uuid.FromString(r.UID)
+	if err != nil {
+		return storage.TaskTimeEntryRead{}, err
+	}
+
+	taskUID, err := uuid.FromString(r.TaskUID)
+	if err != nil {
+		return storage.TaskTimeEntryRead{}, err
+	}
+
+	var workerID *uuid.UUID
+	if r.WorkerUID.Valid && r.WorkerUID.String != "" {
+		w, err := uuid.FromString(r.WorkerUID.String)
+		if err != nil {
+			return storage.TaskTimeEntryRead{}, err
+		}
+
+		workerID = &w
+	}
+
+	startDate, err := time.Parse(time.RFC3339, r.StartDate)
+	if err != nil {
+		return storage.TaskTimeEntryRead{}, err
+	}
+
+	var endDate *time.Time
+	if r.EndDate.Valid && r.EndDate.String != "" {
+		d, err := time.Parse(time.RFC3339, r.EndDate.String)
+		if err != nil {
+			return storage.TaskTimeEntryRead{}, err
+		}
+
+		endDate = &d
+	}
+
+	return storage.TaskTimeEntryRead{
+		UID:        uid,
+		TaskUID:    taskUID,
+		WorkerID:   workerID,
+		HourlyRate: r.HourlyRate,
+		StartDate:  startDate,
+		EndDate:    endDate,
+		Duration:   time.Duration(r.Duration) * time.Second,
+	}, nil
+}
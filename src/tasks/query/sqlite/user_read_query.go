@@ -0,0 +1,58 @@
+package sqlite
+
+import (
+	"database/sql"
+
+	"github.com/Tanibox/tania-core/src/tasks/query"
+	uuid "github.com/satori/go.uuid"
+)
+
+type UserQuerySqlite struct {
+	DB *sql.DB
+}
+
+func NewUserQuerySqlite(db *sql.DB) query.UserQuery {
+	return UserQuerySqlite{DB: db}
+}
+
+func (s UserQuerySqlite) FindUserByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		rowsData := struct {
+			UID      string
+			Username string
+		}{}
+		user := query.TaskUserQueryResult{}
+
+		err := s.DB.QueryRow(`SELECT UID, USERNAME
+			FROM USER_READ WHERE UID = ?`, uid).Scan(&rowsData.UID, &rowsData.Username)
+
+		if err == sql.ErrNoRows {
+			result <- query.QueryResult{Result: user}
+			close(result)
+			return
+		}
+
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		userUID, err := uuid.FromString(rowsData.UID)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		user.UID = userUID
+		user.Username = rowsData.Username
+
+		result <- query.QueryResult{Result: user}
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,31 @@
+package inmemory
+
+import (
+	"github.com/Tanibox/tania-core/src/tasks/repository"
+	"github.com/Tanibox/tania-core/src/tasks/storage"
+)
+
+type FinanceLedgerRepositoryInMemory struct {
+	Storage *storage.FinanceLedgerStorage
+}
+
+func NewFinanceLedgerRepositoryInMemory(s *storage.FinanceLedgerStorage) repository.FinanceLedgerRepository {
+	return &FinanceLedgerRepositoryInMemory{Storage: s}
+}
+
+func (f *FinanceLedgerRepositoryInMemory) Save(entry *storage.FinanceLedgerEntry) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		f.Storage.Lock.Lock()
+		defer f.Storage.Lock.Unlock()
+
+		f.Storage.FinanceLedgerEntryMap[entry.TaskUID] = *entry
+
+		result <- nil
+
+		close(result)
+	}()
+
+	return result
+}
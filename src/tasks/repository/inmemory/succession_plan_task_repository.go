@@ -0,0 +1,31 @@
+package inmemory
+
+import (
+	"github.com/Tanibox/tania-core/src/tasks/repository"
+	"github.com/Tanibox/tania-core/src/tasks/storage"
+)
+
+type SuccessionPlanTaskRepositoryInMemory struct {
+	Storage *storage.SuccessionPlanTaskStorage
+}
+
+func NewSuccessionPlanTaskRepositoryInMemory(s *storage.SuccessionPlanTaskStorage) repository.SuccessionPlanTaskRepository {
+	return &SuccessionPlanTaskRepositoryInMemory{Storage: s}
+}
+
+func (f *SuccessionPlanTaskRepositoryInMemory) Save(successionPlanTask *storage.SuccessionPlanTask) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		f.Storage.Lock.Lock()
+		defer f.Storage.Lock.Unlock()
+
+		f.Storage.SuccessionPlanTaskMap[successionPlanTask.PlannedBatchUID] = *successionPlanTask
+
+		result <- nil
+
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,31 @@
+package inmemory
+
+import (
+	"github.com/Tanibox/tania-core/src/tasks/repository"
+	"github.com/Tanibox/tania-core/src/tasks/storage"
+)
+
+type TaskCalendarFeedRepositoryInMemory struct {
+	Storage *storage.TaskCalendarFeedStorage
+}
+
+func NewTaskCalendarFeedRepositoryInMemory(s *storage.TaskCalendarFeedStorage) repository.TaskCalendarFeedRepository {
+	return &TaskCalendarFeedRepositoryInMemory{Storage: s}
+}
+
+func (f *TaskCalendarFeedRepositoryInMemory) Save(feed *storage.TaskCalendarFeed) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		f.Storage.Lock.Lock()
+		defer f.Storage.Lock.Unlock()
+
+		f.Storage.TaskCalendarFeedMap[feed.UserUID] = *feed
+
+		result <- nil
+
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,31 @@
+package inmemory
+
+import (
+	"github.com/Tanibox/tania-core/src/tasks/repository"
+	"github.com/Tanibox/tania-core/src/tasks/storage"
+)
+
+type TaskReminderPreferenceRepositoryInMemory struct {
+	Storage *storage.TaskReminderPreferenceStorage
+}
+
+func NewTaskReminderPreferenceRepositoryInMemory(s *storage.TaskReminderPreferenceStorage) repository.TaskReminderPreferenceRepository {
+	return &TaskReminderPreferenceRepositoryInMemory{Storage: s}
+}
+
+func (f *TaskReminderPreferenceRepositoryInMemory) Save(preference *storage.TaskReminderPreference) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		f.Storage.Lock.Lock()
+		defer f.Storage.Lock.Unlock()
+
+		f.Storage.TaskReminderPreferenceMap[preference.UserUID] = *preference
+
+		result <- nil
+
+		close(result)
+	}()
+
+	return result
+}
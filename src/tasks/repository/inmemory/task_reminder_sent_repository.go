@@ -0,0 +1,32 @@
+package inmemory
+
+import (
+	"github.com/Tanibox/tania-core/src/tasks/repository"
+	"github.com/Tanibox/tania-core/src/tasks/storage"
+)
+
+type TaskReminderSentRepositoryInMemory struct {
+	Storage *storage.TaskReminderSentStorage
+}
+
+func NewTaskReminderSentRepositoryInMemory(s *storage.TaskReminderSentStorage) repository.TaskReminderSentRepository {
+	return &TaskReminderSentRepositoryInMemory{Storage: s}
+}
+
+func (f *TaskReminderSentRepositoryInMemory) Save(sent *storage.TaskReminderSent) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		f.Storage.Lock.Lock()
+		defer f.Storage.Lock.Unlock()
+
+		key := storage.TaskReminderSentKey{TaskUID: sent.TaskUID, UserUID: sent.UserUID, Kind: sent.Kind}
+		f.Storage.TaskReminderSentMap[key] = *sent
+
+		result <- nil
+
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,49 @@
+package inmemory
+
+import (
+	"github.com/Tanibox/tania-core/src/tasks/repository"
+	"github.com/Tanibox/tania-core/src/tasks/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type TaskTimeEntryReadRepositoryInMemory struct {
+	Storage *storage.TaskTimeEntryReadStorage
+}
+
+func NewTaskTimeEntryReadRepositoryInMemory(s *storage.TaskTimeEntryReadStorage) repository.TaskTimeEntryReadRepository {
+	return &TaskTimeEntryReadRepositoryInMemory{Storage: s}
+}
+
+func (f *TaskTimeEntryReadRepositoryInMemory) Save(timeEntryRead *storage.TaskTimeEntryRead) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		f.Storage.Lock.Lock()
+		defer f.Storage.Lock.Unlock()
+
+		f.Storage.TaskTimeEntryReadMap[timeEntryRead.UID] = *timeEntryRead
+
+		result <- nil
+
+		close(result)
+	}()
+
+	return result
+}
+
+func (f *TaskTimeEntryReadRepositoryInMemory) Remove(uid uuid.UUID) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		f.Storage.Lock.Lock()
+		defer f.Storage.Lock.Unlock()
+
+		delete(f.Storage.TaskTimeEntryReadMap, uid)
+
+		result <- nil
+
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,39 @@
+package sqlite
+
+import (
+	"database/sql"
+
+	"github.com/Tanibox/tania-core/src/tasks/repository"
+	"github.com/Tanibox/tania-core/src/tasks/storage"
+)
+
+type FinanceLedgerRepositoryMysql struct {
+	DB *sql.DB
+}
+
+func NewFinanceLedgerRepositoryMysql(db *sql.DB) repository.FinanceLedgerRepository {
+	return &FinanceLedgerRepositoryMysql{DB: db}
+}
+
+// Save replaces the entry when the task is posted again
+func (f *FinanceLedgerRepositoryMysql) Save(entry *storage.FinanceLedgerEntry) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		var materialUID []byte
+		if entry.MaterialID != nil {
+			materialUID = entry.MaterialID.Bytes()
+		}
+
+		_, err := f.DB.Exec(`REPLACE INTO FINANCE_LEDGER_READ
+			(TASK_UID, FARM_UID, TITLE, AMOUNT, CURRENCY_CODE, DIRECTION, COUNTERPARTY, MATERIAL_UID, POSTED_DATE)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			entry.TaskUID.Bytes(), entry.FarmUID.Bytes(), entry.Title, entry.Amount, entry.CurrencyCode, entry.Direction,
+			entry.Counterparty, materialUID, entry.PostedDate)
+
+		result <- err
+		close(result)
+	}()
+
+	return result
+}
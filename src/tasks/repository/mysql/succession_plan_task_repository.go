@@ -0,0 +1,34 @@
+package sqlite
+
+import (
+	"database/sql"
+
+	"github.com/Tanibox/tania-core/src/tasks/repository"
+	"github.com/Tanibox/tania-core/src/tasks/storage"
+)
+
+type SuccessionPlanTaskRepositoryMysql struct {
+	DB *sql.DB
+}
+
+func NewSuccessionPlanTaskRepositoryMysql(db *sql.DB) repository.SuccessionPlanTaskRepository {
+	return &SuccessionPlanTaskRepositoryMysql{DB: db}
+}
+
+func (f *SuccessionPlanTaskRepositoryMysql) Save(successionPlanTask *storage.SuccessionPlanTask) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		_, err := f.DB.Exec(`INSERT INTO SUCCESSION_PLAN_TASK (PLANNED_BATCH_UID, SUCCESSION_PLAN_UID, TASK_UID)
+			VALUES (?, ?, ?)
+			ON DUPLICATE KEY UPDATE SUCCESSION_PLAN_UID = VALUES(SUCCESSION_PLAN_UID), TASK_UID = VALUES(TASK_UID)`,
+			successionPlanTask.PlannedBatchUID.Bytes(),
+			successionPlanTask.SuccessionPlanUID.Bytes(),
+			successionPlanTask.TaskUID.Bytes())
+
+		result <- err
+		close(result)
+	}()
+
+	return result
+}
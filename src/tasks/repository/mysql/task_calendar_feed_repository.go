@@ -0,0 +1,31 @@
+package sqlite
+
+import (
+	"database/sql"
+
+	"github.com/Tanibox/tania-core/src/tasks/repository"
+	"github.com/Tanibox/tania-core/src/tasks/storage"
+)
+
+type TaskCalendarFeedRepositoryMysql struct {
+	DB *sql.DB
+}
+
+func NewTaskCalendarFeedRepositoryMysql(db *sql.DB) repository.TaskCalendarFeedRepository {
+	return &TaskCalendarFeedRepositoryMysql{DB: db}
+}
+
+func (f *TaskCalendarFeedRepositoryMysql) Save(feed *storage.TaskCalendarFeed) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		_, err := f.DB.Exec(`INSERT INTO TASK_CALENDAR_FEED (USER_UID, TOKEN, CREATED_DATE) VALUES (?, ?, ?)
+			ON DUPLICATE KEY UPDATE TOKEN = VALUES(TOKEN), CREATED_DATE = VALUES(CREATED_DATE)`,
+			feed.UserUID.Bytes(), feed.Token, feed.CreatedDate)
+
+		result <- err
+		close(result)
+	}()
+
+	return result
+}
@@ -2,6 +2,7 @@ package sqlite
 
 import (
 	"database/sql"
+	"encoding/json"
 
 	"github.com/Tanibox/tania-core/src/tasks/domain"
 	"github.com/Tanibox/tania-core/src/tasks/repository"
@@ -32,21 +33,71 @@ func (f *TaskReadRepositoryMysql) Save(taskRead *storage.TaskRead) <-chan error
 			}
 		}
 
+		var domainDataFinance *string
+		if v, ok := taskRead.DomainDetails.(domain.TaskDomainFinance); ok {
+			finance, err := json.Marshal(v)
+			if err != nil {
+				result <- err
+			}
+
+			d := string(finance)
+			domainDataFinance = &d
+		}
+
 		var assetID []byte
 		if taskRead.AssetID != nil {
 			assetID = taskRead.AssetID.Bytes()
 		}
 
+		var seriesUID []byte
+		if taskRead.SeriesID != nil {
+			seriesUID = taskRead.SeriesID.Bytes()
+		}
+
+		var assigneeUID []byte
+		if taskRead.AssigneeID != nil {
+			assigneeUID = taskRead.AssigneeID.Bytes()
+		}
+
+		checklist, err := json.Marshal(taskRead.Checklist)
+		if err != nil {
+			result <- err
+		}
+
+		blockedBy, err := json.Marshal(taskRead.BlockedBy)
+		if err != nil {
+			result <- err
+		}
+
+		comments, err := json.Marshal(taskRead.Comments)
+		if err != nil {
+			result <- err
+		}
+
+		attachments, err := json.Marshal(taskRead.Attachments)
+		if err != nil {
+			result <- err
+		}
+
+		materialConsumption, err := json.Marshal(taskRead.MaterialConsumption)
+		if err != nil {
+			result <- err
+		}
+
 		res, err := f.DB.Exec(`UPDATE TASK_READ SET
 			TITLE = ?, DESCRIPTION = ?, CREATED_DATE = ?, DUE_DATE = ?,
 			COMPLETED_DATE = ?, CANCELLED_DATE = ?, PRIORITY = ?, STATUS = ?,
 			DOMAIN_CODE = ?, DOMAIN_DATA_MATERIAL_ID = ?, DOMAIN_DATA_AREA_ID = ?,
-			CATEGORY = ?, IS_DUE = ?, ASSET_ID = ?
+			CATEGORY = ?, IS_DUE = ?, ASSET_ID = ?, RECURRENCE_RULE = ?, SERIES_UID = ?,
+			ASSIGNEE_UID = ?, CHECKLIST = ?, BLOCKED_BY = ?,
+			COMMENTS = ?, ATTACHMENTS = ?, MATERIAL_CONSUMPTION = ?, DOMAIN_DATA_FINANCE = ?
 			WHERE UID = ?`,
 			taskRead.Title, taskRead.Description, taskRead.CreatedDate, taskRead.DueDate,
 			taskRead.CompletedDate, taskRead.CancelledDate, taskRead.Priority, taskRead.Status,
 			taskRead.Domain, domainDataMaterialID, domainDataAreaID,
-			taskRead.Category, taskRead.IsDue, assetID,
+			taskRead.Category, taskRead.IsDue, assetID, taskRead.RecurrenceRule, seriesUID,
+			assigneeUID, string(checklist), string(blockedBy),
+			string(comments), string(attachments), string(materialConsumption), domainDataFinance,
 			taskRead.UID.Bytes())
 
 		if err != nil {
@@ -65,12 +116,16 @@ func (f *TaskReadRepositoryMysql) Save(taskRead *storage.TaskRead) <-chan error
 			_, err := f.DB.Exec(`INSERT INTO TASK_READ (
 				UID, TITLE, DESCRIPTION, CREATED_DATE, DUE_DATE,
 				COMPLETED_DATE, CANCELLED_DATE, PRIORITY, STATUS,
-				DOMAIN_CODE, DOMAIN_DATA_MATERIAL_ID, DOMAIN_DATA_AREA_ID, CATEGORY, IS_DUE, ASSET_ID)
-				VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+				DOMAIN_CODE, DOMAIN_DATA_MATERIAL_ID, DOMAIN_DATA_AREA_ID, CATEGORY, IS_DUE, ASSET_ID,
+				RECURRENCE_RULE, SERIES_UID, ASSIGNEE_UID, CHECKLIST, BLOCKED_BY,
+				COMMENTS, ATTACHMENTS, MATERIAL_CONSUMPTION, DOMAIN_DATA_FINANCE)
+				VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
 				taskRead.UID.Bytes(), taskRead.Title, taskRead.Description, taskRead.CreatedDate, taskRead.DueDate,
 				taskRead.CompletedDate, taskRead.CancelledDate, taskRead.Priority, taskRead.Status,
 				taskRead.Domain, domainDataMaterialID, domainDataAreaID,
-				taskRead.Category, taskRead.IsDue, assetID)
+				taskRead.Category, taskRead.IsDue, assetID, taskRead.RecurrenceRule, seriesUID,
+				assigneeUID, string(checklist), string(blockedBy),
+				string(comments), string(attachments), string(materialConsumption), domainDataFinance)
 
 			if err != nil {
 				result <- err
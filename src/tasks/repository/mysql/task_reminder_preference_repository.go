@@ -0,0 +1,53 @@
+package sqlite
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/tasks/repository"
+	"github.com/Tanibox/tania-core/src/tasks/storage"
+)
+
+type TaskReminderPreferenceRepositoryMysql struct {
+	DB *sql.DB
+}
+
+func NewTaskReminderPreferenceRepositoryMysql(db *sql.DB) repository.TaskReminderPreferenceRepository {
+	return &TaskReminderPreferenceRepositoryMysql{DB: db}
+}
+
+// Save writes the categories and the priorities as JSON, and the time before the due date in seconds
+func (f *TaskReminderPreferenceRepositoryMysql) Save(preference *storage.TaskReminderPreference) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		categories, err := json.Marshal(preference.Categories)
+		if err != nil {
+			result <- err
+			close(result)
+			return
+		}
+
+		priorities, err := json.Marshal(preference.Priorities)
+		if err != nil {
+			result <- err
+			close(result)
+			return
+		}
+
+		_, err = f.DB.Exec(`INSERT INTO TASK_REMINDER_PREFERENCE
+			(USER_UID, CHANNEL, RECIPIENT, CATEGORIES, PRIORITIES, REMIND_BEFORE, REMIND_OVERDUE, LAST_UPDATED)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+			ON DUPLICATE KEY UPDATE CHANNEL = VALUES(CHANNEL), RECIPIENT = VALUES(RECIPIENT),
+			CATEGORIES = VALUES(CATEGORIES), PRIORITIES = VALUES(PRIORITIES), REMIND_BEFORE = VALUES(REMIND_BEFORE),
+			REMIND_OVERDUE = VALUES(REMIND_OVERDUE), LAST_UPDATED = VALUES(LAST_UPDATED)`,
+			preference.UserUID.Bytes(), preference.Channel, preference.Recipient, string(categories), string(priorities),
+			int64(preference.RemindBefore/time.Second), preference.RemindOverdue, preference.LastUpdated)
+
+		result <- err
+		close(result)
+	}()
+
+	return result
+}
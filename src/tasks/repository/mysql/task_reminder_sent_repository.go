@@ -0,0 +1,32 @@
+package sqlite
+
+import (
+	"database/sql"
+
+	"github.com/Tanibox/tania-core/src/tasks/repository"
+	"github.com/Tanibox/tania-core/src/tasks/storage"
+)
+
+type TaskReminderSentRepositoryMysql struct {
+	DB *sql.DB
+}
+
+func NewTaskReminderSentRepositoryMysql(db *sql.DB) repository.TaskReminderSentRepository {
+	return &TaskReminderSentRepositoryMysql{DB: db}
+}
+
+func (f *TaskReminderSentRepositoryMysql) Save(sent *storage.TaskReminderSent) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		_, err := f.DB.Exec(`INSERT INTO TASK_REMINDER_SENT (TASK_UID, USER_UID, KIND, DUE_DATE, SENT_DATE)
+			VALUES (?, ?, ?, ?, ?)
+			ON DUPLICATE KEY UPDATE DUE_DATE = VALUES(DUE_DATE), SENT_DATE = VALUES(SENT_DATE)`,
+			sent.TaskUID.Bytes(), sent.UserUID.Bytes(), sent.Kind, sent.DueDate, sent.SentDate)
+
+		result <- err
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,58 @@
+package sqlite
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/tasks/repository"
+	"github.com/Tanibox/tania-core/src/tasks/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type TaskTimeEntryReadRepositoryMysql struct {
+	DB *sql.DB
+}
+
+func NewTaskTimeEntryReadRepositoryMysql(db *sql.DB) repository.TaskTimeEntryReadRepository {
+	return &TaskTimeEntryReadRepositoryMysql{DB: db}
+}
+
+func (f *TaskTimeEntryReadRepositoryMysql) Save(timeEntryRead *storage.TaskTimeEntryRead) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		var workerUID []byte
+		if timeEntryRead.WorkerID != nil {
+			workerUID = timeEntryRead.WorkerID.Bytes()
+		}
+
+		duration := int64(timeEntryRead.Duration / time.Second)
+
+		_, err := f.DB.Exec(`INSERT INTO TASK_TIME_ENTRY_READ
+			(UID, TASK_UID, WORKER_UID, HOURLY_RATE, START_DATE, END_DATE, DURATION)
+			VALUES (?, ?, ?, ?, ?, ?, ?)
+			ON DUPLICATE KEY UPDATE
+			TASK_UID = VALUES(TASK_UID), WORKER_UID = VALUES(WORKER_UID), HOURLY_RATE = VALUES(HOURLY_RATE),
+			START_DATE = VALUES(START_DATE), END_DATE = VALUES(END_DATE), DURATION = VALUES(DURATION)`,
+			timeEntryRead.UID.Bytes(), timeEntryRead.TaskUID.Bytes(), workerUID, timeEntryRead.HourlyRate,
+			timeEntryRead.StartDate, timeEntryRead.EndDate, duration)
+
+		result <- err
+		close(result)
+	}()
+
+	return result
+}
+
+func (f *TaskTimeEntryReadRepositoryMysql) Remove(uid uuid.UUID) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		_, err := f.DB.Exec(`DELETE FROM TASK_TIME_ENTRY_READ WHERE UID = ?`, uid.Bytes())
+
+		result <- err
+		close(result)
+	}()
+
+	return result
+}
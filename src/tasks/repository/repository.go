@@ -36,3 +36,31 @@ func BuildTaskFromEventHistory(taskService domain.TaskService, events []storage.
 type TaskReadRepository interface {
 	Save(taskRead *storage.TaskRead) <-chan error
 }
+
+// TaskCalendarFeedRepository saves the calendar feed token of a user, replacing the previous one
+type TaskCalendarFeedRepository interface {
+	Save(feed *storage.TaskCalendarFeed) <-chan error
+}
+
+type TaskTimeEntryReadRepository interface {
+	Save(timeEntryRead *storage.TaskTimeEntryRead) <-chan error
+	Remove(uid uuid.UUID) <-chan error
+}
+
+type FinanceLedgerRepository interface {
+	Save(entry *storage.FinanceLedgerEntry) <-chan error
+}
+
+// TaskReminderPreferenceRepository saves the reminder preference of a user, replacing the previous one
+type TaskReminderPreferenceRepository interface {
+	Save(preference *storage.TaskReminderPreference) <-chan error
+}
+
+// TaskReminderSentRepository saves a sent reminder, replacing the one sent for a previous due date
+type TaskReminderSentRepository interface {
+	Save(sent *storage.TaskReminderSent) <-chan error
+}
+
+type SuccessionPlanTaskRepository interface {
+	Save(successionPlanTask *storage.SuccessionPlanTask) <-chan error
+}
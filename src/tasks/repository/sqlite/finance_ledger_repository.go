@@ -0,0 +1,36 @@
+package sqlite
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/tasks/repository"
+	"github.com/Tanibox/tania-core/src/tasks/storage"
+)
+
+type FinanceLedgerRepositorySqlite struct {
+	DB *sql.DB
+}
+
+func NewFinanceLedgerRepositorySqlite(db *sql.DB) repository.FinanceLedgerRepository {
+	return &FinanceLedgerRepositorySqlite{DB: db}
+}
+
+// Save writes the posted date in UTC, so it can be compared as text by the date range queries.
+// A task is posted once, so posting it again replaces its entry.
+func (f *FinanceLedgerRepositorySqlite) Save(entry *storage.FinanceLedgerEntry) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		_, err := f.DB.Exec(`INSERT OR REPLACE INTO FINANCE_LEDGER_READ
+			(TASK_UID, FARM_UID, TITLE, AMOUNT, CURRENCY_CODE, DIRECTION, COUNTERPARTY, MATERIAL_UID, POSTED_DATE)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			entry.TaskUID, entry.FarmUID, entry.Title, entry.Amount, entry.CurrencyCode, entry.Direction,
+			entry.Counterparty, entry.MaterialID, entry.PostedDate.UTC().Format(time.RFC3339))
+
+		result <- err
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,31 @@
+package sqlite
+
+import (
+	"database/sql"
+
+	"github.com/Tanibox/tania-core/src/tasks/repository"
+	"github.com/Tanibox/tania-core/src/tasks/storage"
+)
+
+type SuccessionPlanTaskRepositorySqlite struct {
+	DB *sql.DB
+}
+
+func NewSuccessionPlanTaskRepositorySqlite(db *sql.DB) repository.SuccessionPlanTaskRepository {
+	return &SuccessionPlanTaskRepositorySqlite{DB: db}
+}
+
+func (f *SuccessionPlanTaskRepositorySqlite) Save(successionPlanTask *storage.SuccessionPlanTask) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		_, err := f.DB.Exec(`INSERT OR REPLACE INTO SUCCESSION_PLAN_TASK
+			(PLANNED_BATCH_UID, SUCCESSION_PLAN_UID, TASK_UID) VALUES (?, ?, ?)`,
+			successionPlanTask.PlannedBatchUID, successionPlanTask.SuccessionPlanUID, successionPlanTask.TaskUID)
+
+		result <- err
+		close(result)
+	}()
+
+	return result
+}
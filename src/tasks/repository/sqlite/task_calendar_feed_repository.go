@@ -0,0 +1,48 @@
+package sqlite
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/tasks/repository"
+	"github.com/Tanibox/tania-core/src/tasks/storage"
+)
+
+type TaskCalendarFeedRepositorySqlite struct {
+	DB *sql.DB
+}
+
+func NewTaskCalendarFeedRepositorySqlite(db *sql.DB) repository.TaskCalendarFeedRepository {
+	return &TaskCalendarFeedRepositorySqlite{DB: db}
+}
+
+func (f *TaskCalendarFeedRepositorySqlite) Save(feed *storage.TaskCalendarFeed) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		res, err := f.DB.Exec(`UPDATE TASK_CALENDAR_FEED SET TOKEN = ?, CREATED_DATE = ? WHERE USER_UID = ?`,
+			feed.Token, feed.CreatedDate.Format(time.RFC3339), feed.UserUID)
+		if err != nil {
+			result <- err
+			close(result)
+			return
+		}
+
+		rowsAffected, err := res.RowsAffected()
+		if err != nil {
+			result <- err
+			close(result)
+			return
+		}
+
+		if rowsAffected == 0 {
+			_, err = f.DB.Exec(`INSERT INTO TASK_CALENDAR_FEED (USER_UID, TOKEN, CREATED_DATE) VALUES (?, ?, ?)`,
+				feed.UserUID, feed.Token, feed.CreatedDate.Format(time.RFC3339))
+		}
+
+		result <- err
+		close(result)
+	}()
+
+	return result
+}
@@ -2,6 +2,7 @@ package sqlite
 
 import (
 	"database/sql"
+	"encoding/json"
 	"github.com/Tanibox/tania-core/src/tasks/domain"
 	"github.com/Tanibox/tania-core/src/tasks/repository"
 	"github.com/Tanibox/tania-core/src/tasks/storage"
@@ -51,15 +52,55 @@ func (f *TaskReadRepositorySqlite) Save(taskRead *storage.TaskRead) <-chan error
 			domainDataMaterialID = v.MaterialID
 		}
 
+		var domainDataFinance *string
+		if v, ok := taskRead.DomainDetails.(domain.TaskDomainFinance); ok {
+			finance, err := json.Marshal(v)
+			if err != nil {
+				result <- err
+			}
+
+			d := string(finance)
+			domainDataFinance = &d
+		}
+
+		checklist, err := json.Marshal(taskRead.Checklist)
+		if err != nil {
+			result <- err
+		}
+
+		blockedBy, err := json.Marshal(taskRead.BlockedBy)
+		if err != nil {
+			result <- err
+		}
+
+		comments, err := json.Marshal(taskRead.Comments)
+		if err != nil {
+			result <- err
+		}
+
+		attachments, err := json.Marshal(taskRead.Attachments)
+		if err != nil {
+			result <- err
+		}
+
+		materialConsumption, err := json.Marshal(taskRead.MaterialConsumption)
+		if err != nil {
+			result <- err
+		}
+
 		res, err := f.DB.Exec(`UPDATE TASK_READ SET
 			TITLE = ?, DESCRIPTION = ?, CREATED_DATE = ?, DUE_DATE = ?,
 			COMPLETED_DATE = ?, CANCELLED_DATE = ?, PRIORITY = ?, STATUS = ?,
 			DOMAIN_CODE = ?, DOMAIN_DATA_MATERIAL_ID = ?, DOMAIN_DATA_AREA_ID = ?,
-			CATEGORY = ?, IS_DUE = ?, ASSET_ID = ?
+			CATEGORY = ?, IS_DUE = ?, ASSET_ID = ?, RECURRENCE_RULE = ?, SERIES_UID = ?,
+			ASSIGNEE_UID = ?, CHECKLIST = ?, BLOCKED_BY = ?,
+			COMMENTS = ?, ATTACHMENTS = ?, MATERIAL_CONSUMPTION = ?, DOMAIN_DATA_FINANCE = ?
 			WHERE UID = ?`,
 			taskRead.Title, taskRead.Description, taskRead.CreatedDate.Format(time.RFC3339), dueDate,
 			completedDate, cancelledDate, taskRead.Priority, taskRead.Status,
 			taskRead.Domain, domainDataMaterialID, domainDataAreaID, taskRead.Category, taskRead.IsDue, taskRead.AssetID,
+			taskRead.RecurrenceRule, taskRead.SeriesID, taskRead.AssigneeID, string(checklist), string(blockedBy),
+			string(comments), string(attachments), string(materialConsumption), domainDataFinance,
 			taskRead.UID)
 
 		if err != nil {
@@ -78,11 +119,15 @@ func (f *TaskReadRepositorySqlite) Save(taskRead *storage.TaskRead) <-chan error
 			_, err := f.DB.Exec(`INSERT INTO TASK_READ (
 				UID, TITLE, DESCRIPTION, CREATED_DATE, DUE_DATE,
 				COMPLETED_DATE, CANCELLED_DATE, PRIORITY, STATUS,
-				DOMAIN_CODE, DOMAIN_DATA_MATERIAL_ID, DOMAIN_DATA_AREA_ID, CATEGORY, IS_DUE, ASSET_ID)
-				VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+				DOMAIN_CODE, DOMAIN_DATA_MATERIAL_ID, DOMAIN_DATA_AREA_ID, CATEGORY, IS_DUE, ASSET_ID,
+				RECURRENCE_RULE, SERIES_UID, ASSIGNEE_UID, CHECKLIST, BLOCKED_BY,
+				COMMENTS, ATTACHMENTS, MATERIAL_CONSUMPTION, DOMAIN_DATA_FINANCE)
+				VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
 				taskRead.UID, taskRead.Title, taskRead.Description, taskRead.CreatedDate.Format(time.RFC3339), dueDate,
 				completedDate, cancelledDate, taskRead.Priority, taskRead.Status,
-				taskRead.Domain, domainDataMaterialID, domainDataAreaID, taskRead.Category, taskRead.IsDue, taskRead.AssetID)
+				taskRead.Domain, domainDataMaterialID, domainDataAreaID, taskRead.Category, taskRead.IsDue, taskRead.AssetID,
+				taskRead.RecurrenceRule, taskRead.SeriesID, taskRead.AssigneeID, string(checklist), string(blockedBy),
+				string(comments), string(attachments), string(materialConsumption), domainDataFinance)
 
 			if err != nil {
 				result <- err
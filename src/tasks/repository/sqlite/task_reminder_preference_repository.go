@@ -0,0 +1,51 @@
+package sqlite
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/tasks/repository"
+	"github.com/Tanibox/tania-core/src/tasks/storage"
+)
+
+type TaskReminderPreferenceRepositorySqlite struct {
+	DB *sql.DB
+}
+
+func NewTaskReminderPreferenceRepositorySqlite(db *sql.DB) repository.TaskReminderPreferenceRepository {
+	return &TaskReminderPreferenceRepositorySqlite{DB: db}
+}
+
+// Save writes the categories and the priorities as JSON, and the time before the due date in seconds
+func (f *TaskReminderPreferenceRepositorySqlite) Save(preference *storage.TaskReminderPreference) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		categories, err := json.Marshal(preference.Categories)
+		if err != nil {
+			result <- err
+			close(result)
+			return
+		}
+
+		priorities, err := json.Marshal(preference.Priorities)
+		if err != nil {
+			result <- err
+			close(result)
+			return
+		}
+
+		_, err = f.DB.Exec(`INSERT OR REPLACE INTO TASK_REMINDER_PREFERENCE
+			(USER_UID, CHANNEL, RECIPIENT, CATEGORIES, PRIORITIES, REMIND_BEFORE, REMIND_OVERDUE, LAST_UPDATED)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+			preference.UserUID, preference.Channel, preference.Recipient, string(categories), string(priorities),
+			int64(preference.RemindBefore/time.Second), preference.RemindOverdue,
+			preference.LastUpdated.Format(time.RFC3339))
+
+		result <- err
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,33 @@
+package sqlite
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/tasks/repository"
+	"github.com/Tanibox/tania-core/src/tasks/storage"
+)
+
+type TaskReminderSentRepositorySqlite struct {
+	DB *sql.DB
+}
+
+func NewTaskReminderSentRepositorySqlite(db *sql.DB) repository.TaskReminderSentRepository {
+	return &TaskReminderSentRepositorySqlite{DB: db}
+}
+
+func (f *TaskReminderSentRepositorySqlite) Save(sent *storage.TaskReminderSent) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		_, err := f.DB.Exec(`INSERT OR REPLACE INTO TASK_REMINDER_SENT
+			(TASK_UID, USER_UID, KIND, DUE_DATE, SENT_DATE) VALUES (?, ?, ?, ?, ?)`,
+			sent.TaskUID, sent.UserUID, sent.Kind,
+			sent.DueDate.Format(time.RFC3339), sent.SentDate.Format(time.RFC3339))
+
+		result <- err
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,77 @@
+package sqlite
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/tasks/repository"
+	"github.com/Tanibox/tania-core/src/tasks/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type TaskTimeEntryReadRepositorySqlite struct {
+	DB *sql.DB
+}
+
+func NewTaskTimeEntryReadRepositorySqlite(db *sql.DB) repository.TaskTimeEntryReadRepository {
+	return &TaskTimeEntryReadRepositorySqlite{DB: db}
+}
+
+// Save writes the dates in UTC, so they can be compared as text by the date range queries
+func (f *TaskTimeEntryReadRepositorySqlite) Save(timeEntryRead *storage.TaskTimeEntryRead) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		var endDate *string
+		if timeEntryRead.EndDate != nil {
+			d := timeEntryRead.EndDate.UTC().Format(time.RFC3339)
+			endDate = &d
+		}
+
+		startDate := timeEntryRead.StartDate.UTC().Format(time.RFC3339)
+		duration := int64(timeEntryRead.Duration / time.Second)
+
+		res, err := f.DB.Exec(`UPDATE TASK_TIME_ENTRY_READ SET
+			TASK_UID = ?, WORKER_UID = ?, HOURLY_RATE = ?, START_DATE = ?, END_DATE = ?, DURATION = ?
+			WHERE UID = ?`,
+			timeEntryRead.TaskUID, timeEntryRead.WorkerID, timeEntryRead.HourlyRate, startDate, endDate, duration,
+			timeEntryRead.UID)
+		if err != nil {
+			result <- err
+			close(result)
+			return
+		}
+
+		rowsAffected, err := res.RowsAffected()
+		if err != nil {
+			result <- err
+			close(result)
+			return
+		}
+
+		if rowsAffected == 0 {
+			_, err = f.DB.Exec(`INSERT INTO TASK_TIME_ENTRY_READ
+				(UID, TASK_UID, WORKER_UID, HOURLY_RATE, START_DATE, END_DATE, DURATION)
+				VALUES (?, ?, ?, ?, ?, ?, ?)`,
+				timeEntryRead.UID, timeEntryRead.TaskUID, timeEntryRead.WorkerID, timeEntryRead.HourlyRate, startDate, endDate, duration)
+		}
+
+		result <- err
+		close(result)
+	}()
+
+	return result
+}
+
+func (f *TaskTimeEntryReadRepositorySqlite) Remove(uid uuid.UUID) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		_, err := f.DB.Exec(`DELETE FROM TASK_TIME_ENTRY_READ WHERE UID = ?`, uid)
+
+		result <- err
+		close(result)
+	}()
+
+	return result
+}
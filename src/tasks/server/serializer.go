@@ -21,6 +21,19 @@ func MapTaskToTaskRead(task *domain.Task) *storage.TaskRead {
 		Category:      task.Category,
 		IsDue:         task.IsDue,
 		AssetID:       task.AssetID,
+
+		RecurrenceRule: task.RecurrenceRule,
+		SeriesID:       task.SeriesID,
+
+		AssigneeID: task.AssigneeID,
+
+		Checklist: task.Checklist,
+		BlockedBy: task.BlockedBy,
+
+		Comments:    task.Comments,
+		Attachments: task.Attachments,
+
+		MaterialConsumption: task.MaterialConsumption,
 	}
 	return taskRead
 }
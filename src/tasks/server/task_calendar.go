@@ -0,0 +1,255 @@
+package server
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/helper/icalhelper"
+	"github.com/Tanibox/tania-core/src/tasks/domain"
+	"github.com/Tanibox/tania-core/src/tasks/query"
+	"github.com/Tanibox/tania-core/src/tasks/storage"
+	"github.com/labstack/echo"
+	uuid "github.com/satori/go.uuid"
+)
+
+// MountCalendar defines the endpoint of the calendar feeds.
+// It must be mounted without the token validation, because the feed token is the authentication.
+func (s *TaskServer) MountCalendar(g *echo.Group) {
+	g.GET("/:token", s.CalendarFeed)
+}
+
+// FindCalendarFeed returns the calendar feed token of the user, which is created on the first call
+func (s *TaskServer) FindCalendarFeed(c echo.Context) error {
+	data := make(map[string]storage.TaskCalendarFeed)
+
+	userUID, ok := c.Get("USER_UID").(uuid.UUID)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"data": "Unauthorized"})
+	}
+
+	queryResult := <-s.TaskCalendarFeedQuery.FindByUserID(userUID)
+	if queryResult.Error != nil {
+		return Error(c, queryResult.Error)
+	}
+
+	feed, ok := queryResult.Result.(storage.TaskCalendarFeed)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	if feed.Token == "" {
+		newFeed, err := s.saveNewCalendarFeed(userUID)
+		if err != nil {
+			return Error(c, err)
+		}
+
+		feed = *newFeed
+	}
+
+	data["data"] = feed
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// ResetCalendarFeed replaces the calendar feed token of the user,
+// so the calendars subscribed with the previous one stop being updated
+func (s *TaskServer) ResetCalendarFeed(c echo.Context) error {
+	data := make(map[string]storage.TaskCalendarFeed)
+
+	userUID, ok := c.Get("USER_UID").(uuid.UUID)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"data": "Unauthorized"})
+	}
+
+	feed, err := s.saveNewCalendarFeed(userUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	data["data"] = *feed
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *TaskServer) saveNewCalendarFeed(userUID uuid.UUID) (*storage.TaskCalendarFeed, error) {
+	token, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+
+	feed := &storage.TaskCalendarFeed{
+		UserUID:     userUID,
+		Token:       token.String(),
+		CreatedDate: time.Now(),
+	}
+
+	err = <-s.TaskCalendarFeedRepo.Save(feed)
+	if err != nil {
+		return nil, err
+	}
+
+	return feed, nil
+}
+
+// CalendarFeed serves the tasks with a due date as an iCalendar file of all day events.
+// It only serves the tasks assigned to the user of the feed, unless all=true is given.
+// The tasks can be filtered by farm_id, category and status.
+// The farm of a task is the farm of its area, crop or reservoir, so the tasks of the other domains are left out by farm_id.
+func (s *TaskServer) CalendarFeed(c echo.Context) error {
+	// Validate //
+	token := strings.TrimSuffix(c.Param("token"), ".ics")
+
+	queryResult := <-s.TaskCalendarFeedQuery.FindByToken(token)
+	if queryResult.Error != nil {
+		return Error(c, queryResult.Error)
+	}
+
+	feed, ok := queryResult.Result.(storage.TaskCalendarFeed)
+	if !ok || token == "" || feed.Token != token {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"data": "Unauthorized"})
+	}
+
+	all := false
+	if v := c.QueryParam("all"); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return Error(c, NewRequestValidationError(PARSE_FAILED, "all"))
+		}
+
+		all = b
+	}
+
+	var farmUID *uuid.UUID
+	if farmID := c.QueryParam("farm_id"); farmID != "" {
+		uid, err := uuid.FromString(farmID)
+		if err != nil {
+			return Error(c, NewRequestValidationError(PARSE_FAILED, "farm_id"))
+		}
+
+		farmUID = &uid
+	}
+
+	queryparams := make(map[string]string)
+	queryparams["category"] = c.QueryParam("category")
+	queryparams["status"] = c.QueryParam("status")
+	if !all {
+		queryparams["assignee"] = feed.UserUID.String()
+	}
+
+	// Process //
+	readResult := <-s.TaskReadQuery.FindTasksWithFilter(queryparams, 0, 0)
+	if readResult.Error != nil {
+		return Error(c, readResult.Error)
+	}
+
+	tasks, ok := readResult.Result.([]storage.TaskRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	calendar := icalhelper.Calendar{
+		Name:  "Tania tasks",
+		Stamp: time.Now(),
+	}
+
+	for _, v := range tasks {
+		if v.DueDate == nil || v.DueDate.IsZero() {
+			continue
+		}
+
+		link := s.findTaskCalendarLink(v)
+		if farmUID != nil && !uuid.Equal(link.FarmID, *farmUID) {
+			continue
+		}
+
+		calendar.Events = append(calendar.Events, taskCalendarEvent(v, link))
+	}
+
+	c.Response().Header().Set(echo.HeaderContentType, icalhelper.ContentType)
+	c.Response().WriteHeader(http.StatusOK)
+
+	return calendar.Write(c.Response())
+}
+
+// taskCalendarLink is the area, crop or reservoir of a task, as shown in the calendar
+type taskCalendarLink struct {
+	FarmID   uuid.UUID
+	Location string
+	Details  []string
+}
+
+// findTaskCalendarLink finds the asset of the task. The tasks of the other domains have no farm.
+func (s *TaskServer) findTaskCalendarLink(task storage.TaskRead) taskCalendarLink {
+	link := taskCalendarLink{}
+
+	if task.AssetID == nil {
+		return link
+	}
+
+	switch task.Domain {
+	case domain.TaskDomainAreaCode:
+		area, ok := s.TaskService.FindAreaByID(*task.AssetID).Result.(query.TaskAreaQueryResult)
+		if ok {
+			link.FarmID = area.FarmID
+			link.Location = area.Name
+			link.Details = append(link.Details, "Area: "+area.Name)
+		}
+
+	case domain.TaskDomainCropCode:
+		crop, ok := s.TaskService.FindCropByID(*task.AssetID).Result.(query.TaskCropQueryResult)
+		if ok {
+			link.FarmID = crop.FarmID
+			link.Details = append(link.Details, "Crop: "+crop.BatchID)
+		}
+
+		details, ok := task.DomainDetails.(domain.TaskDomainCrop)
+		if ok && details.AreaID != nil {
+			area, ok := s.TaskService.FindAreaByID(*details.AreaID).Result.(query.TaskAreaQueryResult)
+			if ok {
+				link.Location = area.Name
+				link.Details = append(link.Details, "Area: "+area.Name)
+			}
+		}
+
+	case domain.TaskDomainReservoirCode:
+		reservoir, ok := s.TaskService.FindReservoirByID(*task.AssetID).Result.(query.TaskReservoirQueryResult)
+		if ok {
+			link.FarmID = reservoir.FarmID
+			link.Location = reservoir.Name
+			link.Details = append(link.Details, "Reservoir: "+reservoir.Name)
+		}
+	}
+
+	return link
+}
+
+func taskCalendarEvent(task storage.TaskRead, link taskCalendarLink) icalhelper.Event {
+	description := []string{}
+	if task.Description != "" {
+		description = append(description, task.Description)
+	}
+	description = append(description, link.Details...)
+
+	priority := 5
+	if task.Priority == domain.TaskPriorityUrgent {
+		priority = 1
+	}
+
+	status := icalhelper.StatusConfirmed
+	if task.Status == domain.TaskStatusCancelled {
+		status = icalhelper.StatusCancelled
+	}
+
+	return icalhelper.Event{
+		UID:         task.UID.String() + "@tania",
+		Date:        *task.DueDate,
+		Summary:     task.Title,
+		Description: strings.Join(description, "\n"),
+		Location:    link.Location,
+		Categories:  []string{task.Category},
+		Priority:    priority,
+		Status:      status,
+	}
+}
@@ -0,0 +1,160 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/tasks/domain"
+	"github.com/Tanibox/tania-core/src/tasks/query"
+	queryInMem "github.com/Tanibox/tania-core/src/tasks/query/inmemory"
+	"github.com/Tanibox/tania-core/src/tasks/storage"
+	"github.com/labstack/echo"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type TaskServiceMock struct {
+	mock.Mock
+}
+
+func (m *TaskServiceMock) FindFarmByID(uid uuid.UUID) domain.ServiceResult {
+	args := m.Called(uid)
+	return args.Get(0).(domain.ServiceResult)
+}
+func (m *TaskServiceMock) FindAreaByID(uid uuid.UUID) domain.ServiceResult {
+	args := m.Called(uid)
+	return args.Get(0).(domain.ServiceResult)
+}
+func (m *TaskServiceMock) FindCropByID(uid uuid.UUID) domain.ServiceResult {
+	args := m.Called(uid)
+	return args.Get(0).(domain.ServiceResult)
+}
+func (m *TaskServiceMock) FindMaterialByID(uid uuid.UUID) domain.ServiceResult {
+	args := m.Called(uid)
+	return args.Get(0).(domain.ServiceResult)
+}
+func (m *TaskServiceMock) FindReservoirByID(uid uuid.UUID) domain.ServiceResult {
+	args := m.Called(uid)
+	return args.Get(0).(domain.ServiceResult)
+}
+func (m *TaskServiceMock) FindUserByID(uid uuid.UUID) domain.ServiceResult {
+	args := m.Called(uid)
+	return args.Get(0).(domain.ServiceResult)
+}
+func (m *TaskServiceMock) FindTaskByID(uid uuid.UUID) domain.ServiceResult {
+	args := m.Called(uid)
+	return args.Get(0).(domain.ServiceResult)
+}
+
+func TestCalendarFeedFilterByFarm(t *testing.T) {
+	// Given
+	userUID, _ := uuid.NewV4()
+	otherUserUID, _ := uuid.NewV4()
+	farmUID, _ := uuid.NewV4()
+	otherFarmUID, _ := uuid.NewV4()
+	areaUID, _ := uuid.NewV4()
+	cropUID, _ := uuid.NewV4()
+	reservoirUID, _ := uuid.NewV4()
+
+	taskServiceMock := new(TaskServiceMock)
+	taskServiceMock.On("FindAreaByID", areaUID).Return(domain.ServiceResult{
+		Result: query.TaskAreaQueryResult{UID: areaUID, Name: "Bed A", FarmID: farmUID},
+	})
+	taskServiceMock.On("FindCropByID", cropUID).Return(domain.ServiceResult{
+		Result: query.TaskCropQueryResult{UID: cropUID, BatchID: "bro-sal-1may", FarmID: farmUID},
+	})
+	taskServiceMock.On("FindReservoirByID", reservoirUID).Return(domain.ServiceResult{
+		Result: query.TaskReservoirQueryResult{UID: reservoirUID, Name: "Tank B", FarmID: otherFarmUID},
+	})
+
+	feedStorage := storage.CreateTaskCalendarFeedStorage()
+	feedStorage.TaskCalendarFeedMap[userUID] = storage.TaskCalendarFeed{UserUID: userUID, Token: "feed-token"}
+
+	taskReadStorage := storage.CreateTaskReadStorage()
+	dueDate := time.Date(2018, time.May, 12, 0, 0, 0, 0, time.UTC)
+
+	createTask := func(taskDomain string, details domain.TaskDomain, assetID *uuid.UUID, assigneeID uuid.UUID) uuid.UUID {
+		uid, _ := uuid.NewV4()
+
+		taskReadStorage.TaskReadMap[uid] = storage.TaskRead{
+			UID:           uid,
+			Title:         "Task " + taskDomain,
+			DueDate:       &dueDate,
+			Status:        domain.TaskStatusCreated,
+			Priority:      domain.TaskPriorityNormal,
+			Domain:        taskDomain,
+			DomainDetails: details,
+			AssetID:       assetID,
+			AssigneeID:    &assigneeID,
+		}
+
+		return uid
+	}
+
+	areaTaskUID := createTask(domain.TaskDomainAreaCode, domain.TaskDomainArea{}, &areaUID, userUID)
+	cropTaskUID := createTask(domain.TaskDomainCropCode, domain.TaskDomainCrop{}, &cropUID, userUID)
+	reservoirTaskUID := createTask(domain.TaskDomainReservoirCode, domain.TaskDomainReservoir{}, &reservoirUID, userUID)
+	generalTaskUID := createTask(domain.TaskDomainGeneralCode, domain.TaskDomainGeneral{}, nil, userUID)
+	otherUserTaskUID := createTask(domain.TaskDomainAreaCode, domain.TaskDomainArea{}, &areaUID, otherUserUID)
+
+	s := &TaskServer{
+		TaskService:           taskServiceMock,
+		TaskReadQuery:         queryInMem.NewTaskReadQueryInMemory(taskReadStorage),
+		TaskCalendarFeedQuery: queryInMem.NewTaskCalendarFeedQueryInMemory(feedStorage),
+	}
+
+	calendarFeed := func(queryString string) (int, string) {
+		req := httptest.NewRequest(http.MethodGet, "/?"+queryString, nil)
+		rec := httptest.NewRecorder()
+
+		c := echo.New().NewContext(req, rec)
+		c.SetParamNames("token")
+		c.SetParamValues("feed-token.ics")
+
+		err := s.CalendarFeed(c)
+		assert.Nil(t, err, queryString)
+
+		return rec.Code, rec.Body.String()
+	}
+
+	allTaskUIDs := []uuid.UUID{areaTaskUID, cropTaskUID, reservoirTaskUID, generalTaskUID, otherUserTaskUID}
+
+	tests := []struct {
+		queryString string
+		taskUIDs    []uuid.UUID
+	}{
+		{queryString: "", taskUIDs: []uuid.UUID{areaTaskUID, cropTaskUID, reservoirTaskUID, generalTaskUID}},
+		{queryString: "farm_id=" + farmUID.String(), taskUIDs: []uuid.UUID{areaTaskUID, cropTaskUID}},
+		{queryString: "farm_id=" + farmUID.String() + "&all=true", taskUIDs: []uuid.UUID{areaTaskUID, cropTaskUID, otherUserTaskUID}},
+		{queryString: "farm_id=" + otherFarmUID.String(), taskUIDs: []uuid.UUID{reservoirTaskUID}},
+	}
+
+	for _, test := range tests {
+		// When
+		code, body := calendarFeed(test.queryString)
+
+		// Then
+		assert.Equal(t, http.StatusOK, code, test.queryString)
+
+		for _, uid := range allTaskUIDs {
+			isExpected := false
+			for _, v := range test.taskUIDs {
+				if uuid.Equal(v, uid) {
+					isExpected = true
+				}
+			}
+
+			assert.Equal(t, isExpected, strings.Contains(body, uid.String()+"@tania"), test.queryString)
+		}
+	}
+
+	// When
+	code, _ := calendarFeed("farm_id=farm")
+
+	// Then
+	assert.Equal(t, http.StatusBadRequest, code)
+}
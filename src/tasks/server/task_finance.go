@@ -0,0 +1,141 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/helper/datetimehelper"
+	"github.com/Tanibox/tania-core/src/tasks/domain"
+	"github.com/Tanibox/tania-core/src/tasks/query"
+	"github.com/Tanibox/tania-core/src/tasks/storage"
+	"github.com/labstack/echo"
+	uuid "github.com/satori/go.uuid"
+)
+
+// FinanceLedgerBalance sums the ledger entries of a currency.
+// The balance is the receivable amount minus the payable amount.
+type FinanceLedgerBalance struct {
+	CurrencyCode string `json:"currency_code"`
+	Payable      string `json:"payable"`
+	Receivable   string `json:"receivable"`
+	Balance      string `json:"balance"`
+}
+
+// FindFinanceLedger returns the finance tasks of the farm posted in the date range, with their balance per currency
+func (s *TaskServer) FindFinanceLedger(c echo.Context) error {
+	data := make(map[string]interface{})
+
+	// Validate //
+	farmID := c.QueryParam("farm_id")
+	if farmID == "" {
+		return Error(c, NewRequestValidationError(REQUIRED, "farm_id"))
+	}
+
+	farmUID, err := uuid.FromString(farmID)
+	if err != nil {
+		return Error(c, NewRequestValidationError(PARSE_FAILED, "farm_id"))
+	}
+
+	filter, err := parseFinanceLedgerFilter(c)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	queryResult := <-s.FinanceLedgerQuery.FindAllByFarmID(farmUID, filter)
+	if queryResult.Error != nil {
+		return Error(c, queryResult.Error)
+	}
+
+	entries, ok := queryResult.Result.([]storage.FinanceLedgerEntry)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	// Process //
+	payable := make(map[string]int64)
+	receivable := make(map[string]int64)
+	currencyCodes := []string{}
+
+	for _, v := range entries {
+		amount, err := domain.ParseFinanceAmount(v.Amount)
+		if err != nil {
+			return Error(c, err)
+		}
+
+		if _, ok := payable[v.CurrencyCode]; !ok {
+			payable[v.CurrencyCode] = 0
+			receivable[v.CurrencyCode] = 0
+			currencyCodes = append(currencyCodes, v.CurrencyCode)
+		}
+
+		switch v.Direction {
+		case domain.TaskFinanceDirectionPayable:
+			payable[v.CurrencyCode] += amount
+		case domain.TaskFinanceDirectionReceivable:
+			receivable[v.CurrencyCode] += amount
+		}
+	}
+
+	sort.Strings(currencyCodes)
+
+	balances := []FinanceLedgerBalance{}
+	for _, v := range currencyCodes {
+		balances = append(balances, FinanceLedgerBalance{
+			CurrencyCode: v,
+			Payable:      formatFinanceAmount(payable[v]),
+			Receivable:   formatFinanceAmount(receivable[v]),
+			Balance:      formatFinanceAmount(receivable[v] - payable[v]),
+		})
+	}
+
+	data["data"] = entries
+	data["balances"] = balances
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// formatFinanceAmount formats an amount in minor units with two decimals
+func formatFinanceAmount(amount int64) string {
+	sign := ""
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+
+	return fmt.Sprintf("%s%d.%02d", sign, amount/100, amount%100)
+}
+
+// parseFinanceLedgerFilter reads the start_date and end_date days of the ledger, both included
+func parseFinanceLedgerFilter(c echo.Context) (query.FinanceLedgerFilter, error) {
+	filter := query.FinanceLedgerFilter{}
+
+	startDate := c.QueryParam("start_date")
+	endDate := c.QueryParam("end_date")
+
+	if startDate != "" {
+		date, err := time.ParseInLocation("2006-01-02", startDate, time.Local)
+		if err != nil {
+			return filter, NewRequestValidationError(PARSE_FAILED, "start_date")
+		}
+
+		filter.StartDate = &date
+	}
+
+	if endDate != "" {
+		date, err := time.ParseInLocation("2006-01-02", endDate, time.Local)
+		if err != nil {
+			return filter, NewRequestValidationError(PARSE_FAILED, "end_date")
+		}
+
+		_, nextDay := datetimehelper.DayRange(date)
+		filter.EndDate = &nextDay
+	}
+
+	if filter.StartDate != nil && filter.EndDate != nil && !filter.StartDate.Before(*filter.EndDate) {
+		return filter, NewRequestValidationError(INVALID_OPTION, "end_date")
+	}
+
+	return filter, nil
+}
@@ -0,0 +1,376 @@
+package server
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/helper/datetimehelper"
+	"github.com/Tanibox/tania-core/src/tasks/domain"
+	"github.com/Tanibox/tania-core/src/tasks/query"
+	"github.com/Tanibox/tania-core/src/tasks/storage"
+	"github.com/labstack/echo"
+	uuid "github.com/satori/go.uuid"
+)
+
+const (
+	LaborGroupByTask     = "task"
+	LaborGroupByCategory = "category"
+	LaborGroupByCrop     = "crop"
+	LaborGroupByArea     = "area"
+)
+
+// LaborReportRow is the time spent and its cost for one task, category, crop or area
+type LaborReportRow struct {
+	ID      string  `json:"id"`
+	Name    string  `json:"name"`
+	Hours   float64 `json:"hours"`
+	Cost    float64 `json:"cost"`
+	Entries int     `json:"entries"`
+}
+
+// StartTaskTimer starts the timer of the worker, which is the signed in user when there is no worker_id
+func (s *TaskServer) StartTaskTimer(c echo.Context) error {
+	// Validate //
+	workerUID, err := parseTimeEntryWorker(c)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	hourlyRate, err := parseHourlyRate(c)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	task, err := s.findTaskFromParam(c)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Process //
+	_, err = task.StartTimer(s.TaskService, workerUID, hourlyRate)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return s.saveTaskChanges(c, task)
+}
+
+func (s *TaskServer) StopTaskTimer(c echo.Context) error {
+	// Validate //
+	workerUID, err := parseTimeEntryWorker(c)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	task, err := s.findTaskFromParam(c)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Process //
+	_, err = task.StopTimer(s.TaskService, workerUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return s.saveTaskChanges(c, task)
+}
+
+// LogTaskTime adds a time entry without a timer. The duration is written as 1h30m,
+// and the entry ends now when there is no start_date.
+func (s *TaskServer) LogTaskTime(c echo.Context) error {
+	// Validate //
+	durationParam := c.FormValue("duration")
+	if durationParam == "" {
+		return Error(c, NewRequestValidationError(REQUIRED, "duration"))
+	}
+
+	duration, err := time.ParseDuration(durationParam)
+	if err != nil {
+		return Error(c, NewRequestValidationError(PARSE_FAILED, "duration"))
+	}
+
+	startDate := time.Now().Add(-duration)
+	if v := c.FormValue("start_date"); v != "" {
+		startDate, err = time.Parse(time.RFC3339, v)
+		if err != nil {
+			return Error(c, NewRequestValidationError(PARSE_FAILED, "start_date"))
+		}
+	}
+
+	workerUID, err := parseTimeEntryWorker(c)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	hourlyRate, err := parseHourlyRate(c)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	task, err := s.findTaskFromParam(c)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Process //
+	_, err = task.LogTime(s.TaskService, workerUID, hourlyRate, startDate, duration)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return s.saveTaskChanges(c, task)
+}
+
+func (s *TaskServer) RemoveTaskTimeEntry(c echo.Context) error {
+	// Validate //
+	entryUID, err := uuid.FromString(c.Param("entry_id"))
+	if err != nil {
+		return Error(c, NewRequestValidationError(PARSE_FAILED, "entry_id"))
+	}
+
+	task, err := s.findTaskFromParam(c)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Process //
+	_, err = task.RemoveTimeEntry(s.TaskService, entryUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return s.saveTaskChanges(c, task)
+}
+
+// FindTaskTimeEntries returns the time entries of the task, with the running timers
+func (s *TaskServer) FindTaskTimeEntries(c echo.Context) error {
+	data := make(map[string][]storage.TaskTimeEntryRead)
+
+	taskUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, NewRequestValidationError(PARSE_FAILED, "id"))
+	}
+
+	queryResult := <-s.TaskTimeEntryReadQuery.FindAllWithFilter(query.TaskTimeEntryFilter{TaskUID: &taskUID})
+	if queryResult.Error != nil {
+		return Error(c, queryResult.Error)
+	}
+
+	entries, ok := queryResult.Result.([]storage.TaskTimeEntryRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	data["data"] = entries
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// FindLaborReport sums the hours and the cost of the stopped time entries started in the date range.
+// The entries of the tasks without a crop or an area are left out of the crop and area reports.
+func (s *TaskServer) FindLaborReport(c echo.Context) error {
+	data := make(map[string][]LaborReportRow)
+
+	// Validate //
+	groupBy := c.QueryParam("group_by")
+	if groupBy == "" {
+		groupBy = LaborGroupByTask
+	}
+
+	switch groupBy {
+	case LaborGroupByTask, LaborGroupByCategory, LaborGroupByCrop, LaborGroupByArea:
+	default:
+		return Error(c, NewRequestValidationError(INVALID_OPTION, "group_by"))
+	}
+
+	filter, err := parseTaskTimeEntryFilter(c)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	queryResult := <-s.TaskTimeEntryReadQuery.FindAllWithFilter(filter)
+	if queryResult.Error != nil {
+		return Error(c, queryResult.Error)
+	}
+
+	entries, ok := queryResult.Result.([]storage.TaskTimeEntryRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	// Process //
+	tasks := make(map[uuid.UUID]storage.TaskRead)
+	rows := make(map[string]*LaborReportRow)
+	keys := []string{}
+
+	for _, v := range entries {
+		if v.EndDate == nil {
+			continue
+		}
+
+		task, ok := tasks[v.TaskUID]
+		if !ok {
+			readResult := <-s.TaskReadQuery.FindByID(v.TaskUID)
+			if readResult.Error != nil {
+				return Error(c, readResult.Error)
+			}
+
+			task, _ = readResult.Result.(storage.TaskRead)
+			tasks[v.TaskUID] = task
+		}
+
+		id, name := s.laborReportGroup(groupBy, task)
+		if id == "" {
+			continue
+		}
+
+		row, ok := rows[id]
+		if !ok {
+			row = &LaborReportRow{ID: id, Name: name}
+			rows[id] = row
+			keys = append(keys, id)
+		}
+
+		hours := v.Duration.Hours()
+
+		row.Hours += hours
+		row.Cost += hours * v.HourlyRate
+		row.Entries++
+	}
+
+	report := []LaborReportRow{}
+	for _, k := range keys {
+		report = append(report, *rows[k])
+	}
+
+	sort.SliceStable(report, func(i, j int) bool {
+		return report[i].Hours > report[j].Hours
+	})
+
+	data["data"] = report
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// laborReportGroup returns the id and the name of the group of the task, or an empty id when it has none
+func (s *TaskServer) laborReportGroup(groupBy string, task storage.TaskRead) (string, string) {
+	switch groupBy {
+	case LaborGroupByTask:
+		if task.UID == (uuid.UUID{}) {
+			return "", ""
+		}
+
+		return task.UID.String(), task.Title
+
+	case LaborGroupByCategory:
+		return task.Category, task.Category
+
+	case LaborGroupByCrop:
+		if task.Domain != domain.TaskDomainCropCode || task.AssetID == nil {
+			return "", ""
+		}
+
+		name := ""
+		crop, ok := s.TaskService.FindCropByID(*task.AssetID).Result.(query.TaskCropQueryResult)
+		if ok {
+			name = crop.BatchID
+		}
+
+		return task.AssetID.String(), name
+
+	case LaborGroupByArea:
+		var areaUID *uuid.UUID
+
+		switch task.Domain {
+		case domain.TaskDomainAreaCode:
+			areaUID = task.AssetID
+		case domain.TaskDomainCropCode:
+			if details, ok := task.DomainDetails.(domain.TaskDomainCrop); ok {
+				areaUID = details.AreaID
+			}
+		}
+
+		if areaUID == nil {
+			return "", ""
+		}
+
+		name := ""
+		area, ok := s.TaskService.FindAreaByID(*areaUID).Result.(query.TaskAreaQueryResult)
+		if ok {
+			name = area.Name
+		}
+
+		return areaUID.String(), name
+	}
+
+	return "", ""
+}
+
+// parseTimeEntryWorker reads the worker_id, and falls back to the signed in user
+func parseTimeEntryWorker(c echo.Context) (*uuid.UUID, error) {
+	workerID := c.FormValue("worker_id")
+	if workerID == "" {
+		if userUID, ok := c.Get("USER_UID").(uuid.UUID); ok {
+			return &userUID, nil
+		}
+
+		return nil, nil
+	}
+
+	workerUID, err := uuid.FromString(workerID)
+	if err != nil {
+		return nil, NewRequestValidationError(PARSE_FAILED, "worker_id")
+	}
+
+	return &workerUID, nil
+}
+
+func parseHourlyRate(c echo.Context) (float64, error) {
+	hourlyRate := c.FormValue("hourly_rate")
+	if hourlyRate == "" {
+		return 0, nil
+	}
+
+	rate, err := strconv.ParseFloat(hourlyRate, 64)
+	if err != nil {
+		return 0, NewRequestValidationError(PARSE_FAILED, "hourly_rate")
+	}
+
+	return rate, nil
+}
+
+// parseTaskTimeEntryFilter reads the start_date and end_date days of the report, both included
+func parseTaskTimeEntryFilter(c echo.Context) (query.TaskTimeEntryFilter, error) {
+	filter := query.TaskTimeEntryFilter{}
+
+	startDate := c.QueryParam("start_date")
+	endDate := c.QueryParam("end_date")
+
+	if startDate != "" {
+		date, err := time.ParseInLocation("2006-01-02", startDate, time.Local)
+		if err != nil {
+			return filter, NewRequestValidationError(PARSE_FAILED, "start_date")
+		}
+
+		filter.StartDate = &date
+	}
+
+	if endDate != "" {
+		date, err := time.ParseInLocation("2006-01-02", endDate, time.Local)
+		if err != nil {
+			return filter, NewRequestValidationError(PARSE_FAILED, "end_date")
+		}
+
+		_, nextDay := datetimehelper.DayRange(date)
+		filter.EndDate = &nextDay
+	}
+
+	if filter.StartDate != nil && filter.EndDate != nil && !filter.StartDate.Before(*filter.EndDate) {
+		return filter, NewRequestValidationError(INVALID_OPTION, "end_date")
+	}
+
+	return filter, nil
+}
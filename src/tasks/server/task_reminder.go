@@ -0,0 +1,299 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Tanibox/tania-core/config"
+	"github.com/Tanibox/tania-core/src/notification"
+	"github.com/Tanibox/tania-core/src/tasks/domain"
+	"github.com/Tanibox/tania-core/src/tasks/storage"
+	"github.com/labstack/echo"
+	"github.com/labstack/gommon/log"
+	uuid "github.com/satori/go.uuid"
+)
+
+// TaskReminderData is the task of a reminder, sent with the message so the webhooks can read it
+type TaskReminderData struct {
+	Kind     string    `json:"kind"`
+	TaskUID  uuid.UUID `json:"task_id"`
+	Title    string    `json:"title"`
+	Category string    `json:"category"`
+	Priority string    `json:"priority"`
+	DueDate  time.Time `json:"due_date"`
+}
+
+// FindTaskReminderPreference returns the reminder preference of the user, with the channels available on this server.
+// A user without a preference gets an empty one, which sends no reminder.
+func (s *TaskServer) FindTaskReminderPreference(c echo.Context) error {
+	data := make(map[string]interface{})
+
+	userUID, ok := c.Get("USER_UID").(uuid.UUID)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"data": "Unauthorized"})
+	}
+
+	queryResult := <-s.TaskReminderPreferenceQuery.FindByUserID(userUID)
+	if queryResult.Error != nil {
+		return Error(c, queryResult.Error)
+	}
+
+	preference, ok := queryResult.Result.(storage.TaskReminderPreference)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	preference.UserUID = userUID
+
+	data["data"] = preference
+	data["channels"] = s.Notifier.Channels()
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// SaveTaskReminderPreference replaces the reminder preference of the user.
+// The categories and the priorities are comma separated, and an empty channel turns the reminders off.
+func (s *TaskServer) SaveTaskReminderPreference(c echo.Context) error {
+	data := make(map[string]storage.TaskReminderPreference)
+
+	userUID, ok := c.Get("USER_UID").(uuid.UUID)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"data": "Unauthorized"})
+	}
+
+	// Validate //
+	channel := strings.ToUpper(strings.TrimSpace(c.FormValue("channel")))
+	recipient := strings.TrimSpace(c.FormValue("recipient"))
+
+	if channel != "" && !s.Notifier.HasChannel(channel) {
+		return Error(c, NewRequestValidationError(INVALID_OPTION, "channel"))
+	}
+
+	err := s.validateReminderRecipient(channel, recipient)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	remindBefore, err := time.ParseDuration(*config.Config.TaskReminderBefore)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	if v := c.FormValue("remind_before"); v != "" {
+		remindBefore, err = time.ParseDuration(v)
+		if err != nil {
+			return Error(c, NewRequestValidationError(PARSE_FAILED, "remind_before"))
+		}
+	}
+
+	remindOverdue := true
+	if v := c.FormValue("remind_overdue"); v != "" {
+		remindOverdue, err = strconv.ParseBool(v)
+		if err != nil {
+			return Error(c, NewRequestValidationError(PARSE_FAILED, "remind_overdue"))
+		}
+	}
+
+	rule, err := domain.CreateTaskReminderRule(
+		splitReminderCodes(c.FormValue("categories")),
+		splitReminderCodes(c.FormValue("priorities")),
+		remindBefore,
+		remindOverdue,
+	)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Process //
+	preference := storage.TaskReminderPreference{
+		UserUID:          userUID,
+		Channel:          channel,
+		Recipient:        recipient,
+		TaskReminderRule: rule,
+		LastUpdated:      time.Now(),
+	}
+
+	err = <-s.TaskReminderPreferenceRepo.Save(&preference)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	data["data"] = preference
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// SendTaskReminders sends the reminders of the open tasks to their assignees, through the channel of their preference.
+// It is run periodically by the scheduler. Each reminder is sent once for a due date,
+// and a reminder which fails to be sent is tried again on the next run.
+func (s *TaskServer) SendTaskReminders() error {
+	preferenceResult := <-s.TaskReminderPreferenceQuery.FindAll()
+	if preferenceResult.Error != nil {
+		return preferenceResult.Error
+	}
+
+	preferenceList, ok := preferenceResult.Result.([]storage.TaskReminderPreference)
+	if !ok {
+		return errors.New("Internal server error. Error type assertion")
+	}
+
+	preferences := make(map[uuid.UUID]storage.TaskReminderPreference)
+	remindBefore := time.Duration(0)
+
+	for _, v := range preferenceList {
+		if v.Channel == "" {
+			continue
+		}
+
+		preferences[v.UserUID] = v
+
+		if v.RemindBefore > remindBefore {
+			remindBefore = v.RemindBefore
+		}
+	}
+
+	if len(preferences) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+
+	taskResult := <-s.TaskReadQuery.FindAllOpenDueBefore(now.Add(remindBefore))
+	if taskResult.Error != nil {
+		return taskResult.Error
+	}
+
+	tasks, ok := taskResult.Result.([]storage.TaskRead)
+	if !ok {
+		return errors.New("Internal server error. Error type assertion")
+	}
+
+	failed := 0
+
+	for _, v := range tasks {
+		if v.AssigneeID == nil || v.DueDate == nil {
+			continue
+		}
+
+		preference, ok := preferences[*v.AssigneeID]
+		if !ok || !preference.Matches(v.Category, v.Priority) {
+			continue
+		}
+
+		kind := preference.ReminderKind(*v.DueDate, now)
+		if kind == "" {
+			continue
+		}
+
+		sentResult := <-s.TaskReminderSentQuery.FindByID(v.UID, preference.UserUID, kind)
+		if sentResult.Error != nil {
+			return sentResult.Error
+		}
+
+		sent, ok := sentResult.Result.(storage.TaskReminderSent)
+		if !ok {
+			return errors.New("Internal server error. Error type assertion")
+		}
+		if !sent.SentDate.IsZero() && sent.DueDate.Unix() == v.DueDate.Unix() {
+			continue
+		}
+
+		err := s.Notifier.Send(preference.Channel, taskReminderMessage(v, preference.Recipient, kind))
+		if err != nil {
+			log.Errorf("Task reminder of task %s to user %s failed. Err %v", v.UID, preference.UserUID, err)
+			failed++
+			continue
+		}
+
+		err = <-s.TaskReminderSentRepo.Save(&storage.TaskReminderSent{
+			TaskUID:  v.UID,
+			UserUID:  preference.UserUID,
+			Kind:     kind,
+			DueDate:  *v.DueDate,
+			SentDate: now,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d task reminders failed to be sent", failed)
+	}
+
+	return nil
+}
+
+func taskReminderMessage(task storage.TaskRead, recipient, kind string) notification.Message {
+	subject := "Task due soon: " + task.Title
+	if kind == domain.TaskReminderOverdue {
+		subject = "Task overdue: " + task.Title
+	}
+
+	body := task.Title + "\n\n" +
+		"Due date: " + task.DueDate.Format("Mon, 02 Jan 2006 15:04 MST") + "\n" +
+		"Category: " + task.Category + "\n" +
+		"Priority: " + task.Priority + "\n"
+
+	if task.Description != "" {
+		body += "\n" + task.Description + "\n"
+	}
+
+	return notification.Message{
+		Recipient: recipient,
+		Subject:   subject,
+		Body:      body,
+		Data: TaskReminderData{
+			Kind:     kind,
+			TaskUID:  task.UID,
+			Title:    task.Title,
+			Category: task.Category,
+			Priority: task.Priority,
+			DueDate:  *task.DueDate,
+		},
+	}
+}
+
+// validateReminderRecipient checks the recipient with the channel.
+// The file channel is the only one which needs no recipient.
+func (s *TaskServer) validateReminderRecipient(channel, recipient string) error {
+	if channel == "" {
+		return nil
+	}
+
+	if recipient == "" {
+		if channel == notification.ChannelFile {
+			return nil
+		}
+
+		return NewRequestValidationError(REQUIRED, "recipient")
+	}
+
+	err := s.Notifier.ValidateRecipient(channel, recipient)
+	if err == notification.ErrRecipientNotAllowed {
+		return NewRequestValidationError(INVALID_OPTION, "recipient")
+	}
+
+	if err != nil {
+		return NewRequestValidationError(PARSE_FAILED, "recipient")
+	}
+
+	return nil
+}
+
+func splitReminderCodes(value string) []string {
+	codes := []string{}
+
+	for _, v := range strings.Split(value, ",") {
+		v = strings.ToUpper(strings.TrimSpace(v))
+		if v != "" {
+			codes = append(codes, v)
+		}
+	}
+
+	return codes
+}
@@ -3,14 +3,19 @@ package server
 import (
 	"database/sql"
 	"net/http"
+	"path/filepath"
+	"strconv"
 	"time"
 
 	"github.com/Tanibox/tania-core/config"
 	assetsstorage "github.com/Tanibox/tania-core/src/assets/storage"
 	"github.com/Tanibox/tania-core/src/eventbus"
 	cropstorage "github.com/Tanibox/tania-core/src/growth/storage"
+	"github.com/Tanibox/tania-core/src/helper/imagehelper"
 	"github.com/Tanibox/tania-core/src/helper/paginationhelper"
+	"github.com/Tanibox/tania-core/src/helper/stringhelper"
 	"github.com/Tanibox/tania-core/src/helper/structhelper"
+	"github.com/Tanibox/tania-core/src/notification"
 	"github.com/Tanibox/tania-core/src/tasks/domain"
 	service "github.com/Tanibox/tania-core/src/tasks/domain/service"
 	"github.com/Tanibox/tania-core/src/tasks/query"
@@ -23,90 +28,166 @@ import (
 	repoSqlite "github.com/Tanibox/tania-core/src/tasks/repository/sqlite"
 	"github.com/Tanibox/tania-core/src/tasks/storage"
 	"github.com/labstack/echo"
+	"github.com/labstack/gommon/log"
 	uuid "github.com/satori/go.uuid"
 )
 
 // TaskServer ties the routes and handlers with injected dependencies
 type TaskServer struct {
-	TaskEventRepo  repository.TaskEventRepository
-	TaskReadRepo   repository.TaskReadRepository
-	TaskEventQuery query.TaskEventQuery
-	TaskReadQuery  query.TaskReadQuery
-	TaskService    domain.TaskService
-	EventBus       eventbus.TaniaEventBus
+	TaskEventRepo               repository.TaskEventRepository
+	TaskReadRepo                repository.TaskReadRepository
+	TaskCalendarFeedRepo        repository.TaskCalendarFeedRepository
+	TaskTimeEntryReadRepo       repository.TaskTimeEntryReadRepository
+	FinanceLedgerRepo           repository.FinanceLedgerRepository
+	TaskReminderPreferenceRepo  repository.TaskReminderPreferenceRepository
+	TaskReminderSentRepo        repository.TaskReminderSentRepository
+	SuccessionPlanTaskRepo      repository.SuccessionPlanTaskRepository
+	TaskEventQuery              query.TaskEventQuery
+	TaskReadQuery               query.TaskReadQuery
+	TaskCalendarFeedQuery       query.TaskCalendarFeedQuery
+	TaskTimeEntryReadQuery      query.TaskTimeEntryReadQuery
+	FinanceLedgerQuery          query.FinanceLedgerQuery
+	TaskReminderPreferenceQuery query.TaskReminderPreferenceQuery
+	TaskReminderSentQuery       query.TaskReminderSentQuery
+	SuccessionPlanTaskQuery     query.SuccessionPlanTaskQuery
+	TaskService                 domain.TaskService
+	EventBus                    eventbus.TaniaEventBus
+	Notifier                    *notification.Notifier
+	File                        File
 }
 
 // NewTaskServer initializes TaskServer's dependencies and create new TaskServer struct
 func NewTaskServer(
 	db *sql.DB,
 	bus eventbus.TaniaEventBus,
+	notifier *notification.Notifier,
+	farmStorage *assetsstorage.FarmReadStorage,
 	cropStorage *cropstorage.CropReadStorage,
 	areaStorage *assetsstorage.AreaReadStorage,
 	materialStorage *assetsstorage.MaterialReadStorage,
 	reservoirStorage *assetsstorage.ReservoirReadStorage,
 	taskEventStorage *storage.TaskEventStorage,
-	taskReadStorage *storage.TaskReadStorage) (*TaskServer, error) {
+	taskReadStorage *storage.TaskReadStorage,
+	taskCalendarFeedStorage *storage.TaskCalendarFeedStorage,
+	taskTimeEntryReadStorage *storage.TaskTimeEntryReadStorage,
+	financeLedgerStorage *storage.FinanceLedgerStorage,
+	taskReminderPreferenceStorage *storage.TaskReminderPreferenceStorage,
+	taskReminderSentStorage *storage.TaskReminderSentStorage,
+	successionPlanTaskStorage *storage.SuccessionPlanTaskStorage) (*TaskServer, error) {
 
 	taskServer := &TaskServer{
 		EventBus: bus,
+		Notifier: notifier,
+		File:     LocalFile{},
 	}
 
 	switch *config.Config.TaniaPersistenceEngine {
 	case config.DB_INMEMORY:
 		taskServer.TaskEventRepo = repoInMem.NewTaskEventRepositoryInMemory(taskEventStorage)
 		taskServer.TaskReadRepo = repoInMem.NewTaskReadRepositoryInMemory(taskReadStorage)
+		taskServer.TaskCalendarFeedRepo = repoInMem.NewTaskCalendarFeedRepositoryInMemory(taskCalendarFeedStorage)
+		taskServer.TaskTimeEntryReadRepo = repoInMem.NewTaskTimeEntryReadRepositoryInMemory(taskTimeEntryReadStorage)
+		taskServer.FinanceLedgerRepo = repoInMem.NewFinanceLedgerRepositoryInMemory(financeLedgerStorage)
+		taskServer.TaskReminderPreferenceRepo = repoInMem.NewTaskReminderPreferenceRepositoryInMemory(taskReminderPreferenceStorage)
+		taskServer.TaskReminderSentRepo = repoInMem.NewTaskReminderSentRepositoryInMemory(taskReminderSentStorage)
+		taskServer.SuccessionPlanTaskRepo = repoInMem.NewSuccessionPlanTaskRepositoryInMemory(successionPlanTaskStorage)
 
 		taskServer.TaskEventQuery = queryInMem.NewTaskEventQueryInMemory(taskEventStorage)
 		taskServer.TaskReadQuery = queryInMem.NewTaskReadQueryInMemory(taskReadStorage)
-
+		taskServer.TaskCalendarFeedQuery = queryInMem.NewTaskCalendarFeedQueryInMemory(taskCalendarFeedStorage)
+		taskServer.TaskTimeEntryReadQuery = queryInMem.NewTaskTimeEntryReadQueryInMemory(taskTimeEntryReadStorage)
+		taskServer.FinanceLedgerQuery = queryInMem.NewFinanceLedgerQueryInMemory(financeLedgerStorage)
+		taskServer.TaskReminderPreferenceQuery = queryInMem.NewTaskReminderPreferenceQueryInMemory(taskReminderPreferenceStorage)
+		taskServer.TaskReminderSentQuery = queryInMem.NewTaskReminderSentQueryInMemory(taskReminderSentStorage)
+		taskServer.SuccessionPlanTaskQuery = queryInMem.NewSuccessionPlanTaskQueryInMemory(successionPlanTaskStorage)
+
+		farmQuery := queryInMem.NewFarmQueryInMemory(farmStorage)
 		cropQuery := queryInMem.NewCropQueryInMemory(cropStorage)
 		areaQuery := queryInMem.NewAreaQueryInMemory(areaStorage)
 		materialReadQuery := queryInMem.NewMaterialQueryInMemory(materialStorage)
 		reservoirQuery := queryInMem.NewReservoirQueryInMemory(reservoirStorage)
+		userQuery := queryInMem.NewUserQueryInMemory()
 
 		taskServer.TaskService = service.TaskServiceSqlLite{
+			FarmQuery:      farmQuery,
 			CropQuery:      cropQuery,
 			AreaQuery:      areaQuery,
 			MaterialQuery:  materialReadQuery,
 			ReservoirQuery: reservoirQuery,
+			UserQuery:      userQuery,
+			TaskReadQuery:  taskServer.TaskReadQuery,
 		}
 
 	case config.DB_SQLITE:
 		taskServer.TaskEventRepo = repoSqlite.NewTaskEventRepositorySqlite(db)
 		taskServer.TaskReadRepo = repoSqlite.NewTaskReadRepositorySqlite(db)
+		taskServer.TaskCalendarFeedRepo = repoSqlite.NewTaskCalendarFeedRepositorySqlite(db)
+		taskServer.TaskTimeEntryReadRepo = repoSqlite.NewTaskTimeEntryReadRepositorySqlite(db)
+		taskServer.FinanceLedgerRepo = repoSqlite.NewFinanceLedgerRepositorySqlite(db)
+		taskServer.TaskReminderPreferenceRepo = repoSqlite.NewTaskReminderPreferenceRepositorySqlite(db)
+		taskServer.TaskReminderSentRepo = repoSqlite.NewTaskReminderSentRepositorySqlite(db)
+		taskServer.SuccessionPlanTaskRepo = repoSqlite.NewSuccessionPlanTaskRepositorySqlite(db)
 
 		taskServer.TaskEventQuery = querySqlite.NewTaskEventQuerySqlite(db)
 		taskServer.TaskReadQuery = querySqlite.NewTaskReadQuerySqlite(db)
-
+		taskServer.TaskCalendarFeedQuery = querySqlite.NewTaskCalendarFeedQuerySqlite(db)
+		taskServer.TaskTimeEntryReadQuery = querySqlite.NewTaskTimeEntryReadQuerySqlite(db)
+		taskServer.FinanceLedgerQuery = querySqlite.NewFinanceLedgerQuerySqlite(db)
+		taskServer.TaskReminderPreferenceQuery = querySqlite.NewTaskReminderPreferenceQuerySqlite(db)
+		taskServer.TaskReminderSentQuery = querySqlite.NewTaskReminderSentQuerySqlite(db)
+		taskServer.SuccessionPlanTaskQuery = querySqlite.NewSuccessionPlanTaskQuerySqlite(db)
+
+		farmQuery := querySqlite.NewFarmQuerySqlite(db)
 		cropQuery := querySqlite.NewCropQuerySqlite(db)
 		areaQuery := querySqlite.NewAreaQuerySqlite(db)
 		materialReadQuery := querySqlite.NewMaterialQuerySqlite(db)
 		reservoirQuery := querySqlite.NewReservoirQuerySqlite(db)
+		userQuery := querySqlite.NewUserQuerySqlite(db)
 
 		taskServer.TaskService = service.TaskServiceSqlLite{
+			FarmQuery:      farmQuery,
 			CropQuery:      cropQuery,
 			AreaQuery:      areaQuery,
 			MaterialQuery:  materialReadQuery,
 			ReservoirQuery: reservoirQuery,
+			UserQuery:      userQuery,
+			TaskReadQuery:  taskServer.TaskReadQuery,
 		}
 
 	case config.DB_MYSQL:
 		taskServer.TaskEventRepo = repoMysql.NewTaskEventRepositoryMysql(db)
 		taskServer.TaskReadRepo = repoMysql.NewTaskReadRepositoryMysql(db)
+		taskServer.TaskCalendarFeedRepo = repoMysql.NewTaskCalendarFeedRepositoryMysql(db)
+		taskServer.TaskTimeEntryReadRepo = repoMysql.NewTaskTimeEntryReadRepositoryMysql(db)
+		taskServer.FinanceLedgerRepo = repoMysql.NewFinanceLedgerRepositoryMysql(db)
+		taskServer.TaskReminderPreferenceRepo = repoMysql.NewTaskReminderPreferenceRepositoryMysql(db)
+		taskServer.TaskReminderSentRepo = repoMysql.NewTaskReminderSentRepositoryMysql(db)
+		taskServer.SuccessionPlanTaskRepo = repoMysql.NewSuccessionPlanTaskRepositoryMysql(db)
 
 		taskServer.TaskEventQuery = queryMysql.NewTaskEventQueryMysql(db)
 		taskServer.TaskReadQuery = queryMysql.NewTaskReadQueryMysql(db)
-
+		taskServer.TaskCalendarFeedQuery = queryMysql.NewTaskCalendarFeedQueryMysql(db)
+		taskServer.TaskTimeEntryReadQuery = queryMysql.NewTaskTimeEntryReadQueryMysql(db)
+		taskServer.FinanceLedgerQuery = queryMysql.NewFinanceLedgerQueryMysql(db)
+		taskServer.TaskReminderPreferenceQuery = queryMysql.NewTaskReminderPreferenceQueryMysql(db)
+		taskServer.TaskReminderSentQuery = queryMysql.NewTaskReminderSentQueryMysql(db)
+		taskServer.SuccessionPlanTaskQuery = queryMysql.NewSuccessionPlanTaskQueryMysql(db)
+
+		farmQuery := queryMysql.NewFarmQueryMysql(db)
 		cropQuery := queryMysql.NewCropQueryMysql(db)
 		areaQuery := queryMysql.NewAreaQueryMysql(db)
 		materialReadQuery := queryMysql.NewMaterialQueryMysql(db)
 		reservoirQuery := queryMysql.NewReservoirQueryMysql(db)
+		userQuery := queryMysql.NewUserQueryMysql(db)
 
 		taskServer.TaskService = service.TaskServiceSqlLite{
+			FarmQuery:      farmQuery,
 			CropQuery:      cropQuery,
 			AreaQuery:      areaQuery,
 			MaterialQuery:  materialReadQuery,
 			ReservoirQuery: reservoirQuery,
+			UserQuery:      userQuery,
+			TaskReadQuery:  taskServer.TaskReadQuery,
 		}
 
 	}
@@ -128,6 +209,30 @@ func (s *TaskServer) InitSubscriber() {
 	s.EventBus.Subscribe(domain.TaskCancelledCode, s.SaveToTaskReadModel)
 	s.EventBus.Subscribe(domain.TaskCompletedCode, s.SaveToTaskReadModel)
 	s.EventBus.Subscribe(domain.TaskDueCode, s.SaveToTaskReadModel)
+	s.EventBus.Subscribe(domain.TaskRecurrenceChangedCode, s.SaveToTaskReadModel)
+	s.EventBus.Subscribe(domain.TaskAssignedCode, s.SaveToTaskReadModel)
+	s.EventBus.Subscribe(domain.TaskChecklistItemAddedCode, s.SaveToTaskReadModel)
+	s.EventBus.Subscribe(domain.TaskChecklistItemCompletedCode, s.SaveToTaskReadModel)
+	s.EventBus.Subscribe(domain.TaskChecklistItemRemovedCode, s.SaveToTaskReadModel)
+	s.EventBus.Subscribe(domain.TaskBlockerAddedCode, s.SaveToTaskReadModel)
+	s.EventBus.Subscribe(domain.TaskBlockerRemovedCode, s.SaveToTaskReadModel)
+	s.EventBus.Subscribe(domain.TaskCommentAddedCode, s.SaveToTaskReadModel)
+	s.EventBus.Subscribe(domain.TaskAttachmentAddedCode, s.SaveToTaskReadModel)
+	s.EventBus.Subscribe(domain.TaskAttachmentRemovedCode, s.SaveToTaskReadModel)
+	s.EventBus.Subscribe(domain.TaskMaterialConsumedCode, s.SaveToTaskReadModel)
+
+	s.EventBus.Subscribe(domain.TaskFinancePostedCode, s.SaveToFinanceLedger)
+
+	s.EventBus.Subscribe(domain.TaskTimerStartedCode, s.SaveToTaskTimeEntryReadModel)
+	s.EventBus.Subscribe(domain.TaskTimerStoppedCode, s.SaveToTaskTimeEntryReadModel)
+	s.EventBus.Subscribe(domain.TaskTimeLoggedCode, s.SaveToTaskTimeEntryReadModel)
+	s.EventBus.Subscribe(domain.TaskTimeEntryRemovedCode, s.SaveToTaskTimeEntryReadModel)
+
+	s.EventBus.Subscribe("CropBatchTemplateApplied", s.CreateCropTemplateTasks)
+	s.EventBus.Subscribe("SuccessionPlanCreated", s.CreateSuccessionPlanTasks)
+	s.EventBus.Subscribe("SuccessionPlanBatchConfirmed", s.CloseSuccessionPlanTasks)
+	s.EventBus.Subscribe("SuccessionPlanBatchSkipped", s.CloseSuccessionPlanTasks)
+	s.EventBus.Subscribe("SuccessionPlanRemoved", s.CloseSuccessionPlanTasks)
 }
 
 // Mount defines the TaskServer's endpoints with its handlers
@@ -136,12 +241,36 @@ func (s *TaskServer) Mount(g *echo.Group) {
 
 	g.GET("", s.FindAllTasks)
 	g.GET("/search", s.FindFilteredTasks)
+	g.GET("/mine", s.FindMyTasks)
+	// The feed itself is served by MountCalendar, with the token of the user
+	g.GET("/calendar", s.FindCalendarFeed)
+	g.POST("/calendar", s.ResetCalendarFeed)
+	g.GET("/reports/labor", s.FindLaborReport)
+	g.GET("/ledger", s.FindFinanceLedger)
+	// The reminders are sent by the scheduler with SendTaskReminders
+	g.GET("/reminders", s.FindTaskReminderPreference)
+	g.PUT("/reminders", s.SaveTaskReminderPreference)
 	g.GET("/:id", s.FindTaskByID)
 	g.PUT("/:id", s.UpdateTask)
 	g.PUT("/:id/cancel", s.CancelTask)
 	g.PUT("/:id/complete", s.CompleteTask)
-	// As we don't have an async task right now to check for Due state,
-	// I'm adding a rest call to be able to manually do that. We can remove it in the future
+	g.PUT("/:id/assign", s.AssignTask)
+	g.POST("/:id/checklist", s.AddChecklistItem)
+	g.PUT("/:id/checklist/:item_id/complete", s.CompleteChecklistItem)
+	g.DELETE("/:id/checklist/:item_id", s.RemoveChecklistItem)
+	g.POST("/:id/blockers", s.AddBlocker)
+	g.DELETE("/:id/blockers/:blocker_id", s.RemoveBlocker)
+	g.POST("/:id/comments", s.AddComment)
+	g.POST("/:id/attachments", s.UploadTaskAttachment)
+	g.GET("/:id/attachments/:attachment_id", s.GetTaskAttachment)
+	g.DELETE("/:id/attachments/:attachment_id", s.RemoveTaskAttachment)
+	g.POST("/:id/timer/start", s.StartTaskTimer)
+	g.POST("/:id/timer/stop", s.StopTaskTimer)
+	g.GET("/:id/time_entries", s.FindTaskTimeEntries)
+	g.POST("/:id/time_entries", s.LogTaskTime)
+	g.DELETE("/:id/time_entries/:entry_id", s.RemoveTaskTimeEntry)
+	// The scheduler marks the overdue tasks as due with MarkDueTasks.
+	// This rest call is kept to mark a task as due manually without waiting for it.
 	g.PUT("/:id/due", s.SetTaskAsDue)
 }
 
@@ -191,7 +320,27 @@ func (s TaskServer) FindAllTasks(c echo.Context) error {
 }
 
 func (s TaskServer) FindFilteredTasks(c echo.Context) error {
-	data := make(map[string]interface{})
+	queryparams := make(map[string]string)
+	queryparams["is_due"] = c.QueryParam("is_due")
+	queryparams["priority"] = c.QueryParam("priority")
+	queryparams["status"] = c.QueryParam("status")
+	queryparams["domain"] = c.QueryParam("domain")
+	queryparams["asset_id"] = c.QueryParam("asset_id")
+	queryparams["category"] = c.QueryParam("category")
+	queryparams["due_start"] = c.QueryParam("due_start")
+	queryparams["due_end"] = c.QueryParam("due_end")
+	queryparams["assignee"] = c.QueryParam("assignee")
+
+	return s.findTasksWithFilter(c, queryparams)
+}
+
+// FindMyTasks returns the tasks assigned to the authenticated user,
+// with the same filters as FindFilteredTasks
+func (s TaskServer) FindMyTasks(c echo.Context) error {
+	userUID, ok := c.Get("USER_UID").(uuid.UUID)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"data": "Unauthorized"})
+	}
 
 	queryparams := make(map[string]string)
 	queryparams["is_due"] = c.QueryParam("is_due")
@@ -202,6 +351,13 @@ func (s TaskServer) FindFilteredTasks(c echo.Context) error {
 	queryparams["category"] = c.QueryParam("category")
 	queryparams["due_start"] = c.QueryParam("due_start")
 	queryparams["due_end"] = c.QueryParam("due_end")
+	queryparams["assignee"] = userUID.String()
+
+	return s.findTasksWithFilter(c, queryparams)
+}
+
+func (s TaskServer) findTasksWithFilter(c echo.Context, queryparams map[string]string) error {
+	data := make(map[string]interface{})
 
 	page := c.QueryParam("page")
 	limit := c.QueryParam("limit")
@@ -293,6 +449,27 @@ func (s *TaskServer) SaveTask(c echo.Context) error {
 		return Error(c, err)
 	}
 
+	recurrence := c.FormValue("recurrence")
+	if len(recurrence) != 0 {
+		_, err = task.ChangeTaskRecurrence(s.TaskService, recurrence)
+		if err != nil {
+			return Error(c, err)
+		}
+	}
+
+	assigneeID := c.FormValue("assignee_id")
+	if len(assigneeID) != 0 {
+		uid, err := uuid.FromString(assigneeID)
+		if err != nil {
+			return Error(c, NewRequestValidationError(PARSE_FAILED, "assignee_id"))
+		}
+
+		_, err = task.AssignTask(s.TaskService, &uid)
+		if err != nil {
+			return Error(c, err)
+		}
+	}
+
 	err = <-s.TaskEventRepo.Save(task.UID, 0, task.UncommittedChanges)
 	if err != nil {
 		return Error(c, err)
@@ -357,7 +534,25 @@ func (s *TaskServer) CreateTaskDomainByCode(domaincode string, c echo.Context) (
 
 		return domain.CreateTaskDomainCrop(s.TaskService, category, materialPtr, areaPtr)
 	case domain.TaskDomainFinanceCode:
-		return domain.CreateTaskDomainFinance()
+
+		materialID := c.FormValue("material_id")
+
+		materialPtr := (*uuid.UUID)(nil)
+		if len(materialID) != 0 {
+			uid, err := uuid.FromString(materialID)
+			if err != nil {
+				return domain.TaskDomainFinance{}, err
+			}
+			materialPtr = &uid
+		}
+
+		return domain.CreateTaskDomainFinance(
+			s.TaskService,
+			c.FormValue("amount"),
+			c.FormValue("currency_code"),
+			c.FormValue("direction"),
+			c.FormValue("counterparty"),
+			materialPtr)
 	case domain.TaskDomainGeneralCode:
 		return domain.CreateTaskDomainGeneral()
 	case domain.TaskDomainInventoryCode:
@@ -528,6 +723,27 @@ func (s *TaskServer) UpdateTask(c echo.Context) error {
 	// Build TaskEvents from history
 	task := repository.BuildTaskFromEventHistory(s.TaskService, events)
 
+	// Changing only this occurrence of a recurring task takes it out of its series,
+	// so the series goes on with its next occurrence right away
+	scope := c.FormValue("recurrence_scope")
+	if scope != "" && scope != domain.TaskRecurrenceScopeThis && scope != domain.TaskRecurrenceScopeFollowing {
+		return Error(c, NewRequestValidationError(INVALID_OPTION, "recurrence_scope"))
+	}
+
+	nextTask := (*domain.Task)(nil)
+	if task.RecurrenceRule != "" && scope == domain.TaskRecurrenceScopeThis {
+		if len(c.FormValue("recurrence")) != 0 {
+			return Error(c, NewRequestValidationError(INVALID_OPTION, "recurrence_scope"))
+		}
+
+		nextTask, err = task.CreateNextOccurrence(s.TaskService)
+		if err != nil {
+			return Error(c, err)
+		}
+
+		task.ChangeTaskRecurrence(s.TaskService, "")
+	}
+
 	updatedTask, err := s.updateTaskAttributes(s.TaskService, task, c)
 	if err != nil {
 		return Error(c, err)
@@ -541,6 +757,12 @@ func (s *TaskServer) UpdateTask(c echo.Context) error {
 
 	// Trigger Events
 	s.publishUncommittedEvents(updatedTask)
+
+	err = s.saveNextOccurrence(nextTask)
+	if err != nil {
+		return Error(c, err)
+	}
+
 	read := MapTaskToTaskRead(updatedTask)
 
 	s.AppendTaskDomainDetails(read)
@@ -596,6 +818,20 @@ func (s *TaskServer) updateTaskAttributes(taskService domain.TaskService, task *
 		task.ChangeTaskDetails(s.TaskService, details)
 	}
 
+	// Change Task Recurrence, which applies to the following occurrences.
+	// NONE ends the recurrence.
+	recurrence := c.FormValue("recurrence")
+	if len(recurrence) != 0 {
+		if recurrence == domain.TaskRecurrenceNone {
+			recurrence = ""
+		}
+
+		_, err := task.ChangeTaskRecurrence(s.TaskService, recurrence)
+		if err != nil {
+			return task, err
+		}
+	}
+
 	return task, nil
 }
 
@@ -631,7 +867,15 @@ func (s *TaskServer) CancelTask(c echo.Context) error {
 		return Error(c, err)
 	}
 
-	updatedTask.CancelTask(s.TaskService)
+	err = updatedTask.CancelTask(s.TaskService)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	nextTask, err := updatedTask.CreateNextOccurrence(s.TaskService)
+	if err != nil {
+		return Error(c, err)
+	}
 
 	// Save new TaskEvent
 	err = <-s.TaskEventRepo.Save(updatedTask.UID, updatedTask.Version, updatedTask.UncommittedChanges)
@@ -642,6 +886,11 @@ func (s *TaskServer) CancelTask(c echo.Context) error {
 	// Trigger Events
 	s.publishUncommittedEvents(updatedTask)
 
+	err = s.saveNextOccurrence(nextTask)
+	if err != nil {
+		return Error(c, err)
+	}
+
 	read := MapTaskToTaskRead(updatedTask)
 
 	s.AppendTaskDomainDetails(read)
@@ -690,7 +939,31 @@ func (s *TaskServer) CompleteTask(c echo.Context) error {
 		return Error(c, err)
 	}
 
-	updatedTask.CompleteTask(s.TaskService)
+	err = updatedTask.CompleteTask(s.TaskService)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// The material used by the task is deducted from the stock by the assets
+	consumedQuantity := c.FormValue("consumed_quantity")
+	if consumedQuantity != "" {
+		q, err := strconv.ParseFloat(consumedQuantity, 32)
+		if err != nil {
+			return Error(c, NewRequestValidationError(PARSE_FAILED, "consumed_quantity"))
+		}
+
+		allowInsufficientStock := *config.Config.TaskNegativeStock == config.NEGATIVE_STOCK_FLAG
+
+		err = updatedTask.ConsumeMaterial(s.TaskService, float32(q), allowInsufficientStock)
+		if err != nil {
+			return Error(c, err)
+		}
+	}
+
+	nextTask, err := updatedTask.CreateNextOccurrence(s.TaskService)
+	if err != nil {
+		return Error(c, err)
+	}
 
 	// Save new TaskEvent
 	err = <-s.TaskEventRepo.Save(updatedTask.UID, updatedTask.Version, updatedTask.UncommittedChanges)
@@ -700,6 +973,11 @@ func (s *TaskServer) CompleteTask(c echo.Context) error {
 
 	// Trigger Events
 	s.publishUncommittedEvents(updatedTask)
+
+	err = s.saveNextOccurrence(nextTask)
+	if err != nil {
+		return Error(c, err)
+	}
 	read := MapTaskToTaskRead(updatedTask)
 
 	s.AppendTaskDomainDetails(read)
@@ -756,6 +1034,497 @@ func (s *TaskServer) SetTaskAsDue(c echo.Context) error {
 	return c.JSON(http.StatusOK, data)
 }
 
+// AssignTask assigns the task to the user of the assignee_id param.
+// The task is unassigned when it is empty.
+func (s *TaskServer) AssignTask(c echo.Context) error {
+	data := make(map[string]storage.TaskRead)
+
+	// Validate //
+	uid, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	var assigneeUID *uuid.UUID
+	if assigneeID := c.FormValue("assignee_id"); assigneeID != "" {
+		uid, err := uuid.FromString(assigneeID)
+		if err != nil {
+			return Error(c, NewRequestValidationError(PARSE_FAILED, "assignee_id"))
+		}
+
+		assigneeUID = &uid
+	}
+
+	readResult := <-s.TaskReadQuery.FindByID(uid)
+	if readResult.Error != nil {
+		return Error(c, readResult.Error)
+	}
+
+	taskRead, ok := readResult.Result.(storage.TaskRead)
+	if !ok || taskRead.UID != uid {
+		return Error(c, NewRequestValidationError(NOT_FOUND, "id"))
+	}
+
+	eventQueryResult := <-s.TaskEventQuery.FindAllByTaskID(uid)
+	if eventQueryResult.Error != nil {
+		return Error(c, eventQueryResult.Error)
+	}
+
+	events := eventQueryResult.Result.([]storage.TaskEvent)
+
+	// Process //
+	task := repository.BuildTaskFromEventHistory(s.TaskService, events)
+
+	_, err = task.AssignTask(s.TaskService, assigneeUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Persists //
+	err = <-s.TaskEventRepo.Save(task.UID, task.Version, task.UncommittedChanges)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Trigger Events
+	s.publishUncommittedEvents(task)
+
+	read := MapTaskToTaskRead(task)
+
+	s.AppendTaskDomainDetails(read)
+
+	data["data"] = *read
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// AddChecklistItem adds an item to the checklist of the task
+func (s *TaskServer) AddChecklistItem(c echo.Context) error {
+	// Validate //
+	title := c.FormValue("title")
+	if title == "" {
+		return Error(c, NewRequestValidationError(REQUIRED, "title"))
+	}
+
+	task, err := s.findTaskFromParam(c)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Process //
+	_, err = task.AddChecklistItem(s.TaskService, title)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return s.saveTaskChanges(c, task)
+}
+
+// CompleteChecklistItem checks an item of the checklist of the task
+func (s *TaskServer) CompleteChecklistItem(c echo.Context) error {
+	// Validate //
+	itemUID, err := uuid.FromString(c.Param("item_id"))
+	if err != nil {
+		return Error(c, NewRequestValidationError(PARSE_FAILED, "item_id"))
+	}
+
+	task, err := s.findTaskFromParam(c)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Process //
+	_, err = task.CompleteChecklistItem(s.TaskService, itemUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return s.saveTaskChanges(c, task)
+}
+
+// RemoveChecklistItem removes an item from the checklist of the task
+func (s *TaskServer) RemoveChecklistItem(c echo.Context) error {
+	// Validate //
+	itemUID, err := uuid.FromString(c.Param("item_id"))
+	if err != nil {
+		return Error(c, NewRequestValidationError(PARSE_FAILED, "item_id"))
+	}
+
+	task, err := s.findTaskFromParam(c)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Process //
+	_, err = task.RemoveChecklistItem(s.TaskService, itemUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return s.saveTaskChanges(c, task)
+}
+
+// AddBlocker makes the task blocked by the task of the blocker_id param.
+// The task can't be completed until its blockers are completed or cancelled.
+func (s *TaskServer) AddBlocker(c echo.Context) error {
+	// Validate //
+	blockerID := c.FormValue("blocker_id")
+	if blockerID == "" {
+		return Error(c, NewRequestValidationError(REQUIRED, "blocker_id"))
+	}
+
+	blockerUID, err := uuid.FromString(blockerID)
+	if err != nil {
+		return Error(c, NewRequestValidationError(PARSE_FAILED, "blocker_id"))
+	}
+
+	task, err := s.findTaskFromParam(c)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Process //
+	_, err = task.AddBlocker(s.TaskService, blockerUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return s.saveTaskChanges(c, task)
+}
+
+// RemoveBlocker removes a blocker from the task
+func (s *TaskServer) RemoveBlocker(c echo.Context) error {
+	// Validate //
+	blockerUID, err := uuid.FromString(c.Param("blocker_id"))
+	if err != nil {
+		return Error(c, NewRequestValidationError(PARSE_FAILED, "blocker_id"))
+	}
+
+	task, err := s.findTaskFromParam(c)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Process //
+	_, err = task.RemoveBlocker(s.TaskService, blockerUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return s.saveTaskChanges(c, task)
+}
+
+// AddComment adds a comment to the task, written by the signed in user
+func (s *TaskServer) AddComment(c echo.Context) error {
+	// Validate //
+	content := c.FormValue("content")
+	if content == "" {
+		return Error(c, NewRequestValidationError(REQUIRED, "content"))
+	}
+
+	var authorUID *uuid.UUID
+	if userUID, ok := c.Get("USER_UID").(uuid.UUID); ok {
+		authorUID = &userUID
+	}
+
+	task, err := s.findTaskFromParam(c)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Process //
+	_, err = task.AddComment(s.TaskService, content, authorUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return s.saveTaskChanges(c, task)
+}
+
+// UploadTaskAttachment attaches an image or a PDF file to the task.
+// The files are stored in a folder per task, and the images get thumbnails as the crop photos.
+func (s *TaskServer) UploadTaskAttachment(c echo.Context) error {
+	// Validate //
+	attachment, err := c.FormFile("attachment")
+	if err != nil {
+		return Error(c, NewRequestValidationError(REQUIRED, "attachment"))
+	}
+
+	mimeType := attachment.Header.Get("Content-Type")
+	if !domain.IsTaskAttachmentImage(mimeType) && mimeType != domain.TaskAttachmentMimeTypePDF {
+		return Error(c, NewRequestValidationError(INVALID_OPTION, "attachment"))
+	}
+
+	var uploaderUID *uuid.UUID
+	if userUID, ok := c.Get("USER_UID").(uuid.UUID); ok {
+		uploaderUID = &userUID
+	}
+
+	task, err := s.findTaskFromParam(c)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Process //
+	attachmentUID, err := uuid.NewV4()
+	if err != nil {
+		return Error(c, err)
+	}
+
+	filename := filepath.Base(attachment.Filename)
+	destPath := taskAttachmentFolder(task.UID) + domain.TaskAttachment{UID: attachmentUID, Filename: filename}.StoredFilename()
+
+	err = s.File.Upload(attachment, destPath)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	width, height := 0, 0
+	if domain.IsTaskAttachmentImage(mimeType) {
+		width, height, err = imagehelper.GetImageDimension(destPath)
+		if err != nil {
+			return Error(c, err)
+		}
+
+		err = imagehelper.CreateThumbnails(destPath)
+		if err != nil {
+			return Error(c, err)
+		}
+	}
+
+	_, err = task.AddAttachment(s.TaskService, attachmentUID, filename, mimeType, int(attachment.Size), width, height, uploaderUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return s.saveTaskChanges(c, task)
+}
+
+// GetTaskAttachment serves the attachment file. The size param selects a thumbnail of an image.
+func (s *TaskServer) GetTaskAttachment(c echo.Context) error {
+	// Validate //
+	uid, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, NewRequestValidationError(PARSE_FAILED, "id"))
+	}
+
+	attachmentUID, err := uuid.FromString(c.Param("attachment_id"))
+	if err != nil {
+		return Error(c, NewRequestValidationError(PARSE_FAILED, "attachment_id"))
+	}
+
+	size := c.QueryParam("size")
+	if size != "" && !imagehelper.IsValidThumbnailSize(size) {
+		return Error(c, NewRequestValidationError(INVALID_OPTION, "size"))
+	}
+
+	readResult := <-s.TaskReadQuery.FindByID(uid)
+	if readResult.Error != nil {
+		return Error(c, readResult.Error)
+	}
+
+	taskRead, ok := readResult.Result.(storage.TaskRead)
+	if !ok || taskRead.UID != uid {
+		return Error(c, NewRequestValidationError(NOT_FOUND, "id"))
+	}
+
+	found := (*domain.TaskAttachment)(nil)
+	for i, v := range taskRead.Attachments {
+		if v.UID == attachmentUID {
+			found = &taskRead.Attachments[i]
+		}
+	}
+
+	if found == nil {
+		return Error(c, NewRequestValidationError(NOT_FOUND, "attachment_id"))
+	}
+
+	if size != "" && !found.IsImage() {
+		return Error(c, NewRequestValidationError(INVALID_OPTION, "size"))
+	}
+
+	// Process //
+	srcPath := taskAttachmentPath(taskRead.UID, *found)
+
+	if size != "" {
+		srcPath, err = imagehelper.GetThumbnail(srcPath, size)
+		if err != nil {
+			return Error(c, err)
+		}
+	}
+
+	return c.File(srcPath)
+}
+
+// RemoveTaskAttachment removes the attachment and its files
+func (s *TaskServer) RemoveTaskAttachment(c echo.Context) error {
+	data := make(map[string]storage.TaskRead)
+
+	// Validate //
+	attachmentUID, err := uuid.FromString(c.Param("attachment_id"))
+	if err != nil {
+		return Error(c, NewRequestValidationError(PARSE_FAILED, "attachment_id"))
+	}
+
+	task, err := s.findTaskFromParam(c)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Process //
+	removed := task.Attachment(attachmentUID)
+	if removed == nil {
+		return Error(c, NewRequestValidationError(NOT_FOUND, "attachment_id"))
+	}
+
+	removedAttachment := *removed
+
+	_, err = task.RemoveAttachment(s.TaskService, attachmentUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Persists //
+	err = <-s.TaskEventRepo.Save(task.UID, task.Version, task.UncommittedChanges)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	srcPath := taskAttachmentPath(task.UID, removedAttachment)
+
+	paths := []string{srcPath}
+	if removedAttachment.IsImage() {
+		for size := range imagehelper.ThumbnailSizes {
+			paths = append(paths, imagehelper.GetThumbnailPath(srcPath, size))
+		}
+	}
+
+	for _, v := range paths {
+		err = s.File.Remove(v)
+		if err != nil {
+			log.Error(err)
+		}
+	}
+
+	// Trigger Events
+	s.publishUncommittedEvents(task)
+
+	read := MapTaskToTaskRead(task)
+
+	s.AppendTaskDomainDetails(read)
+
+	data["data"] = *read
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// taskAttachmentPath is the file of the attachment, named after its UID
+func taskAttachmentPath(taskUID uuid.UUID, attachment domain.TaskAttachment) string {
+	return taskAttachmentFolder(taskUID) + attachment.StoredFilename()
+}
+
+func taskAttachmentFolder(taskUID uuid.UUID) string {
+	return stringhelper.Join(*config.Config.UploadPathTask, "/", taskUID.String(), "/")
+}
+
+// findTaskFromParam builds the task of the id param from its event history
+func (s *TaskServer) findTaskFromParam(c echo.Context) (*domain.Task, error) {
+	uid, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return nil, NewRequestValidationError(PARSE_FAILED, "id")
+	}
+
+	readResult := <-s.TaskReadQuery.FindByID(uid)
+	if readResult.Error != nil {
+		return nil, readResult.Error
+	}
+
+	taskRead, ok := readResult.Result.(storage.TaskRead)
+	if !ok || taskRead.UID != uid {
+		return nil, NewRequestValidationError(NOT_FOUND, "id")
+	}
+
+	eventQueryResult := <-s.TaskEventQuery.FindAllByTaskID(uid)
+	if eventQueryResult.Error != nil {
+		return nil, eventQueryResult.Error
+	}
+
+	events := eventQueryResult.Result.([]storage.TaskEvent)
+
+	return repository.BuildTaskFromEventHistory(s.TaskService, events), nil
+}
+
+// saveTaskChanges saves and publishes the uncommitted events of the task, and responds with it
+func (s *TaskServer) saveTaskChanges(c echo.Context, task *domain.Task) error {
+	data := make(map[string]storage.TaskRead)
+
+	// Persists //
+	err := <-s.TaskEventRepo.Save(task.UID, task.Version, task.UncommittedChanges)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Trigger Events
+	s.publishUncommittedEvents(task)
+
+	read := MapTaskToTaskRead(task)
+
+	s.AppendTaskDomainDetails(read)
+
+	data["data"] = *read
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// MarkDueTasks marks the open tasks whose due date has passed as due.
+// It is run periodically by the scheduler.
+func (s *TaskServer) MarkDueTasks() error {
+	queryResult := <-s.TaskReadQuery.FindAllDueBefore(time.Now())
+	if queryResult.Error != nil {
+		return queryResult.Error
+	}
+
+	tasks := queryResult.Result.([]storage.TaskRead)
+
+	for _, v := range tasks {
+		eventQueryResult := <-s.TaskEventQuery.FindAllByTaskID(v.UID)
+		if eventQueryResult.Error != nil {
+			return eventQueryResult.Error
+		}
+
+		events := eventQueryResult.Result.([]storage.TaskEvent)
+
+		task := repository.BuildTaskFromEventHistory(s.TaskService, events)
+
+		task.SetTaskAsDue(s.TaskService)
+
+		err := <-s.TaskEventRepo.Save(task.UID, task.Version, task.UncommittedChanges)
+		if err != nil {
+			return err
+		}
+
+		s.publishUncommittedEvents(task)
+	}
+
+	return nil
+}
+
+// saveNextOccurrence saves the next occurrence of a recurring task, if there is one
+func (s *TaskServer) saveNextOccurrence(task *domain.Task) error {
+	if task == nil {
+		return nil
+	}
+
+	err := <-s.TaskEventRepo.Save(task.UID, 0, task.UncommittedChanges)
+	if err != nil {
+		return err
+	}
+
+	s.publishUncommittedEvents(task)
+
+	return nil
+}
+
 func (s *TaskServer) publishUncommittedEvents(entity interface{}) error {
 
 	switch e := entity.(type) {
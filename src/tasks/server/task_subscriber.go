@@ -2,11 +2,19 @@ package server
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
+	"strings"
+	"time"
 
+	growthdomain "github.com/Tanibox/tania-core/src/growth/domain"
+	"github.com/Tanibox/tania-core/src/helper/datetimehelper"
 	"github.com/Tanibox/tania-core/src/tasks/domain"
+	"github.com/Tanibox/tania-core/src/tasks/query"
+	"github.com/Tanibox/tania-core/src/tasks/repository"
 	"github.com/Tanibox/tania-core/src/tasks/storage"
 	"github.com/labstack/echo"
+	"github.com/labstack/gommon/log"
 	uuid "github.com/satori/go.uuid"
 )
 
@@ -126,6 +134,196 @@ func (s *TaskServer) SaveToTaskReadModel(event interface{}) error {
 		taskReadFromRepo.IsDue = true
 		taskRead = taskReadFromRepo
 
+	case domain.TaskRecurrenceChanged:
+
+		// Get TaskRead By UID
+
+		taskReadFromRepo, err := s.getTaskReadFromID(e.UID)
+		if err != nil {
+			return err
+		}
+
+		taskReadFromRepo.RecurrenceRule = e.RecurrenceRule
+		taskReadFromRepo.SeriesID = e.SeriesID
+		taskRead = taskReadFromRepo
+
+	case domain.TaskAssigned:
+
+		// Get TaskRead By UID
+
+		taskReadFromRepo, err := s.getTaskReadFromID(e.UID)
+		if err != nil {
+			return err
+		}
+
+		taskReadFromRepo.AssigneeID = e.AssigneeID
+		taskRead = taskReadFromRepo
+
+	case domain.TaskChecklistItemAdded:
+
+		// Get TaskRead By UID
+
+		taskReadFromRepo, err := s.getTaskReadFromID(e.UID)
+		if err != nil {
+			return err
+		}
+
+		checklist := append([]domain.ChecklistItem{}, taskReadFromRepo.Checklist...)
+		taskReadFromRepo.Checklist = append(checklist, domain.ChecklistItem{
+			UID:         e.ItemUID,
+			Title:       e.Title,
+			CreatedDate: e.CreatedDate,
+		})
+		taskRead = taskReadFromRepo
+
+	case domain.TaskChecklistItemCompleted:
+
+		// Get TaskRead By UID
+
+		taskReadFromRepo, err := s.getTaskReadFromID(e.UID)
+		if err != nil {
+			return err
+		}
+
+		checklist := []domain.ChecklistItem{}
+		for _, v := range taskReadFromRepo.Checklist {
+			if v.UID == e.ItemUID {
+				v.IsCompleted = true
+				v.CompletedDate = e.CompletedDate
+			}
+
+			checklist = append(checklist, v)
+		}
+
+		taskReadFromRepo.Checklist = checklist
+		taskRead = taskReadFromRepo
+
+	case domain.TaskChecklistItemRemoved:
+
+		// Get TaskRead By UID
+
+		taskReadFromRepo, err := s.getTaskReadFromID(e.UID)
+		if err != nil {
+			return err
+		}
+
+		checklist := []domain.ChecklistItem{}
+		for _, v := range taskReadFromRepo.Checklist {
+			if v.UID != e.ItemUID {
+				checklist = append(checklist, v)
+			}
+		}
+
+		taskReadFromRepo.Checklist = checklist
+		taskRead = taskReadFromRepo
+
+	case domain.TaskBlockerAdded:
+
+		// Get TaskRead By UID
+
+		taskReadFromRepo, err := s.getTaskReadFromID(e.UID)
+		if err != nil {
+			return err
+		}
+
+		blockedBy := append([]uuid.UUID{}, taskReadFromRepo.BlockedBy...)
+		taskReadFromRepo.BlockedBy = append(blockedBy, e.BlockerUID)
+		taskRead = taskReadFromRepo
+
+	case domain.TaskBlockerRemoved:
+
+		// Get TaskRead By UID
+
+		taskReadFromRepo, err := s.getTaskReadFromID(e.UID)
+		if err != nil {
+			return err
+		}
+
+		blockedBy := []uuid.UUID{}
+		for _, v := range taskReadFromRepo.BlockedBy {
+			if v != e.BlockerUID {
+				blockedBy = append(blockedBy, v)
+			}
+		}
+
+		taskReadFromRepo.BlockedBy = blockedBy
+		taskRead = taskReadFromRepo
+
+	case domain.TaskCommentAdded:
+
+		// Get TaskRead By UID
+
+		taskReadFromRepo, err := s.getTaskReadFromID(e.UID)
+		if err != nil {
+			return err
+		}
+
+		comments := append([]domain.TaskComment{}, taskReadFromRepo.Comments...)
+		taskReadFromRepo.Comments = append(comments, domain.TaskComment{
+			UID:         e.CommentUID,
+			Content:     e.Content,
+			AuthorID:    e.AuthorID,
+			CreatedDate: e.CreatedDate,
+		})
+		taskRead = taskReadFromRepo
+
+	case domain.TaskAttachmentAdded:
+
+		// Get TaskRead By UID
+
+		taskReadFromRepo, err := s.getTaskReadFromID(e.UID)
+		if err != nil {
+			return err
+		}
+
+		attachments := append([]domain.TaskAttachment{}, taskReadFromRepo.Attachments...)
+		taskReadFromRepo.Attachments = append(attachments, domain.TaskAttachment{
+			UID:         e.AttachmentUID,
+			Filename:    e.Filename,
+			MimeType:    e.MimeType,
+			Size:        e.Size,
+			Width:       e.Width,
+			Height:      e.Height,
+			UploaderID:  e.UploaderID,
+			CreatedDate: e.CreatedDate,
+		})
+		taskRead = taskReadFromRepo
+
+	case domain.TaskAttachmentRemoved:
+
+		// Get TaskRead By UID
+
+		taskReadFromRepo, err := s.getTaskReadFromID(e.UID)
+		if err != nil {
+			return err
+		}
+
+		attachments := []domain.TaskAttachment{}
+		for _, v := range taskReadFromRepo.Attachments {
+			if v.UID != e.AttachmentUID {
+				attachments = append(attachments, v)
+			}
+		}
+
+		taskReadFromRepo.Attachments = attachments
+		taskRead = taskReadFromRepo
+	case domain.TaskMaterialConsumed:
+
+		// Get TaskRead By UID
+		taskReadFromRepo, err := s.getTaskReadFromID(e.UID)
+		if err != nil {
+			return err
+		}
+
+		taskReadFromRepo.MaterialConsumption = &domain.TaskMaterialConsumption{
+			MaterialID:        e.MaterialID,
+			Quantity:          e.Quantity,
+			QuantityUnit:      e.QuantityUnit,
+			InsufficientStock: e.InsufficientStock,
+			ConsumedDate:      e.ConsumedDate,
+		}
+		taskRead = taskReadFromRepo
+
 	default:
 		return errors.New("Unknown task event")
 	}
@@ -138,6 +336,305 @@ func (s *TaskServer) SaveToTaskReadModel(event interface{}) error {
 	return nil
 }
 
+// SaveToTaskTimeEntryReadModel keeps the time entries in their own read model, to report on the labor by date
+func (s *TaskServer) SaveToTaskTimeEntryReadModel(event interface{}) error {
+	timeEntryRead := &storage.TaskTimeEntryRead{}
+
+	switch e := event.(type) {
+	case domain.TaskTimerStarted:
+
+		timeEntryRead.UID = e.EntryUID
+		timeEntryRead.TaskUID = e.UID
+		timeEntryRead.WorkerID = e.WorkerID
+		timeEntryRead.HourlyRate = e.HourlyRate
+		timeEntryRead.StartDate = e.StartDate
+	case domain.TaskTimerStopped:
+
+		readResult := <-s.TaskTimeEntryReadQuery.FindByID(e.EntryUID)
+		if readResult.Error != nil {
+			return readResult.Error
+		}
+
+		timeEntryFromRepo, ok := readResult.Result.(storage.TaskTimeEntryRead)
+		if !ok {
+			return errors.New("Internal server error. Error type assertion")
+		}
+
+		if timeEntryFromRepo.UID != e.EntryUID {
+			return domain.TaskError{domain.TaskErrorTimeEntryNotFoundCode}
+		}
+
+		endDate := e.EndDate
+		timeEntryFromRepo.EndDate = &endDate
+		timeEntryFromRepo.Duration = e.Duration
+		timeEntryRead = &timeEntryFromRepo
+	case domain.TaskTimeLogged:
+
+		endDate := e.EndDate
+
+		timeEntryRead.UID = e.EntryUID
+		timeEntryRead.TaskUID = e.UID
+		timeEntryRead.WorkerID = e.WorkerID
+		timeEntryRead.HourlyRate = e.HourlyRate
+		timeEntryRead.StartDate = e.StartDate
+		timeEntryRead.EndDate = &endDate
+		timeEntryRead.Duration = e.Duration
+	case domain.TaskTimeEntryRemoved:
+
+		return <-s.TaskTimeEntryReadRepo.Remove(e.EntryUID)
+
+	default:
+		return errors.New("Unknown task time entry event")
+	}
+
+	return <-s.TaskTimeEntryReadRepo.Save(timeEntryRead)
+}
+
+// SaveToFinanceLedger posts the completed finance task to the ledger of its farm
+func (s *TaskServer) SaveToFinanceLedger(event interface{}) error {
+	e, ok := event.(domain.TaskFinancePosted)
+	if !ok {
+		return errors.New("Unknown finance ledger event")
+	}
+
+	taskRead, err := s.getTaskReadFromID(e.UID)
+	if err != nil {
+		return err
+	}
+
+	return <-s.FinanceLedgerRepo.Save(&storage.FinanceLedgerEntry{
+		TaskUID:      e.UID,
+		FarmUID:      e.FarmID,
+		Title:        taskRead.Title,
+		Amount:       e.Amount,
+		CurrencyCode: e.CurrencyCode,
+		Direction:    e.Direction,
+		Counterparty: e.Counterparty,
+		MaterialID:   e.MaterialID,
+		PostedDate:   e.PostedDate,
+	})
+}
+
+// CreateCropTemplateTasks creates the tasks scheduled by a crop template for a crop batch.
+// The due date of each task is counted from the seeding date of the crop batch.
+func (s *TaskServer) CreateCropTemplateTasks(event interface{}) error {
+	e, ok := event.(growthdomain.CropBatchTemplateApplied)
+	if !ok {
+		log.Error(errors.New("Internal server error. Error type assertion"))
+		return nil
+	}
+
+	for _, v := range e.Tasks {
+		description := v.Description
+		if description == "" {
+			description = "Scheduled by the " + e.TemplateName + " crop template for " + e.BatchID
+		}
+
+		taskDomain, err := domain.CreateTaskDomainCrop(s.TaskService, v.Category, v.MaterialUID, &e.AreaUID)
+		if err != nil {
+			log.Error(err)
+			continue
+		}
+
+		for _, offset := range v.DayOffsets() {
+			dueDate := e.SeedingDate.AddDate(0, 0, offset)
+
+			task, err := domain.CreateTask(
+				s.TaskService,
+				v.Title,
+				description,
+				&dueDate,
+				v.Priority,
+				taskDomain,
+				v.Category,
+				&e.UID)
+			if err != nil {
+				log.Error(err)
+				continue
+			}
+
+			err = <-s.TaskEventRepo.Save(task.UID, 0, task.UncommittedChanges)
+			if err != nil {
+				log.Error(err)
+				continue
+			}
+
+			s.publishUncommittedEvents(task)
+		}
+	}
+
+	return nil
+}
+
+// CreateSuccessionPlanTasks creates a seeding task in the area of each planned crop batch of a succession plan.
+// The planned crop batches seeded before today don't get a task, because a task cannot be due in the past.
+func (s *TaskServer) CreateSuccessionPlanTasks(event interface{}) error {
+	e, ok := event.(growthdomain.SuccessionPlanCreated)
+	if !ok {
+		log.Error(errors.New("Internal server error. Error type assertion"))
+		return nil
+	}
+
+	title := "Seed " + e.Name
+	serviceResult := s.TaskService.FindMaterialByID(e.InventoryUID)
+	if material, ok := serviceResult.Result.(query.TaskMaterialQueryResult); ok && serviceResult.Error == nil {
+		title = "Seed " + material.Name
+	}
+
+	taskDomain, err := domain.CreateTaskDomainArea(s.TaskService, domain.TaskCategoryArea, &e.InventoryUID)
+	if err != nil {
+		log.Error(err)
+		return nil
+	}
+
+	today, tomorrow := datetimehelper.DayRange(time.Now())
+	for _, v := range e.PlannedBatches {
+		if v.SeedingDate.Before(today) {
+			continue
+		}
+
+		// The due date can't be in the past, so the task of a batch seeded today is due by the end of the day
+		seedingDate := v.SeedingDate
+		if seedingDate.Before(time.Now()) {
+			seedingDate = tomorrow.Add(-time.Second)
+		}
+		areaUID := v.AreaUID
+		description := fmt.Sprintf("Planned by the %s succession plan. Container: %d %s",
+			e.Name, v.Quantity, strings.ToLower(e.ContainerType))
+
+		task, err := domain.CreateTask(
+			s.TaskService,
+			title,
+			description,
+			&seedingDate,
+			domain.TaskPriorityNormal,
+			taskDomain,
+			domain.TaskCategoryArea,
+			&areaUID)
+		if err != nil {
+			log.Error(err)
+			continue
+		}
+
+		err = <-s.TaskEventRepo.Save(task.UID, 0, task.UncommittedChanges)
+		if err != nil {
+			log.Error(err)
+			continue
+		}
+
+		s.publishUncommittedEvents(task)
+
+		err = <-s.SuccessionPlanTaskRepo.Save(&storage.SuccessionPlanTask{
+			PlannedBatchUID:   v.UID,
+			SuccessionPlanUID: e.UID,
+			TaskUID:           task.UID,
+		})
+		if err != nil {
+			log.Error(err)
+		}
+	}
+
+	return nil
+}
+
+// CloseSuccessionPlanTasks closes the seeding tasks of the planned crop batches which are not planned anymore.
+// The task of a confirmed crop batch is completed, because the crop batch has been seeded.
+// The tasks of a skipped crop batch or of a removed succession plan are cancelled.
+func (s *TaskServer) CloseSuccessionPlanTasks(event interface{}) error {
+	successionPlanTasks := []storage.SuccessionPlanTask{}
+	complete := false
+
+	switch e := event.(type) {
+	case growthdomain.SuccessionPlanBatchConfirmed:
+		successionPlanTasks = s.findSuccessionPlanTask(e.PlannedBatchUID)
+		complete = true
+
+	case growthdomain.SuccessionPlanBatchSkipped:
+		successionPlanTasks = s.findSuccessionPlanTask(e.PlannedBatchUID)
+
+	case growthdomain.SuccessionPlanRemoved:
+		queryResult := <-s.SuccessionPlanTaskQuery.FindAllBySuccessionPlanID(e.UID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+			return nil
+		}
+
+		result, ok := queryResult.Result.([]storage.SuccessionPlanTask)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+			return nil
+		}
+
+		successionPlanTasks = result
+
+	default:
+		log.Error(errors.New("Internal server error. Error type assertion"))
+		return nil
+	}
+
+	for _, v := range successionPlanTasks {
+		eventQueryResult := <-s.TaskEventQuery.FindAllByTaskID(v.TaskUID)
+		if eventQueryResult.Error != nil {
+			log.Error(eventQueryResult.Error)
+			continue
+		}
+
+		events, ok := eventQueryResult.Result.([]storage.TaskEvent)
+		if !ok || len(events) == 0 {
+			continue
+		}
+
+		task := repository.BuildTaskFromEventHistory(s.TaskService, events)
+
+		// The task may have been closed by hand already
+		if task.Status != domain.TaskStatusCreated {
+			continue
+		}
+
+		var err error
+		if complete {
+			err = task.CompleteTask(s.TaskService)
+		} else {
+			err = task.CancelTask(s.TaskService)
+		}
+		if err != nil {
+			log.Error(err)
+			continue
+		}
+
+		err = <-s.TaskEventRepo.Save(task.UID, task.Version, task.UncommittedChanges)
+		if err != nil {
+			log.Error(err)
+			continue
+		}
+
+		s.publishUncommittedEvents(task)
+	}
+
+	return nil
+}
+
+func (s *TaskServer) findSuccessionPlanTask(plannedBatchUID uuid.UUID) []storage.SuccessionPlanTask {
+	queryResult := <-s.SuccessionPlanTaskQuery.FindByPlannedBatchID(plannedBatchUID)
+	if queryResult.Error != nil {
+		log.Error(queryResult.Error)
+		return nil
+	}
+
+	successionPlanTask, ok := queryResult.Result.(storage.SuccessionPlanTask)
+	if !ok {
+		log.Error(errors.New("Internal server error. Error type assertion"))
+		return nil
+	}
+
+	// The planned batch may have been planned before its task was recorded
+	if uuid.Equal(successionPlanTask.TaskUID, uuid.UUID{}) {
+		return nil
+	}
+
+	return []storage.SuccessionPlanTask{successionPlanTask}
+}
+
 func (s *TaskServer) getTaskReadFromID(uid uuid.UUID) (*storage.TaskRead, error) {
 
 	readResult := <-s.TaskReadQuery.FindByID(uid)
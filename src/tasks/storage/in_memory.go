@@ -37,3 +37,93 @@ func CreateTaskReadStorage() *TaskReadStorage {
 
 	return &TaskReadStorage{TaskReadMap: make(map[uuid.UUID]TaskRead), Lock: &rwMutex}
 }
+
+type TaskCalendarFeedStorage struct {
+	Lock                *deadlock.RWMutex
+	TaskCalendarFeedMap map[uuid.UUID]TaskCalendarFeed
+}
+
+func CreateTaskCalendarFeedStorage() *TaskCalendarFeedStorage {
+	rwMutex := deadlock.RWMutex{}
+	deadlock.Opts.DeadlockTimeout = time.Second * 10
+	deadlock.Opts.OnPotentialDeadlock = func() {
+		fmt.Println("TASK CALENDAR FEED STORAGE DEADLOCK!")
+	}
+
+	return &TaskCalendarFeedStorage{TaskCalendarFeedMap: make(map[uuid.UUID]TaskCalendarFeed), Lock: &rwMutex}
+}
+
+type TaskTimeEntryReadStorage struct {
+	Lock                 *deadlock.RWMutex
+	TaskTimeEntryReadMap map[uuid.UUID]TaskTimeEntryRead
+}
+
+func CreateTaskTimeEntryReadStorage() *TaskTimeEntryReadStorage {
+	rwMutex := deadlock.RWMutex{}
+	deadlock.Opts.DeadlockTimeout = time.Second * 10
+	deadlock.Opts.OnPotentialDeadlock = func() {
+		fmt.Println("TASK TIME ENTRY READ STORAGE DEADLOCK!")
+	}
+
+	return &TaskTimeEntryReadStorage{TaskTimeEntryReadMap: make(map[uuid.UUID]TaskTimeEntryRead), Lock: &rwMutex}
+}
+
+type FinanceLedgerStorage struct {
+	Lock                  *deadlock.RWMutex
+	FinanceLedgerEntryMap map[uuid.UUID]FinanceLedgerEntry
+}
+
+func CreateFinanceLedgerStorage() *FinanceLedgerStorage {
+	rwMutex := deadlock.RWMutex{}
+	deadlock.Opts.DeadlockTimeout = time.Second * 10
+	deadlock.Opts.OnPotentialDeadlock = func() {
+		fmt.Println("FINANCE LEDGER STORAGE DEADLOCK!")
+	}
+
+	return &FinanceLedgerStorage{FinanceLedgerEntryMap: make(map[uuid.UUID]FinanceLedgerEntry), Lock: &rwMutex}
+}
+
+type TaskReminderPreferenceStorage struct {
+	Lock                      *deadlock.RWMutex
+	TaskReminderPreferenceMap map[uuid.UUID]TaskReminderPreference
+}
+
+func CreateTaskReminderPreferenceStorage() *TaskReminderPreferenceStorage {
+	rwMutex := deadlock.RWMutex{}
+	deadlock.Opts.DeadlockTimeout = time.Second * 10
+	deadlock.Opts.OnPotentialDeadlock = func() {
+		fmt.Println("TASK REMINDER PREFERENCE STORAGE DEADLOCK!")
+	}
+
+	return &TaskReminderPreferenceStorage{TaskReminderPreferenceMap: make(map[uuid.UUID]TaskReminderPreference), Lock: &rwMutex}
+}
+
+type TaskReminderSentStorage struct {
+	Lock                *deadlock.RWMutex
+	TaskReminderSentMap map[TaskReminderSentKey]TaskReminderSent
+}
+
+func CreateTaskReminderSentStorage() *TaskReminderSentStorage {
+	rwMutex := deadlock.RWMutex{}
+	deadlock.Opts.DeadlockTimeout = time.Second * 10
+	deadlock.Opts.OnPotentialDeadlock = func() {
+		fmt.Println("TASK REMINDER SENT STORAGE DEADLOCK!")
+	}
+
+	return &TaskReminderSentStorage{TaskReminderSentMap: make(map[TaskReminderSentKey]TaskReminderSent), Lock: &rwMutex}
+}
+
+type SuccessionPlanTaskStorage struct {
+	Lock                  *deadlock.RWMutex
+	SuccessionPlanTaskMap map[uuid.UUID]SuccessionPlanTask
+}
+
+func CreateSuccessionPlanTaskStorage() *SuccessionPlanTaskStorage {
+	rwMutex := deadlock.RWMutex{}
+	deadlock.Opts.DeadlockTimeout = time.Second * 10
+	deadlock.Opts.OnPotentialDeadlock = func() {
+		fmt.Println("SUCCESSION PLAN TASK STORAGE DEADLOCK!")
+	}
+
+	return &SuccessionPlanTaskStorage{SuccessionPlanTaskMap: make(map[uuid.UUID]SuccessionPlanTask), Lock: &rwMutex}
+}
@@ -29,6 +29,19 @@ type TaskRead struct {
 	Category      string            `json:"category"`
 	IsDue         bool              `json:"is_due"`
 	AssetID       *uuid.UUID        `json:"asset_id"`
+
+	RecurrenceRule string     `json:"recurrence_rule"`
+	SeriesID       *uuid.UUID `json:"series_id"`
+
+	AssigneeID *uuid.UUID `json:"assignee_id"`
+
+	Checklist []domain.ChecklistItem `json:"checklist"`
+	BlockedBy []uuid.UUID            `json:"blocked_by"`
+
+	Comments    []domain.TaskComment    `json:"comments"`
+	Attachments []domain.TaskAttachment `json:"attachments"`
+
+	MaterialConsumption *domain.TaskMaterialConsumption `json:"material_consumption"`
 }
 
 // Implements TaskDomain interface in domain
@@ -81,3 +94,69 @@ type TaskDomainDetailedReservoir struct {
 func (d TaskDomainDetailedReservoir) Code() string {
 	return domain.TaskDomainCropCode
 }
+
+// TaskCalendarFeed is the token of the calendar feed of a user.
+// The token is given in the feed url, because the calendar apps can't send the authorization header.
+type TaskCalendarFeed struct {
+	UserUID     uuid.UUID `json:"user_id"`
+	Token       string    `json:"token"`
+	CreatedDate time.Time `json:"created_date"`
+}
+
+// TaskTimeEntryRead is a time entry of a task, kept apart from the tasks to report on the labor by date
+type TaskTimeEntryRead struct {
+	UID        uuid.UUID     `json:"uid"`
+	TaskUID    uuid.UUID     `json:"task_id"`
+	WorkerID   *uuid.UUID    `json:"worker_id"`
+	HourlyRate float64       `json:"hourly_rate"`
+	StartDate  time.Time     `json:"start_date"`
+	EndDate    *time.Time    `json:"end_date"`
+	Duration   time.Duration `json:"duration"`
+}
+
+// FinanceLedgerEntry is a completed finance task, posted to the ledger of its farm
+type FinanceLedgerEntry struct {
+	TaskUID      uuid.UUID  `json:"task_id"`
+	FarmUID      uuid.UUID  `json:"farm_id"`
+	Title        string     `json:"title"`
+	Amount       string     `json:"amount"`
+	CurrencyCode string     `json:"currency_code"`
+	Direction    string     `json:"direction"`
+	Counterparty string     `json:"counterparty"`
+	MaterialID   *uuid.UUID `json:"material_id"`
+	PostedDate   time.Time  `json:"posted_date"`
+}
+
+// TaskReminderPreference is how a user is reminded of the tasks assigned to them.
+// The recipient is the address of the channel, such as an email address or a webhook url.
+type TaskReminderPreference struct {
+	UserUID   uuid.UUID `json:"user_id"`
+	Channel   string    `json:"channel"`
+	Recipient string    `json:"recipient"`
+	domain.TaskReminderRule
+	LastUpdated time.Time `json:"last_updated"`
+}
+
+// TaskReminderSent records a reminder sent to a user, so it is only sent once for a due date
+type TaskReminderSent struct {
+	TaskUID  uuid.UUID `json:"task_id"`
+	UserUID  uuid.UUID `json:"user_id"`
+	Kind     string    `json:"kind"`
+	DueDate  time.Time `json:"due_date"`
+	SentDate time.Time `json:"sent_date"`
+}
+
+// SuccessionPlanTask links the seeding task of a planned crop batch to its succession plan,
+// so the task is closed when the planned crop batch is confirmed or skipped
+type SuccessionPlanTask struct {
+	PlannedBatchUID   uuid.UUID `json:"planned_batch_id"`
+	SuccessionPlanUID uuid.UUID `json:"succession_plan_id"`
+	TaskUID           uuid.UUID `json:"task_id"`
+}
+
+// TaskReminderSentKey identifies a reminder of a task to a user
+type TaskReminderSentKey struct {
+	TaskUID uuid.UUID
+	UserUID uuid.UUID
+	Kind    string
+}